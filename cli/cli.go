@@ -9,36 +9,58 @@ import (
 	"strconv"
 
 	"github.com/ANG13T/SatIntel/osint"
-	"github.com/TwiN/go-color"
 	"github.com/iskaa02/qalam/gradient"
 )
 
 // Option prompts the user to select a menu option and validates the input.
-// It recursively prompts until a valid option between 0 and 4 is entered.
+// It recursively prompts until a valid option between 0 and 7 is entered.
 func Option() {
 	fmt.Print("\n ENTER INPUT > ")
 	var selection string
 	fmt.Scanln(&selection)
 	num, err := strconv.Atoi(selection)
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] INVALID INPUT"))
+		fmt.Println(osint.Colorize(osint.RoleError, "  [!] INVALID INPUT"))
 		Option()
 	} else {
-		if num >= 0 && num < 6 {
+		if num >= 0 && num < 8 {
+			if !menuItemAvailable(num) {
+				osint.RequireCredentials(onlineMenuItems[num])
+				Option()
+				return
+			}
 			DisplayFunctions(num)
 		} else {
-			fmt.Println(color.Ize(color.Red, "  [!] INVALID INPUT"))
+			fmt.Println(osint.Colorize(osint.RoleError, "  [!] INVALID INPUT"))
 			Option()
 		}
 	}
 }
 
+// onlineMenuItems names the main menu entries that need Space-Track or N2YO credentials.
+var onlineMenuItems = map[int]string{
+	1: "Orbital Element Data Display",
+	2: "Satellite Telemetry Display",
+	3: "Orbital Predictions",
+	5: "Batch Operations",
+	6: "Launches On This Date",
+}
+
+// menuItemAvailable reports whether a main menu entry can run with the current credentials.
+func menuItemAvailable(num int) bool {
+	if _, online := onlineMenuItems[num]; online {
+		return osint.CredentialsConfigured()
+	}
+	return true
+}
+
 // DisplayFunctions executes the selected function based on the menu choice.
 // After execution, it waits for user input, clears the screen, and shows the menu again.
 func DisplayFunctions(x int) {
 	if x == 0 {
-		fmt.Println(color.Ize(color.Blue, " Escaping Orbit..."))
-		os.Exit(1)
+		osint.PrintN2YOUsageSummary()
+		fmt.Println(osint.Colorize(osint.RoleAccent, " Escaping Orbit..."))
+		osint.Exit(osint.ExitSuccess)
 	} else if x == 1 {
 		osint.OrbitalElement()
 		waitForEnter()
@@ -69,6 +91,18 @@ func DisplayFunctions(x int) {
 		clearScreen()
 		Banner()
 		Option()
+	} else if x == 6 {
+		osint.LaunchesOnDate()
+		waitForEnter()
+		clearScreen()
+		Banner()
+		Option()
+	} else if x == 7 {
+		osint.ManageObserverProfiles()
+		waitForEnter()
+		clearScreen()
+		Banner()
+		Option()
 	}
 }
 
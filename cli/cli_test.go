@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"os"
 	"testing"
+
+	"github.com/ANG13T/SatIntel/osint"
 )
 
 // Note: Most CLI functions are interactive and difficult to test without mocking stdin/stdout
@@ -58,3 +61,25 @@ func TestBannerFileReading(t *testing.T) {
 		})
 	}
 }
+
+func TestMenuItemAvailable_Offline(t *testing.T) {
+	for _, key := range []string{"SPACE_TRACK_USERNAME", "SPACE_TRACK_PASSWORD", "N2YO_API_KEY"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+	original := osint.Offline
+	osint.Offline = true
+	t.Cleanup(func() { osint.Offline = original })
+
+	if !menuItemAvailable(4) {
+		t.Error("TLE Parser (SGP4) should be available offline")
+	}
+	if !menuItemAvailable(0) {
+		t.Error("Exit should always be available")
+	}
+	for num := range onlineMenuItems {
+		if menuItemAvailable(num) {
+			t.Errorf("Menu item %d should require credentials", num)
+		}
+	}
+}
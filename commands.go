@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ANG13T/SatIntel/osint"
+)
+
+// runCommand executes a non-interactive subcommand and returns the process exit code.
+func runCommand(args []string) int {
+	switch args[0] {
+	case "validate-tle":
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel validate-tle <file>")
+			return osint.ExitInvalidInput
+		}
+		return validateTLECommand(args[1])
+	case "usage":
+		return usageCommand()
+	case "errors":
+		osint.PrintErrorCodes()
+		return osint.ExitSuccess
+	case "catalog-stats":
+		if len(args) > 2 {
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel catalog-stats [country|type|year]")
+			return osint.ExitInvalidInput
+		}
+		groupBy := "country"
+		if len(args) == 2 {
+			groupBy = args[1]
+		}
+		return catalogStatsCommand(groupBy)
+	case "accuracy":
+		if len(args) < 4 || len(args) > 5 {
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel accuracy <norad> <latitude> <longitude> [samples]")
+			return osint.ExitInvalidInput
+		}
+		return accuracyCommand(args[1:])
+	case "visible-now":
+		args, quiet := parseFlag(args[1:], "--quiet", "-q")
+		if len(args) < 3 || len(args) > 4 {
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel visible-now <norad> <latitude> <longitude> [min elevation] [--quiet]")
+			return osint.ExitInvalidInput
+		}
+		return visibleNowCommand(args, quiet)
+	case "config":
+		if len(args) != 2 || (args[1] != "show" && args[1] != "reset") {
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel config show|reset")
+			return osint.ExitInvalidInput
+		}
+		return configCommand(args[1])
+	case "mirror-catalog":
+		filter, err := parseCatalogFilter(args[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, "Usage: SatIntel mirror-catalog [country=<code>] [type=<object type>] [year=<launch year>]")
+			return osint.ExitInvalidInput
+		}
+		return mirrorCatalogCommand(filter)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", args[0])
+		fmt.Fprintln(os.Stderr, "Available commands: validate-tle <file>, usage, errors, catalog-stats [country|type|year], accuracy <norad> <latitude> <longitude> [samples], visible-now <norad> <latitude> <longitude> [min elevation], config show|reset, mirror-catalog [filters]")
+		return osint.ExitInvalidInput
+	}
+}
+
+// validateTLECommand validates every element set in a TLE file and prints a report.
+func validateTLECommand(path string) int {
+	results, err := osint.ValidateTLEFile(path)
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeFileReadFailed, "Failed to validate TLE file")
+		return osint.ExitCodeFor(err)
+	}
+	if !osint.PrintTLEValidationReport(results) {
+		return osint.ExitInvalidInput
+	}
+	return osint.ExitSuccess
+}
+
+// usageCommand prints today's N2YO API usage.
+func usageCommand() int {
+	usage, err := osint.LoadN2YOUsage()
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeFileReadFailed, "Failed to read N2YO usage")
+		return osint.ExitCodeFor(err)
+	}
+	if len(usage.Endpoints) == 0 {
+		fmt.Println("No N2YO API requests recorded today")
+		return osint.ExitSuccess
+	}
+	osint.PrintN2YOUsageSummary()
+	return osint.ExitSuccess
+}
+
+// catalogStatsCommand charts the objects in orbit grouped by country, type or launch year.
+func catalogStatsCommand(groupBy string) int {
+	if err := osint.PrintCatalogStats(groupBy); err != nil {
+		osint.HandleError(err, osint.ErrCodeAPIRequestFailed, "Failed to fetch catalog statistics")
+		return osint.ExitCodeFor(err)
+	}
+	return osint.ExitSuccess
+}
+
+// accuracyCommand compares SGP4 with N2YO for a satellite over the next samples seconds.
+func accuracyCommand(args []string) int {
+	latitude, err := osint.ParseCoordinate(args[1])
+	longitude, err2 := osint.ParseCoordinate(args[2])
+	samples := 60
+	var err3 error
+	if len(args) == 4 {
+		samples, err3 = strconv.Atoi(args[3])
+	}
+	if err != nil || err2 != nil || err3 != nil {
+		fmt.Fprintln(os.Stderr, "Latitude, longitude and samples must be numbers")
+		return osint.ExitInvalidInput
+	}
+
+	observer := osint.ObserverPosition{Latitude: latitude, Longitude: longitude}
+	stats, err := osint.AccuracyReport(args[0], observer, time.Now().UTC(), samples)
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeAPIRequestFailed, "Failed to build accuracy report")
+		return osint.ExitCodeFor(err)
+	}
+	osint.PrintAccuracyReport(stats)
+	return osint.ExitSuccess
+}
+
+// visibleNowCommand checks whether a satellite is above a minimum elevation (default 0) right now.
+// It exits with ExitSuccess when it is and ExitNoData when it is not; quiet suppresses all output
+// except errors.
+func visibleNowCommand(args []string, quiet bool) int {
+	latitude, err := osint.ParseCoordinate(args[1])
+	longitude, err2 := osint.ParseCoordinate(args[2])
+	minElevation := 0.0
+	var err3 error
+	if len(args) == 4 {
+		minElevation, err3 = strconv.ParseFloat(args[3], 64)
+	}
+	if err != nil || err2 != nil || err3 != nil {
+		fmt.Fprintln(os.Stderr, "Latitude, longitude and min elevation must be numbers")
+		return osint.ExitInvalidInput
+	}
+
+	observer := osint.ObserverPosition{Latitude: latitude, Longitude: longitude}
+	visible, angles, err := osint.SatelliteVisibleNow(args[0], observer, minElevation, time.Now().UTC())
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeTLEInsufficientData, "Failed to compute the satellite's position")
+		return osint.ExitCodeFor(err)
+	}
+
+	if !quiet {
+		state := "below"
+		if visible {
+			state = "above"
+		}
+		fmt.Printf("NORAD %s is %s %.1f° elevation: azimuth %.1f°, elevation %.1f°, range %.0f km\n",
+			args[0], state, minElevation, angles.Azimuth, angles.Elevation, angles.Range)
+	}
+	if !visible {
+		return osint.ExitNoData
+	}
+	return osint.ExitSuccess
+}
+
+// configCommand shows the settings in effect, or resets the config file to the defaults.
+func configCommand(action string) int {
+	if action == "reset" {
+		backup, err := osint.ResetConfig()
+		if err != nil {
+			osint.HandleError(err, osint.ErrCodeFilePermission, "Failed to reset the config file")
+			return osint.ExitFailure
+		}
+		if backup == "" {
+			fmt.Println("No config file, all settings are already at their defaults")
+		} else {
+			fmt.Println("Settings reset to their defaults, credentials kept. The previous config is saved as " + backup)
+		}
+		return osint.ExitSuccess
+	}
+
+	cfg, err := osint.LoadConfig()
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeFileReadFailed, "Failed to read the config file")
+		return osint.ExitFailure
+	}
+	osint.PrintEffectiveConfig(osint.EffectiveConfig(cfg))
+	return osint.ExitSuccess
+}
+
+// parseCatalogFilter reads key=value catalog filters such as country=US or type=PAYLOAD.
+func parseCatalogFilter(args []string) (osint.CatalogFilter, error) {
+	var filter osint.CatalogFilter
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || value == "" {
+			return filter, fmt.Errorf("invalid filter %q", arg)
+		}
+		switch strings.ToLower(key) {
+		case "country":
+			filter.Country = strings.ToUpper(value)
+		case "type":
+			filter.ObjectType = strings.ToUpper(value)
+		case "year":
+			filter.LaunchYear = value
+		default:
+			return filter, fmt.Errorf("unknown filter %q", key)
+		}
+	}
+	return filter, nil
+}
+
+// mirrorCatalogCommand downloads the matching part of the satellite catalog for offline searches.
+func mirrorCatalogCommand(filter osint.CatalogFilter) int {
+	client, err := osint.Login()
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeAuthCredentials, "Failed to log in to Space-Track")
+		return osint.ExitCodeFor(err)
+	}
+	mirror, err := osint.BuildCatalogMirror(client, filter)
+	if err != nil {
+		osint.HandleError(err, osint.ErrCodeAPIRequestFailed, "Failed to build the catalog mirror")
+		return osint.ExitCodeFor(err)
+	}
+	fmt.Println("Saved local catalog mirror: " + mirror.Describe())
+	return osint.ExitSuccess
+}
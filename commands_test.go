@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ANG13T/SatIntel/osint"
+)
+
+func TestRunCommandValidateTLE(t *testing.T) {
+	valid := "ISS (ZARYA)\n" +
+		"1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9993\n" +
+		"2 25544  51.6335 344.7760 0007976 126.2523 325.9359 15.70406856328906\n"
+	broken := "1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9993\n" +
+		"2 25544  51.6335 344.7760 0007976 126.2523 325.9359 15.70406856328900\n"
+
+	tmpDir := t.TempDir()
+	validFile := filepath.Join(tmpDir, "valid.tle")
+	brokenFile := filepath.Join(tmpDir, "broken.tle")
+	os.WriteFile(validFile, []byte(valid), 0644)
+	os.WriteFile(brokenFile, []byte(broken), 0644)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected int
+	}{
+		{"valid file", []string{"validate-tle", validFile}, osint.ExitSuccess},
+		{"checksum failure", []string{"validate-tle", brokenFile}, osint.ExitInvalidInput},
+		{"missing file", []string{"validate-tle", filepath.Join(tmpDir, "missing.tle")}, osint.ExitFailure},
+		{"missing argument", []string{"validate-tle"}, osint.ExitInvalidInput},
+		{"unknown command", []string{"frobnicate"}, osint.ExitInvalidInput},
+		{"list error codes", []string{"errors"}, osint.ExitSuccess},
+		{"accuracy missing arguments", []string{"accuracy", "25544"}, osint.ExitInvalidInput},
+		{"accuracy invalid latitude", []string{"accuracy", "25544", "north", "-74"}, osint.ExitInvalidInput},
+		{"config without action", []string{"config"}, osint.ExitInvalidInput},
+		{"config unknown action", []string{"config", "edit"}, osint.ExitInvalidInput},
+		{"visible-now missing arguments", []string{"visible-now", "25544", "--quiet"}, osint.ExitInvalidInput},
+		{"visible-now invalid elevation", []string{"visible-now", "25544", "40.7", "-74", "high"}, osint.ExitInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := runCommand(tt.args); code != tt.expected {
+				t.Errorf("runCommand(%v) = %d, want %d", tt.args, code, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunCommandUsage_UnreadableState(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("SATINTEL_DATA_DIR", dataDir)
+	os.WriteFile(filepath.Join(dataDir, "n2yo_usage.json"), []byte("{not json"), 0644)
+
+	if code := runCommand([]string{"usage"}); code == osint.ExitSuccess {
+		t.Errorf("runCommand(usage) = %d, want a non-zero exit code", code)
+	}
+}
+
+func TestParseCatalogFilter(t *testing.T) {
+	filter, err := parseCatalogFilter([]string{"country=us", "type=payload", "year=2020"})
+	if err != nil {
+		t.Fatalf("parseCatalogFilter() failed: %v", err)
+	}
+	if filter != (osint.CatalogFilter{Country: "US", ObjectType: "PAYLOAD", LaunchYear: "2020"}) {
+		t.Errorf("parseCatalogFilter() = %+v", filter)
+	}
+
+	for _, args := range [][]string{{"country"}, {"country="}, {"orbit=LEO"}} {
+		if _, err := parseCatalogFilter(args); err == nil {
+			t.Errorf("parseCatalogFilter(%v) should fail", args)
+		}
+	}
+}
@@ -7,16 +7,16 @@ toolchain go1.24.5
 require (
 	github.com/TwiN/go-color v1.4.0
 	github.com/iskaa02/qalam v0.3.0
+	github.com/joshuaferrara/go-satellite v0.0.0-20220611180459-512638c64e5b
 	github.com/manifoldco/promptui v0.9.0
+	golang.org/x/term v0.38.0
 )
 
 require (
 	github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e // indirect
-	github.com/joshuaferrara/go-satellite v0.0.0-20220611180459-512638c64e5b // indirect
 	github.com/lucasb-eyer/go-colorful v1.0.3 // indirect
 	github.com/mazznoer/colorgrad v0.8.1 // indirect
 	github.com/mazznoer/csscolorparser v0.1.0 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	golang.org/x/sys v0.39.0 // indirect
-	golang.org/x/term v0.38.0 // indirect
 )
@@ -13,14 +13,17 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/ANG13T/SatIntel/cli"
+	"github.com/ANG13T/SatIntel/osint"
 	"golang.org/x/term"
 )
 
 // loadEnvFile reads environment variables from a .env file in the current directory.
-// It skips empty lines and comments, and handles quoted values.
+// It skips empty lines and comments, and handles quoted values. Variables already set
+// in the environment are left untouched.
 func loadEnvFile() error {
 	envPath := ".env"
 
@@ -47,7 +50,9 @@ func loadEnvFile() error {
 			key := strings.TrimSpace(parts[0])
 			value := strings.TrimSpace(parts[1])
 			value = strings.Trim(value, "\"'")
-			os.Setenv(key, value)
+			if _, exists := os.LookupEnv(key); !exists {
+				os.Setenv(key, value)
+			}
 		}
 	}
 
@@ -58,6 +63,63 @@ func loadEnvFile() error {
 	return nil
 }
 
+// applyConfig sets environment variables from the config file for any that are still unset,
+// so the environment and .env always take precedence. It returns the number of values applied.
+func applyConfig(cfg *osint.Config) int {
+	applied := 0
+	for key, value := range cfg.EnvValues() {
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		os.Setenv(key, value)
+		applied++
+	}
+	return applied
+}
+
+// parseGlobalFlags removes global flags from args and reports whether offline mode was requested,
+// either with --offline or by setting SATINTEL_OFFLINE.
+func parseGlobalFlags(args []string) ([]string, bool) {
+	offline := envFlag("SATINTEL_OFFLINE")
+
+	var rest []string
+	for _, arg := range args {
+		if arg == "--offline" || arg == "-offline" {
+			offline = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, offline
+}
+
+// parseYesFlag removes --yes (or -y) from args and reports whether it was present.
+func parseYesFlag(args []string) ([]string, bool) {
+	return parseFlag(args, "--yes", "-y")
+}
+
+// parseFlag removes every occurrence of a boolean flag, under any of names, from args and
+// reports whether it was present.
+func parseFlag(args []string, names ...string) ([]string, bool) {
+	found := false
+	var rest []string
+	for _, arg := range args {
+		if slices.Contains(names, arg) {
+			found = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, found
+}
+
+// promptOfflineMode asks whether to skip entering credentials and use only offline features.
+func promptOfflineMode() bool {
+	fmt.Print("Credentials are missing. Continue in offline mode (TLE parsing and SGP4 only)? (y/n): ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.ToLower(strings.TrimSpace(answer)) == "y"
+}
+
 // isPasswordField determines if the environment variable should have masked input.
 func isPasswordField(envKey string) bool {
 	passwordFields := []string{
@@ -129,7 +191,7 @@ func readPassword() (string, error) {
 		// Handle Ctrl+C
 		if char == 3 {
 			fmt.Println()
-			os.Exit(1)
+			osint.Exit(osint.ExitFailure)
 		}
 
 		// Skip control characters except those we handle
@@ -159,14 +221,14 @@ func setEnvironmentalVariable(envKey string) string {
 			input, err = readPassword()
 			if err != nil {
 				fmt.Println("Error reading password:", err)
-				os.Exit(1)
+				osint.Exit(osint.ExitFailure)
 			}
 		} else {
 			reader := bufio.NewReader(os.Stdin)
 			input, err = reader.ReadString('\n')
 			if err != nil {
 				fmt.Println("Error reading input:", err)
-				os.Exit(1)
+				osint.Exit(osint.ExitFailure)
 			}
 			input = strings.TrimSpace(input)
 		}
@@ -185,7 +247,7 @@ func setEnvironmentalVariable(envKey string) string {
 
 	if err := os.Setenv(envKey, input); err != nil {
 		fmt.Printf("Error setting environment variable %s: %v\n", envKey, err)
-		os.Exit(1)
+		osint.Exit(osint.ExitFailure)
 	}
 
 	return input
@@ -200,6 +262,46 @@ func checkEnvironmentalVariable(envKey string) {
 	}
 }
 
+// reenterSpaceTrackCredentials prompts for a new Space-Track username and password after a
+// rejected login.
+func reenterSpaceTrackCredentials() {
+	setEnvironmentalVariable("SPACE_TRACK_USERNAME")
+	setEnvironmentalVariable("SPACE_TRACK_PASSWORD")
+}
+
+// isNonInteractive reports whether prompts must be avoided: SATINTEL_NONINTERACTIVE is set,
+// or stdin is not a terminal (as in CI), where a prompt would block forever.
+func isNonInteractive(stdinIsTerminal bool) bool {
+	return envFlag("SATINTEL_NONINTERACTIVE") || !stdinIsTerminal
+}
+
+// envFlag reports whether a boolean environment variable is set to 1, true or yes.
+func envFlag(key string) bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(key))) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// checkCredentials makes sure every required credential is set. Missing or blank values are
+// prompted for interactively. In non-interactive mode it returns an error naming them instead of
+// reading stdin.
+func checkCredentials(nonInteractive bool) error {
+	missing := osint.MissingCredentials()
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if nonInteractive {
+		return fmt.Errorf("missing required environment variables: %s (set them, add them to .env or the config file, or run with --offline)", strings.Join(missing, ", "))
+	}
+	for _, key := range missing {
+		setEnvironmentalVariable(key)
+	}
+	return nil
+}
+
 // validateAPIKeyFormat validates the format of API keys and credentials.
 // Returns an error if the format is invalid.
 func validateAPIKeyFormat(envKey, value string) error {
@@ -299,9 +401,8 @@ func testSpaceTrackConnection(username, password string) (*http.Client, error) {
 		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
 	}
 
-	client := &http.Client{
-		Jar: jar,
-	}
+	client := osint.NewHTTPClient(0)
+	client.Jar = jar
 
 	resp, err := client.PostForm("https://www.space-track.org/ajaxauth/login", vals)
 	if err != nil {
@@ -322,7 +423,7 @@ func testN2YOConnection(apiKey string) error {
 	// Using minimal parameters to reduce API usage
 	testURL := fmt.Sprintf("https://api.n2yo.com/rest/v1/satellite/positions/25544/0/0/0/1/&apiKey=%s", apiKey)
 
-	resp, err := http.Get(testURL)
+	resp, err := osint.NewHTTPClient(0).Get(testURL)
 	if err != nil {
 		return fmt.Errorf("connection error: %w", err)
 	}
@@ -351,30 +452,131 @@ func testN2YOConnection(apiKey string) error {
 }
 
 func main() {
-	err := loadEnvFile()
-	if err != nil {
-		if err.Error() == ".env file not found" {
-			fmt.Println("Note: .env file not found. Please provide credentials:")
+	// Precedence: environment > .env > config file
+	envErr := loadEnvFile()
+	cfg, cfgErr := osint.LoadConfig()
+	configApplied := applyConfig(cfg)
+	osint.ExcludeDebrisByDefault = !cfg.IncludeDebris
+	osint.PromptToSaveFavorites = !cfg.DisableFavoritePrompt && !envFlag("SATINTEL_NO_FAVORITE_PROMPT")
+	osint.MapGridlines = cfg.MapGridlines
+	osint.CompressExports = cfg.CompressExports
+	if cfg.PositionSource != "" {
+		if source, err := osint.ParsePositionSource(cfg.PositionSource); err != nil {
+			fmt.Printf("Warning: %v, using N2YO\n", err)
+		} else {
+			osint.DefaultPositionSource = source
+		}
+	}
+	if cfg.TLESource != "" {
+		if provider, err := osint.ParseTLEProvider(cfg.TLESource); err != nil {
+			fmt.Printf("Warning: %v, using Space-Track\n", err)
+		} else {
+			osint.DefaultTLEProvider = provider
+		}
+	}
+	if err := cfg.ApplyPrecision(); err != nil {
+		fmt.Printf("Warning: %v, using default precision\n", err)
+	}
+	if err := cfg.ApplyPassDetection(); err != nil {
+		fmt.Printf("Warning: %v, using default pass detection\n", err)
+	}
+	if err := cfg.ApplyTLELineTolerance(); err != nil {
+		fmt.Printf("Warning: %v, using default TLE line tolerance\n", err)
+	}
+	if err := cfg.ApplyNameSearchLimit(); err != nil {
+		fmt.Printf("Warning: %v, using default name search limit\n", err)
+	}
+	if err := cfg.ApplySnapshotOptions(); err != nil {
+		fmt.Printf("Warning: %v, using default snapshot settings\n", err)
+	}
+	if err := cfg.ApplyN2YOCallBudget(); err != nil {
+		fmt.Printf("Warning: %v, using default N2YO call budget\n", err)
+	}
+	if err := cfg.ApplyMapTrackSamples(); err != nil {
+		fmt.Printf("Warning: %v, mapping positions as fetched\n", err)
+	}
+	if err := cfg.ApplyMapFade(); err != nil {
+		fmt.Printf("Warning: %v, using default map fade\n", err)
+	}
+	if err := cfg.ApplyCatalogCacheTTL(); err != nil {
+		fmt.Printf("Warning: %v, using default catalog cache lifetime\n", err)
+	}
+	if err := cfg.ApplyLocation(); err != nil {
+		fmt.Printf("Warning: %v, detecting location by IP\n", err)
+	}
+
+	if theme := os.Getenv("SATINTEL_THEME"); theme != "" {
+		if err := osint.SetTheme(theme); err != nil {
+			fmt.Printf("Warning: %v, using default theme\n", err)
+		}
+	}
+	if limit := os.Getenv("N2YO_RATE_LIMIT"); limit != "" {
+		if err := osint.SetN2YORateLimit(limit); err != nil {
+			fmt.Printf("Warning: %v, using default N2YO rate limit\n", err)
+		}
+	}
+
+	args, offline := parseGlobalFlags(os.Args[1:])
+	args, osint.AssumeDefaults = parseYesFlag(args)
+	args, osint.UseLocalCatalog = parseFlag(args, "--local-catalog")
+	args, verbose := parseFlag(args, "--verbose", "-verbose")
+	if verbose {
+		osint.EnableVerboseLogging(os.Stderr)
+	}
+	osint.Offline = offline
+	if len(args) > 0 {
+		osint.Exit(runCommand(args))
+	}
+
+	if offline {
+		fmt.Println("Offline mode: Space-Track and N2YO features are disabled")
+		cli.SatIntel()
+		return
+	}
+
+	if envErr != nil {
+		if envErr.Error() == ".env file not found" {
+			fmt.Println("Note: .env file not found.")
 		} else {
-			fmt.Printf("Warning: Error loading .env file: %v\n", err)
-			fmt.Println("Please provide credentials manually:")
+			fmt.Printf("Warning: Error loading .env file: %v\n", envErr)
 		}
-		fmt.Println()
 	} else {
 		fmt.Println("Loaded credentials from .env file")
 	}
 
-	checkEnvironmentalVariable("SPACE_TRACK_USERNAME")
-	checkEnvironmentalVariable("SPACE_TRACK_PASSWORD")
-	checkEnvironmentalVariable("N2YO_API_KEY")
+	if cfgErr != nil {
+		fmt.Printf("Warning: Error loading config file: %v\n", cfgErr)
+	} else if configApplied > 0 {
+		path, _ := osint.ConfigPath()
+		fmt.Printf("Loaded settings from %s\n", path)
+	}
+	fmt.Println()
+
+	nonInteractive := isNonInteractive(term.IsTerminal(int(os.Stdin.Fd())))
+	osint.NonInteractive = nonInteractive
+	osint.ReenterCredentials = reenterSpaceTrackCredentials
+
+	if !nonInteractive && !osint.CredentialsConfigured() && promptOfflineMode() {
+		osint.Offline = true
+		fmt.Println("Offline mode: Space-Track and N2YO features are disabled")
+		cli.SatIntel()
+		return
+	}
+
+	if err := checkCredentials(nonInteractive); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		osint.Exit(osint.ExitAuth)
+	}
 
 	// Validate credentials format and test connections
 	fmt.Println("\nValidating API credentials...")
 	if err := validateCredentials(); err != nil {
 		fmt.Printf("Warning: Credential validation failed: %v\n", err)
 		fmt.Println("You may experience issues when using API features.")
-		fmt.Println("Press Enter to continue anyway, or Ctrl+C to exit and fix credentials...")
-		bufio.NewReader(os.Stdin).ReadBytes('\n')
+		if !nonInteractive {
+			fmt.Println("Press Enter to continue anyway, or Ctrl+C to exit and fix credentials...")
+			bufio.NewReader(os.Stdin).ReadBytes('\n')
+		}
 	}
 
 	cli.SatIntel()
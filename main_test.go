@@ -1,10 +1,13 @@
 package main
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/ANG13T/SatIntel/osint"
 )
 
 func TestIsPasswordField(t *testing.T) {
@@ -379,6 +382,214 @@ func TestLoadEnvFileEdgeCases(t *testing.T) {
 	})
 }
 
+func TestCredentialPrecedence(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	// Environment wins over .env and config
+	t.Setenv("SPACE_TRACK_USERNAME", "envuser")
+	for _, key := range []string{"SPACE_TRACK_PASSWORD", "N2YO_API_KEY", "SATINTEL_THEME"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+
+	envContent := "SPACE_TRACK_USERNAME=dotenvuser\nSPACE_TRACK_PASSWORD=dotenvpass\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".env"), []byte(envContent), 0644); err != nil {
+		t.Fatalf("Failed to create .env file: %v", err)
+	}
+
+	configPath, err := osint.ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error: %v", err)
+	}
+	os.MkdirAll(filepath.Dir(configPath), 0755)
+	configContent := `{"space_track_username": "cfguser", "space_track_password": "cfgpass", "n2yo_api_key": "cfgkey"}`
+	if err := os.WriteFile(configPath, []byte(configContent), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	if err := loadEnvFile(); err != nil {
+		t.Fatalf("loadEnvFile() error: %v", err)
+	}
+	cfg, err := osint.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if applied := applyConfig(cfg); applied != 1 {
+		t.Errorf("applyConfig() applied %d values, want 1", applied)
+	}
+
+	expected := map[string]string{
+		"SPACE_TRACK_USERNAME": "envuser",
+		"SPACE_TRACK_PASSWORD": "dotenvpass",
+		"N2YO_API_KEY":         "cfgkey",
+	}
+	for key, want := range expected {
+		if val := os.Getenv(key); val != want {
+			t.Errorf("%s = %q, want %q", key, val, want)
+		}
+	}
+}
+
+func TestApplyConfig_MissingFile(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+	t.Setenv("N2YO_API_KEY", "")
+	os.Unsetenv("N2YO_API_KEY")
+
+	cfg, err := osint.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() with no config file should not error, got: %v", err)
+	}
+	if applied := applyConfig(cfg); applied != 0 {
+		t.Errorf("applyConfig() applied %d values from a missing file, want 0", applied)
+	}
+	if _, found := os.LookupEnv("N2YO_API_KEY"); found {
+		t.Error("N2YO_API_KEY should remain unset")
+	}
+}
+
+func TestParseGlobalFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		envOffline  string
+		wantArgs    []string
+		wantOffline bool
+	}{
+		{"no args", nil, "", nil, false},
+		{"offline flag", []string{"--offline"}, "", nil, true},
+		{"single dash", []string{"-offline"}, "", nil, true},
+		{"flag with command", []string{"--offline", "validate-tle", "a.tle"}, "", []string{"validate-tle", "a.tle"}, true},
+		{"command only", []string{"usage"}, "", []string{"usage"}, false},
+		{"env var", nil, "true", nil, true},
+		{"env var disabled", nil, "0", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SATINTEL_OFFLINE", tt.envOffline)
+			args, offline := parseGlobalFlags(tt.args)
+			if offline != tt.wantOffline {
+				t.Errorf("offline = %v, want %v", offline, tt.wantOffline)
+			}
+			if strings.Join(args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestParseYesFlag(t *testing.T) {
+	args, yes := parseYesFlag([]string{"--yes", "validate-tle", "a.tle"})
+	if !yes || strings.Join(args, " ") != "validate-tle a.tle" {
+		t.Errorf("parseYesFlag() = %v, %v", args, yes)
+	}
+	if _, yes := parseYesFlag([]string{"usage"}); yes {
+		t.Error("parseYesFlag() reported --yes without the flag")
+	}
+}
+
+func TestParseFlag_LocalCatalog(t *testing.T) {
+	args, local := parseFlag([]string{"--local-catalog", "--yes"}, "--local-catalog")
+	if !local || strings.Join(args, " ") != "--yes" {
+		t.Errorf("parseFlag() = %v, %v", args, local)
+	}
+}
+
+func TestCheckCredentials_NonInteractive(t *testing.T) {
+	t.Setenv("SPACE_TRACK_USERNAME", "user")
+	t.Setenv("SPACE_TRACK_PASSWORD", "")
+	os.Unsetenv("SPACE_TRACK_PASSWORD")
+	t.Setenv("N2YO_API_KEY", "")
+	os.Unsetenv("N2YO_API_KEY")
+	t.Setenv("SATINTEL_NONINTERACTIVE", "")
+
+	// Stdin holds a value that a prompt would consume
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() failed: %v", err)
+	}
+	writer.WriteString("from-stdin\n")
+	writer.Close()
+	originalStdin := os.Stdin
+	os.Stdin = reader
+	t.Cleanup(func() {
+		os.Stdin = originalStdin
+		reader.Close()
+	})
+
+	err = checkCredentials(isNonInteractive(false))
+	if err == nil {
+		t.Fatal("Expected an error for missing credentials in non-interactive mode")
+	}
+	for _, key := range []string{"SPACE_TRACK_PASSWORD", "N2YO_API_KEY"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Error should name %s, got %q", key, err)
+		}
+	}
+	if strings.Contains(err.Error(), "SPACE_TRACK_USERNAME") {
+		t.Errorf("Error should not name variables that are set, got %q", err)
+	}
+
+	rest, _ := io.ReadAll(reader)
+	if string(rest) != "from-stdin\n" {
+		t.Errorf("stdin should not be read, remaining input %q", rest)
+	}
+	if _, found := os.LookupEnv("SPACE_TRACK_PASSWORD"); found {
+		t.Error("No credential should have been set")
+	}
+}
+
+func TestCheckCredentials_SetButEmpty(t *testing.T) {
+	t.Setenv("SPACE_TRACK_USERNAME", "")
+	t.Setenv("SPACE_TRACK_PASSWORD", "pass")
+	t.Setenv("N2YO_API_KEY", "key")
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() failed: %v", err)
+	}
+	writer.WriteString("prompted-user\n")
+	writer.Close()
+	originalStdin := os.Stdin
+	os.Stdin = reader
+	t.Cleanup(func() {
+		os.Stdin = originalStdin
+		reader.Close()
+	})
+
+	if err := checkCredentials(false); err != nil {
+		t.Fatalf("checkCredentials() failed: %v", err)
+	}
+	if got := os.Getenv("SPACE_TRACK_USERNAME"); got != "prompted-user" {
+		t.Errorf("SPACE_TRACK_USERNAME = %q, want the prompted value", got)
+	}
+}
+
+func TestIsNonInteractive(t *testing.T) {
+	t.Setenv("SATINTEL_NONINTERACTIVE", "")
+	if isNonInteractive(true) {
+		t.Error("A terminal without the flag should be interactive")
+	}
+	t.Setenv("SATINTEL_NONINTERACTIVE", "1")
+	if !isNonInteractive(true) {
+		t.Error("SATINTEL_NONINTERACTIVE=1 should force non-interactive mode")
+	}
+
+	t.Setenv("SATINTEL_NONINTERACTIVE", "")
+	if !isNonInteractive(false) {
+		t.Error("A non-terminal stdin should be non-interactive")
+	}
+}
+
 // Benchmark tests
 func BenchmarkIsPasswordField(b *testing.B) {
 	testCases := []string{
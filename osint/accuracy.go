@@ -0,0 +1,138 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// maxN2YOPositionSeconds is the longest window the N2YO positions endpoint returns.
+const maxN2YOPositionSeconds = 300
+
+// AccuracyStats summarizes how far SGP4 predictions drift from N2YO positions over a window.
+// Deltas are N2YO minus SGP4, like PositionDiscrepancy.
+type AccuracyStats struct {
+	NORADID string
+	Samples int
+	Start   time.Time
+	End     time.Time
+
+	RMSLatitude      float64 // degrees
+	RMSLongitude     float64 // degrees
+	RMSAltitude      float64 // km
+	RMSGroundDistKm  float64
+	MaxGroundDistKm  float64
+	MeanAltitudeBias float64 // km; positive when N2YO reports a higher orbit
+}
+
+// GoodEnoughForOffline reports whether the offline predictor stays within the distance at which
+// a position check would flag the TLE as stale.
+func (s AccuracyStats) GoodEnoughForOffline() bool {
+	return s.MaxGroundDistKm <= staleTLEThresholdKm
+}
+
+// AccuracyReport compares SGP4 against N2YO for one satellite over samples one-second steps
+// beginning at start. Both sides use the TLE N2YO currently holds, so the result measures the
+// propagators rather than element set age. N2YO only serves positions from the current time
+// onwards, so start may be at most a few minutes ahead and a start in the past means now.
+func AccuracyReport(norad string, observer ObserverPosition, start time.Time, samples int) (AccuracyStats, error) {
+	offset := 0
+	if ahead := time.Until(start); ahead > 0 {
+		offset = int(math.Ceil(ahead.Seconds()))
+	}
+	if samples < 1 || offset+samples > maxN2YOPositionSeconds {
+		return AccuracyStats{}, NewAppErrorWithContext(ErrCodeInputInvalid, "Accuracy window is outside what N2YO can serve",
+			fmt.Sprintf("Samples: %d, start in %ds, N2YO returns at most %d seconds from now", samples, offset, maxN2YOPositionSeconds))
+	}
+
+	positions, err := FetchSatellitePositions(
+		norad,
+		strconv.FormatFloat(observer.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(observer.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(observer.Altitude, 'f', -1, 64),
+		offset+samples,
+	)
+	if err != nil {
+		return AccuracyStats{}, err
+	}
+
+	tle, err := FetchTLE(norad)
+	if err != nil {
+		return AccuracyStats{}, err
+	}
+	line1, line2, err := tle.Lines()
+	if err != nil {
+		return AccuracyStats{}, err
+	}
+
+	var discrepancies []PositionDiscrepancy
+	for _, pos := range positions.Positions {
+		if pos.Timestamp < start.Unix() {
+			continue
+		}
+		predicted, err := CalculateSGP4PositionWithObserver(line1, line2, time.Unix(pos.Timestamp, 0).UTC(), observer)
+		if err != nil {
+			return AccuracyStats{}, err
+		}
+		discrepancies = append(discrepancies, comparePositions(norad, pos, predicted))
+		if len(discrepancies) == samples {
+			break
+		}
+	}
+	if len(discrepancies) == 0 {
+		return AccuracyStats{}, NewAppErrorWithContext(ErrCodeAPIResponseFailed, "N2YO returned no positions in the window", fmt.Sprintf("NORAD ID: %s", norad))
+	}
+	return accuracyStats(norad, discrepancies), nil
+}
+
+// accuracyStats reduces per-sample discrepancies to RMS figures.
+func accuracyStats(norad string, discrepancies []PositionDiscrepancy) AccuracyStats {
+	stats := AccuracyStats{
+		NORADID: norad,
+		Samples: len(discrepancies),
+		Start:   time.Unix(discrepancies[0].Timestamp, 0).UTC(),
+		End:     time.Unix(discrepancies[len(discrepancies)-1].Timestamp, 0).UTC(),
+	}
+	var lat, lon, alt, dist, bias float64
+	for _, d := range discrepancies {
+		lat += d.LatitudeDelta * d.LatitudeDelta
+		lon += d.LongitudeDelta * d.LongitudeDelta
+		alt += d.AltitudeDelta * d.AltitudeDelta
+		dist += d.GroundDistanceKm * d.GroundDistanceKm
+		bias += d.AltitudeDelta
+		stats.MaxGroundDistKm = math.Max(stats.MaxGroundDistKm, d.GroundDistanceKm)
+	}
+	n := float64(len(discrepancies))
+	stats.RMSLatitude = math.Sqrt(lat / n)
+	stats.RMSLongitude = math.Sqrt(lon / n)
+	stats.RMSAltitude = math.Sqrt(alt / n)
+	stats.RMSGroundDistKm = math.Sqrt(dist / n)
+	stats.MeanAltitudeBias = bias / n
+	return stats
+}
+
+// PrintAccuracyReport displays an SGP4 accuracy report in a formatted table.
+func PrintAccuracyReport(s AccuracyStats) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║              SGP4 Accuracy vs N2YO                          ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("NORAD ID", s.NORADID)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Window (UTC)", s.Start.Format("2006-01-02 15:04:05")+" - "+s.End.Format("15:04:05"))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Samples", strconv.Itoa(s.Samples))))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("RMS Latitude (degrees)", FormatQuantity(QuantityCoordinate, s.RMSLatitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("RMS Longitude (degrees)", FormatQuantity(QuantityCoordinate, s.RMSLongitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("RMS Altitude (km)", FormatQuantity(QuantityAltitude, s.RMSAltitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Mean Altitude Bias (km)", FormatQuantity(QuantityAltitude, s.MeanAltitudeBias))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("RMS Ground Distance (km)", fmt.Sprintf("%.2f", s.RMSGroundDistKm))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Max Ground Distance (km)", fmt.Sprintf("%.2f", s.MaxGroundDistKm))))
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+
+	if s.GoodEnoughForOffline() {
+		fmt.Println(Colorize(RoleSuccess, "  [+] SGP4 agrees with N2YO closely enough for offline use"))
+	} else {
+		fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] SGP4 drifts more than %.0f km from N2YO; prefer N2YO for this satellite", staleTLEThresholdKm)))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,94 @@
+package osint
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccuracyReport(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.0, Longitude: -74.0}
+	t0 := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+
+	// N2YO positions offset from SGP4 by alternating latitude/longitude errors and a constant
+	// altitude bias. The first sample is before the requested start and must be skipped.
+	mocked := Response{SatelliteInfo: SatelliteInfo{Satname: "SPACE STATION", Satid: 25544}}
+	for i := 0; i < 6; i++ {
+		at := t0.Add(time.Duration(i) * time.Second)
+		predicted, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, at, observer)
+		if err != nil {
+			t.Fatalf("SGP4 propagation failed: %v", err)
+		}
+		latOffset, lonOffset := 0.3, 0.3
+		if i%2 == 0 {
+			latOffset, lonOffset = -0.3, 0.4
+		}
+		mocked.Positions = append(mocked.Positions, Position{
+			Satlatitude:  predicted.Position.Latitude + latOffset,
+			Satlongitude: predicted.Position.Longitude + lonOffset,
+			Sataltitude:  predicted.Position.Altitude + 2.0,
+			Timestamp:    at.Unix(),
+		})
+	}
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/positions/25544/"):
+			json.NewEncoder(w).Encode(mocked)
+		case strings.HasPrefix(r.URL.Path, "/tle/25544"):
+			json.NewEncoder(w).Encode(N2YOTLEResponse{
+				Info: SatelliteInfo{Satname: "SPACE STATION", Satid: 25544},
+				TLE:  testTLELine1 + "\r\n" + testTLELine2,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	stats, err := AccuracyReport("25544", observer, t0.Add(time.Second), 4)
+	if err != nil {
+		t.Fatalf("AccuracyReport() failed: %v", err)
+	}
+
+	if stats.Samples != 4 {
+		t.Errorf("Samples = %d, want 4", stats.Samples)
+	}
+	if !stats.Start.Equal(t0.Add(time.Second)) || !stats.End.Equal(t0.Add(4*time.Second)) {
+		t.Errorf("Window = %v - %v, want %v - %v", stats.Start, stats.End, t0.Add(time.Second), t0.Add(4*time.Second))
+	}
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"RMSLatitude", stats.RMSLatitude, 0.3},
+		{"RMSLongitude", stats.RMSLongitude, math.Sqrt((0.3*0.3 + 0.4*0.4) / 2)},
+		{"RMSAltitude", stats.RMSAltitude, 2.0},
+		{"MeanAltitudeBias", stats.MeanAltitudeBias, 2.0},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-6 {
+			t.Errorf("%s = %.6f, want %.6f", c.name, c.got, c.want)
+		}
+	}
+	if stats.RMSGroundDistKm <= 0 || stats.RMSGroundDistKm > stats.MaxGroundDistKm {
+		t.Errorf("RMSGroundDistKm = %.2f, MaxGroundDistKm = %.2f", stats.RMSGroundDistKm, stats.MaxGroundDistKm)
+	}
+	if !stats.GoodEnoughForOffline() {
+		t.Errorf("Expected sub-degree offsets to be within the offline threshold, max = %.2f km", stats.MaxGroundDistKm)
+	}
+}
+
+func TestAccuracyReport_WindowTooLong(t *testing.T) {
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("N2YO should not be queried for an invalid window")
+	})
+
+	if _, err := AccuracyReport("25544", ObserverPosition{}, time.Now(), maxN2YOPositionSeconds+1); err == nil {
+		t.Error("Expected error for more samples than N2YO returns")
+	}
+	if _, err := AccuracyReport("25544", ObserverPosition{}, time.Now(), 0); err == nil {
+		t.Error("Expected error for zero samples")
+	}
+}
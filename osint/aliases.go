@@ -0,0 +1,124 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// satcatChange is one rename or re-designation recorded in Space-Track's satcat_change class.
+type satcatChange struct {
+	NORADCatID      string `json:"NORAD_CAT_ID"`
+	CurrentName     string `json:"CURRENT_NAME"`
+	PreviousName    string `json:"PREVIOUS_NAME"`
+	CurrentIntldes  string `json:"CURRENT_INTLDES"`
+	PreviousIntldes string `json:"PREVIOUS_INTLDES"`
+	ChangeMade      string `json:"CHANGE_MADE"`
+}
+
+// satcatChangesEndpoint is the query for catalog changes matching the filter path segments.
+func satcatChangesEndpoint(filter string) string {
+	return "/class/satcat_change" + filter + "/orderby/CHANGE_MADE%20desc/format/json"
+}
+
+// querySatcatChanges fetches catalog changes matching the query path segments.
+func querySatcatChanges(client *http.Client, filter string) ([]satcatChange, error) {
+	data, err := QuerySpaceTrack(client, satcatChangesEndpoint(filter))
+	if err != nil {
+		return nil, err
+	}
+	return parseSatcatChanges(data)
+}
+
+// parseSatcatChanges decodes a satcat_change response.
+func parseSatcatChanges(data string) ([]satcatChange, error) {
+	var changes []satcatChange
+	if err := json.Unmarshal([]byte(data), &changes); err != nil {
+		return nil, NewAppErrorWithErr(ErrCodeAPIParseFailed, "Failed to parse catalog change history", err)
+	}
+	return changes, nil
+}
+
+// ResolveAliases returns the names a satellite was cataloged under before its current name,
+// most recent first. Objects that were never renamed have no aliases.
+func ResolveAliases(client *http.Client, norad string) ([]string, error) {
+	changes, err := querySatcatChanges(client, "/NORAD_CAT_ID/"+url.PathEscape(strings.TrimSpace(norad)))
+	if err != nil {
+		return nil, err
+	}
+
+	var current string
+	if len(changes) > 0 {
+		current = changes[0].CurrentName
+	}
+	seen := map[string]bool{current: true, "": true}
+	var aliases []string
+	for _, change := range changes {
+		name := strings.TrimSpace(change.PreviousName)
+		if !seen[name] {
+			seen[name] = true
+			aliases = append(aliases, name)
+		}
+	}
+	return aliases, nil
+}
+
+// findRenamedSatellites maps the NORAD IDs of satellites formerly named like searchName to the
+// matching former name, so searches by an old name still find renamed objects. The lookup is
+// cached like other catalog queries.
+func findRenamedSatellites(session *spaceTrackSession, searchName string) (map[string]string, error) {
+	data, _, err := queryCatalog(session, satcatChangesEndpoint("/PREVIOUS_NAME/~~"+url.PathEscape(searchName)))
+	if err != nil {
+		return nil, err
+	}
+	changes, err := parseSatcatChanges(data)
+	if err != nil {
+		return nil, err
+	}
+	renamed := make(map[string]string)
+	for _, change := range changes {
+		if _, found := renamed[change.NORADCatID]; !found && strings.Contains(strings.ToLower(change.PreviousName), strings.ToLower(searchName)) {
+			renamed[change.NORADCatID] = change.PreviousName
+		}
+	}
+	return renamed, nil
+}
+
+// fetchRenamedSatellites returns the catalog entries of the satellites in formerNames that pass
+// the search filters. At most NameSearchLimit IDs are looked up.
+func fetchRenamedSatellites(session *spaceTrackSession, formerNames map[string]string, country, objectType, launchYear string) ([]Satellite, error) {
+	if len(formerNames) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(formerNames))
+	for id := range formerNames {
+		ids = append(ids, url.PathEscape(id))
+	}
+	sort.Strings(ids)
+	if len(ids) > NameSearchLimit {
+		ids = ids[:NameSearchLimit]
+	}
+
+	data, _, err := queryCatalog(session, buildSatcatIDQuery(ids, country, objectType, launchYear))
+	if err != nil {
+		return nil, err
+	}
+	var sats []Satellite
+	if err := decodeSpaceTrackJSON(data, &sats); err != nil {
+		return nil, NewAppErrorWithErr(ErrCodeAPIParseFailed, "Failed to parse renamed satellites", err)
+	}
+	return sats, nil
+}
+
+// printAliases notes the former names of a satellite. Lookup failures are not worth interrupting
+// the display for and are ignored.
+func printAliases(client *http.Client, norad string) {
+	aliases, err := ResolveAliases(client, norad)
+	if err != nil || len(aliases) == 0 {
+		return
+	}
+	fmt.Println(Colorize(RoleInfo, "  [*] Previously cataloged as: "+strings.Join(aliases, ", ")))
+}
@@ -0,0 +1,105 @@
+package osint
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// satcatChangeFixture is a catalog entry renamed twice, plus an unrelated rename.
+const satcatChangeFixture = `[
+	{"NORAD_CAT_ID":"39084","CURRENT_NAME":"LANDSAT 8","PREVIOUS_NAME":"LDCM","CURRENT_INTLDES":"2013-008A","PREVIOUS_INTLDES":"2013-008A","CHANGE_MADE":"2013-05-30 00:00:00"},
+	{"NORAD_CAT_ID":"39084","CURRENT_NAME":"LDCM","PREVIOUS_NAME":"LANDSAT DATA CONTINUITY MISSION","CURRENT_INTLDES":"2013-008A","PREVIOUS_INTLDES":"2013-008A","CHANGE_MADE":"2013-02-12 00:00:00"},
+	{"NORAD_CAT_ID":"39084","CURRENT_NAME":"LANDSAT 8","PREVIOUS_NAME":"LDCM","CURRENT_INTLDES":"2013-008A","PREVIOUS_INTLDES":"2013-008A","CHANGE_MADE":"2013-02-11 00:00:00"},
+	{"NORAD_CAT_ID":"25994","CURRENT_NAME":"TERRA","PREVIOUS_NAME":"EOS AM-1","CURRENT_INTLDES":"1999-068A","PREVIOUS_INTLDES":"1999-068A","CHANGE_MADE":"2000-01-05 00:00:00"}
+]`
+
+// satcatChangesFor returns the fixture rows for one NORAD ID, as a query by ID would.
+func satcatChangesFor(t *testing.T, norad string) []byte {
+	t.Helper()
+	var changes []satcatChange
+	if err := json.Unmarshal([]byte(satcatChangeFixture), &changes); err != nil {
+		t.Fatal(err)
+	}
+	var matching []satcatChange
+	for _, change := range changes {
+		if change.NORADCatID == norad {
+			matching = append(matching, change)
+		}
+	}
+	data, _ := json.Marshal(matching)
+	return data
+}
+
+func TestResolveAliases(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/class/satcat_change/NORAD_CAT_ID/39084/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(satcatChangesFor(t, "39084"))
+	})
+
+	aliases, err := ResolveAliases(client, "39084")
+	if err != nil {
+		t.Fatalf("ResolveAliases() failed: %v", err)
+	}
+	if got := strings.Join(aliases, "|"); got != "LDCM|LANDSAT DATA CONTINUITY MISSION" {
+		t.Errorf("ResolveAliases() = %v, want former names most recent first without duplicates", aliases)
+	}
+}
+
+func TestResolveAliases_NeverRenamed(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	})
+
+	aliases, err := ResolveAliases(client, "25544")
+	if err != nil || len(aliases) != 0 {
+		t.Errorf("ResolveAliases() = %v, %v; want no aliases", aliases, err)
+	}
+}
+
+func TestFindRenamedSatellites(t *testing.T) {
+	t.Setenv(cacheDirEnv, t.TempDir())
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/class/satcat_change/PREVIOUS_NAME/~~ldcm/") {
+			t.Errorf("unexpected query %s", r.URL.Path)
+		}
+		w.Write([]byte(satcatChangeFixture))
+	})
+
+	renamed, err := findRenamedSatellites(loggedInSession(client), "ldcm")
+	if err != nil {
+		t.Fatalf("findRenamedSatellites() failed: %v", err)
+	}
+	if len(renamed) != 1 || renamed["39084"] != "LDCM" {
+		t.Errorf("findRenamedSatellites() = %v, want 39084 formerly LDCM", renamed)
+	}
+	if _, found := renamed["25994"]; found {
+		t.Error("findRenamedSatellites() should exclude renames whose former name does not match")
+	}
+}
+
+func TestFetchRenamedSatellites(t *testing.T) {
+	t.Setenv(cacheDirEnv, t.TempDir())
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/class/satcat/NORAD_CAT_ID/25994,39084/COUNTRY/US/") {
+			t.Errorf("unexpected query %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"SATNAME":"LANDSAT 8","NORAD_CAT_ID":"39084"},{"SATNAME":"TERRA","NORAD_CAT_ID":"25994"}]`))
+	})
+
+	sats, err := fetchRenamedSatellites(loggedInSession(client), map[string]string{"39084": "LDCM", "25994": "EOS AM-1"}, "US", "", "")
+	if err != nil {
+		t.Fatalf("fetchRenamedSatellites() failed: %v", err)
+	}
+	if len(sats) != 2 || sats[0].SATNAME != "LANDSAT 8" {
+		t.Errorf("fetchRenamedSatellites() = %+v", sats)
+	}
+
+	if sats, err := fetchRenamedSatellites(loggedInSession(client), nil, "", "", ""); sats != nil || err != nil {
+		t.Errorf("fetchRenamedSatellites() without renames = %v, %v; want no query", sats, err)
+	}
+}
@@ -0,0 +1,99 @@
+package osint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Environment variables that override where SatIntel keeps its files.
+const (
+	dataDirEnv   = "SATINTEL_DATA_DIR"
+	configDirEnv = "SATINTEL_CONFIG_DIR"
+	cacheDirEnv  = "SATINTEL_CACHE_DIR"
+)
+
+// legacyDataDirName is the data directory in the home directory used by earlier releases.
+// It is kept when it exists so saved favorites and history are not lost.
+const legacyDataDirName = ".satintel"
+
+// runtimeGOOS is the platform used to pick default directories. Tests override it.
+var runtimeGOOS = runtime.GOOS
+
+// DataDir returns the directory for persistent state such as favorites and usage counters.
+// SATINTEL_DATA_DIR wins; otherwise an existing ~/.satintel is used, then the platform's data
+// directory: $XDG_DATA_HOME/satintel (default ~/.local/share/satintel) on Linux,
+// ~/Library/Application Support/SatIntel on macOS and %LOCALAPPDATA%\SatIntel on Windows.
+func DataDir() (string, error) {
+	if dir := os.Getenv(dataDirEnv); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	legacy := filepath.Join(home, legacyDataDirName)
+	if info, err := os.Stat(legacy); err == nil && info.IsDir() {
+		return legacy, nil
+	}
+	return platformDataDir(home), nil
+}
+
+// platformDataDir returns the conventional data directory for the current platform.
+func platformDataDir(home string) string {
+	switch runtimeGOOS {
+	case "windows":
+		for _, key := range []string{"LOCALAPPDATA", "APPDATA"} {
+			if dir := os.Getenv(key); dir != "" {
+				return filepath.Join(dir, "SatIntel")
+			}
+		}
+		return filepath.Join(home, "AppData", "Local", "SatIntel")
+	case "darwin":
+		return filepath.Join(home, "Library", "Application Support", "SatIntel")
+	default:
+		// The XDG spec says relative paths are invalid and must be ignored.
+		if dir := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(dir) {
+			return filepath.Join(dir, "satintel")
+		}
+		return filepath.Join(home, ".local", "share", "satintel")
+	}
+}
+
+// ConfigDir returns the directory holding config.json: SATINTEL_CONFIG_DIR, or satintel in the
+// user config directory ($XDG_CONFIG_HOME or ~/.config on Linux).
+func ConfigDir() (string, error) {
+	if dir := os.Getenv(configDirEnv); dir != "" {
+		return dir, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user config directory: %w", err)
+	}
+	return filepath.Join(configDir, "satintel"), nil
+}
+
+// CacheDir returns the directory for data that can be refetched, such as cached TLEs:
+// SATINTEL_CACHE_DIR, or satintel in the user cache directory ($XDG_CACHE_HOME or ~/.cache on Linux).
+func CacheDir() (string, error) {
+	if dir := os.Getenv(cacheDirEnv); dir != "" {
+		return dir, nil
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user cache directory: %w", err)
+	}
+	return filepath.Join(cacheDir, "satintel"), nil
+}
+
+// pathIn returns filename inside the directory from resolve, creating the directory if needed.
+// It falls back to the current directory when no directory can be determined.
+func pathIn(resolve func() (string, error), filename string) string {
+	dir, err := resolve()
+	if err != nil {
+		return filename
+	}
+	os.MkdirAll(dir, 0755)
+	return filepath.Join(dir, filename)
+}
@@ -0,0 +1,158 @@
+package osint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestMain clears directory overrides from the developer's environment so tests that point HOME
+// at a temporary directory keep all state inside it.
+func TestMain(m *testing.M) {
+	for _, key := range []string{dataDirEnv, configDirEnv, cacheDirEnv, "XDG_DATA_HOME", "XDG_CACHE_HOME"} {
+		os.Unsetenv(key)
+	}
+	os.Exit(m.Run())
+}
+
+// withGOOS makes directory resolution behave as on goos.
+func withGOOS(t *testing.T, goos string) {
+	t.Helper()
+	original := runtimeGOOS
+	runtimeGOOS = goos
+	t.Cleanup(func() { runtimeGOOS = original })
+}
+
+func TestDataDir(t *testing.T) {
+	tests := []struct {
+		name   string
+		goos   string
+		env    map[string]string
+		legacy bool
+		want   func(home string) string
+	}{
+		{
+			name: "linux default",
+			goos: "linux",
+			want: func(home string) string { return filepath.Join(home, ".local", "share", "satintel") },
+		},
+		{
+			name: "linux XDG_DATA_HOME",
+			goos: "linux",
+			env:  map[string]string{"XDG_DATA_HOME": "/srv/data"},
+			want: func(home string) string { return filepath.Join("/srv/data", "satintel") },
+		},
+		{
+			name: "relative XDG_DATA_HOME is ignored",
+			goos: "linux",
+			env:  map[string]string{"XDG_DATA_HOME": "data"},
+			want: func(home string) string { return filepath.Join(home, ".local", "share", "satintel") },
+		},
+		{
+			name: "macOS",
+			goos: "darwin",
+			env:  map[string]string{"XDG_DATA_HOME": "/srv/data"},
+			want: func(home string) string { return filepath.Join(home, "Library", "Application Support", "SatIntel") },
+		},
+		{
+			name: "windows LOCALAPPDATA",
+			goos: "windows",
+			env:  map[string]string{"LOCALAPPDATA": "/win/local", "APPDATA": "/win/roaming"},
+			want: func(home string) string { return filepath.Join("/win/local", "SatIntel") },
+		},
+		{
+			name: "windows APPDATA fallback",
+			goos: "windows",
+			env:  map[string]string{"LOCALAPPDATA": "", "APPDATA": "/win/roaming"},
+			want: func(home string) string { return filepath.Join("/win/roaming", "SatIntel") },
+		},
+		{
+			name:   "existing legacy directory",
+			goos:   "linux",
+			env:    map[string]string{"XDG_DATA_HOME": "/srv/data"},
+			legacy: true,
+			want:   func(home string) string { return filepath.Join(home, ".satintel") },
+		},
+		{
+			name:   "override wins over legacy directory",
+			goos:   "linux",
+			env:    map[string]string{dataDirEnv: "/custom/data", "XDG_DATA_HOME": "/srv/data"},
+			legacy: true,
+			want:   func(home string) string { return "/custom/data" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv("USERPROFILE", home)
+			withGOOS(t, tt.goos)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			if tt.legacy {
+				if err := os.Mkdir(filepath.Join(home, ".satintel"), 0755); err != nil {
+					t.Fatalf("Failed to create legacy directory: %v", err)
+				}
+			}
+
+			got, err := DataDir()
+			if err != nil {
+				t.Fatalf("DataDir() error: %v", err)
+			}
+			if want := tt.want(home); got != want {
+				t.Errorf("DataDir() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigAndCacheDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "config"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(home, "cache"))
+
+	if runtimeGOOS == "linux" {
+		if got, _ := ConfigDir(); got != filepath.Join(home, "config", "satintel") {
+			t.Errorf("ConfigDir() = %q, want XDG_CONFIG_HOME/satintel", got)
+		}
+		if got, _ := CacheDir(); got != filepath.Join(home, "cache", "satintel") {
+			t.Errorf("CacheDir() = %q, want XDG_CACHE_HOME/satintel", got)
+		}
+	}
+
+	t.Setenv(configDirEnv, "/custom/config")
+	t.Setenv(cacheDirEnv, "/custom/cache")
+	if got, _ := ConfigDir(); got != "/custom/config" {
+		t.Errorf("ConfigDir() = %q, want the %s override", got, configDirEnv)
+	}
+	if got, _ := CacheDir(); got != "/custom/cache" {
+		t.Errorf("CacheDir() = %q, want the %s override", got, cacheDirEnv)
+	}
+	if path, _ := ConfigPath(); path != filepath.Join("/custom/config", "config.json") {
+		t.Errorf("ConfigPath() = %q, want config.json in the override directory", path)
+	}
+}
+
+func TestStoresUseAppDirectories(t *testing.T) {
+	dataDir := t.TempDir()
+	cacheDir := t.TempDir()
+	t.Setenv(dataDirEnv, dataDir)
+	t.Setenv(cacheDirEnv, cacheDir)
+
+	if err := saveJSONState("state.json", map[string]int{"a": 1}); err != nil {
+		t.Fatalf("saveJSONState() error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "state.json")); err != nil {
+		t.Errorf("State should be written to the data directory: %v", err)
+	}
+	if getFavoritesPath() != filepath.Join(dataDir, favoritesFile) {
+		t.Errorf("getFavoritesPath() = %q, want it in the data directory", getFavoritesPath())
+	}
+
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+	if _, err := os.Stat(filepath.Join(cacheDir, tleCacheFile)); err != nil {
+		t.Errorf("TLE cache should be written to the cache directory: %v", err)
+	}
+}
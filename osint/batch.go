@@ -4,13 +4,15 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"html"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/TwiN/go-color"
 	"github.com/manifoldco/promptui"
 )
 
@@ -56,6 +58,8 @@ func showBatchMenu() string {
 		"Batch Visual Predictions",
 		"Batch Radio Predictions",
 		"Batch Position Data",
+		"Snapshot Favorites' Current Positions",
+		"Best Favorite Overhead Now",
 		"Cancel",
 	}
 
@@ -66,11 +70,11 @@ func showBatchMenu() string {
 	}
 
 	idx, _, err := prompt.Run()
-	if err != nil || idx == 5 {
+	if err != nil || idx == 7 {
 		return ""
 	}
 
-	options := []string{"tle", "compare", "visual", "radio", "position"}
+	options := []string{"tle", "compare", "visual", "radio", "position", "snapshot", "overhead"}
 	if idx < len(options) {
 		return options[idx]
 	}
@@ -83,7 +87,7 @@ func selectMultipleSatellites() []BatchSatellite {
 	selectedMap := make(map[string]bool) // Track selected NORAD IDs to prevent duplicates
 
 	for {
-		fmt.Println(color.Ize(color.Cyan, "\n  [*] Current selection: "+strconv.Itoa(len(selected))+" satellite(s)"))
+		fmt.Println(Colorize(RoleInfo, "\n  [*] Current selection: "+strconv.Itoa(len(selected))+" satellite(s)"))
 		
 		menuItems := []string{
 			"Add Satellite from Catalog",
@@ -129,10 +133,10 @@ func selectMultipleSatellites() []BatchSatellite {
 									ObjectType: sat.OBJECT_TYPE,
 								})
 								selectedMap[norad] = true
-								fmt.Println(color.Ize(color.Green, "  [+] Added: "+sat.SATNAME))
+								fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+sat.SATNAME))
 							} else {
 								// Fallback with just name and NORAD
-								name := strings.Split(result, " (")[0]
+								name, _ := splitSatelliteLabel(result)
 								selected = append(selected, BatchSatellite{
 									Name:     name,
 									NORADID:  norad,
@@ -140,11 +144,11 @@ func selectMultipleSatellites() []BatchSatellite {
 									ObjectType: "Unknown",
 								})
 								selectedMap[norad] = true
-								fmt.Println(color.Ize(color.Green, "  [+] Added: "+name))
+								fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+name))
 							}
 						} else {
 							// Fallback
-							name := strings.Split(result, " (")[0]
+							name, _ := splitSatelliteLabel(result)
 							selected = append(selected, BatchSatellite{
 								Name:     name,
 								NORADID:  norad,
@@ -152,11 +156,11 @@ func selectMultipleSatellites() []BatchSatellite {
 								ObjectType: "Unknown",
 							})
 							selectedMap[norad] = true
-							fmt.Println(color.Ize(color.Green, "  [+] Added: "+name))
+							fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+name))
 						}
 					} else {
 						// Fallback
-						name := strings.Split(result, " (")[0]
+						name, _ := splitSatelliteLabel(result)
 						selected = append(selected, BatchSatellite{
 							Name:     name,
 							NORADID:  norad,
@@ -164,10 +168,10 @@ func selectMultipleSatellites() []BatchSatellite {
 							ObjectType: "Unknown",
 						})
 						selectedMap[norad] = true
-						fmt.Println(color.Ize(color.Green, "  [+] Added: "+name))
+						fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+name))
 					}
 				} else {
-					fmt.Println(color.Ize(color.Yellow, "  [!] Satellite already in batch"))
+					fmt.Println(Colorize(RoleWarning, "  [!] Satellite already in batch"))
 				}
 			}
 
@@ -201,7 +205,7 @@ func selectMultipleSatellites() []BatchSatellite {
 									ObjectType: sat.OBJECT_TYPE,
 								})
 								selectedMap[norad] = true
-								fmt.Println(color.Ize(color.Green, "  [+] Added: "+sat.SATNAME))
+								fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+sat.SATNAME))
 							} else {
 								selected = append(selected, BatchSatellite{
 									Name:     "NORAD " + norad,
@@ -210,7 +214,7 @@ func selectMultipleSatellites() []BatchSatellite {
 									ObjectType: "Unknown",
 								})
 								selectedMap[norad] = true
-								fmt.Println(color.Ize(color.Green, "  [+] Added: NORAD "+norad))
+								fmt.Println(Colorize(RoleSuccess, "  [+] Added: NORAD "+norad))
 							}
 						} else {
 							selected = append(selected, BatchSatellite{
@@ -220,7 +224,7 @@ func selectMultipleSatellites() []BatchSatellite {
 								ObjectType: "Unknown",
 							})
 							selectedMap[norad] = true
-							fmt.Println(color.Ize(color.Green, "  [+] Added: NORAD "+norad))
+							fmt.Println(Colorize(RoleSuccess, "  [+] Added: NORAD "+norad))
 						}
 					} else {
 						selected = append(selected, BatchSatellite{
@@ -230,10 +234,10 @@ func selectMultipleSatellites() []BatchSatellite {
 							ObjectType: "Unknown",
 						})
 						selectedMap[norad] = true
-						fmt.Println(color.Ize(color.Green, "  [+] Added: NORAD "+norad))
+						fmt.Println(Colorize(RoleSuccess, "  [+] Added: NORAD "+norad))
 					}
 				} else {
-					fmt.Println(color.Ize(color.Yellow, "  [!] Satellite already in batch"))
+					fmt.Println(Colorize(RoleWarning, "  [!] Satellite already in batch"))
 				}
 			}
 
@@ -242,7 +246,7 @@ func selectMultipleSatellites() []BatchSatellite {
 			if favResult != "" {
 				norad := extractNorad(favResult)
 				if !selectedMap[norad] {
-					name := strings.Split(favResult, " (")[0]
+					name, _ := splitSatelliteLabel(favResult)
 					selected = append(selected, BatchSatellite{
 						Name:     name,
 						NORADID:  norad,
@@ -250,15 +254,15 @@ func selectMultipleSatellites() []BatchSatellite {
 						ObjectType: "Unknown",
 					})
 					selectedMap[norad] = true
-					fmt.Println(color.Ize(color.Green, "  [+] Added: "+name))
+					fmt.Println(Colorize(RoleSuccess, "  [+] Added: "+name))
 				} else {
-					fmt.Println(color.Ize(color.Yellow, "  [!] Satellite already in batch"))
+					fmt.Println(Colorize(RoleWarning, "  [!] Satellite already in batch"))
 				}
 			}
 
 		case 3: // Remove Satellite
 			if len(selected) == 0 {
-				fmt.Println(color.Ize(color.Yellow, "  [!] No satellites to remove"))
+				fmt.Println(Colorize(RoleWarning, "  [!] No satellites to remove"))
 				continue
 			}
 			var items []string
@@ -276,14 +280,14 @@ func selectMultipleSatellites() []BatchSatellite {
 				removed := selected[removeIdx]
 				selected = append(selected[:removeIdx], selected[removeIdx+1:]...)
 				delete(selectedMap, removed.NORADID)
-				fmt.Println(color.Ize(color.Green, "  [+] Removed: "+removed.Name))
+				fmt.Println(Colorize(RoleSuccess, "  [+] Removed: "+removed.Name))
 			}
 
 		case 4: // View Selected
 			if len(selected) == 0 {
-				fmt.Println(color.Ize(color.Yellow, "  [!] No satellites selected"))
+				fmt.Println(Colorize(RoleWarning, "  [!] No satellites selected"))
 			} else {
-				fmt.Println(color.Ize(color.Cyan, "\n  Selected Satellites:"))
+				fmt.Println(Colorize(RoleInfo, "\n  Selected Satellites:"))
 				for i, sat := range selected {
 					fmt.Printf("  %d. %s (%s)\n", i+1, sat.Name, sat.NORADID)
 					if sat.Country != "Unknown" {
@@ -297,24 +301,21 @@ func selectMultipleSatellites() []BatchSatellite {
 
 		case 5: // Clear All
 			if len(selected) > 0 {
-				confirmPrompt := promptui.Prompt{
-					Label:     "Clear all satellites? (y/n)",
-					Default:   "n",
-					AllowEdit: true,
-				}
-				confirm, _ := confirmPrompt.Run()
-				if strings.ToLower(strings.TrimSpace(confirm)) == "y" {
+				if confirm("Clear all satellites?", false) {
 					selected = []BatchSatellite{}
 					selectedMap = make(map[string]bool)
-					fmt.Println(color.Ize(color.Green, "  [+] Cleared all satellites"))
+					fmt.Println(Colorize(RoleSuccess, "  [+] Cleared all satellites"))
 				}
 			}
 
 		case 6: // Done
 			if len(selected) == 0 {
-				fmt.Println(color.Ize(color.Red, "  [!] Please select at least one satellite"))
+				fmt.Println(Colorize(RoleError, "  [!] Please select at least one satellite"))
 				continue
 			}
+			for _, sat := range selected {
+				recordRecent(sat.Name, sat.NORADID)
+			}
 			return selected
 		}
 	}
@@ -326,11 +327,14 @@ func BatchDownloadTLE(satellites []BatchSatellite) []BatchTLEResult {
 		return nil
 	}
 
-	fmt.Println(color.Ize(color.Cyan, fmt.Sprintf("\n  [*] Downloading TLE data for %d satellite(s)...", len(satellites))))
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("\n  [*] Downloading TLE data for %d satellite(s)...", len(satellites))))
 
 	client, err := Login()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to login: "+err.Error()))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to login: "+err.Error()))
+		if isNetworkFailure(err) {
+			return cachedBatchTLE(satellites)
+		}
 		return nil
 	}
 
@@ -352,7 +356,13 @@ func BatchDownloadTLE(satellites []BatchSatellite) []BatchTLEResult {
 			endpoint := fmt.Sprintf("/class/gp_history/format/tle/NORAD_CAT_ID/%s/orderby/EPOCH%%20desc/limit/1", satellite.NORADID)
 			data, err := QuerySpaceTrack(client, endpoint)
 			if err != nil {
-				result.Error = err
+				if cached, found := lookupCachedTLE(satellite.NORADID); found && isNetworkFailure(err) {
+					fmt.Println(Colorize(RoleWarning, "  [!] "+satellite.Name+": "+cached.StaleNotice()))
+					result.TLE = ConstructTLE(satellite.Name, cached.Line1, cached.Line2)
+					result.Success = true
+				} else {
+					result.Error = err
+				}
 				mu.Lock()
 				results[idx] = result
 				completed++
@@ -420,6 +430,10 @@ func BatchDownloadTLE(satellites []BatchSatellite) []BatchTLEResult {
 				return
 			}
 
+			storeCachedTLE(satellite.NORADID, lineOne, lineTwo)
+			if appErr := tleChecksumWarning(lineOne, lineTwo); appErr != nil {
+				fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] %s: %s", satellite.Name, appErr.Message)))
+			}
 			result.TLE = tle
 			result.Success = true
 
@@ -428,7 +442,7 @@ func BatchDownloadTLE(satellites []BatchSatellite) []BatchTLEResult {
 			completed++
 			mu.Unlock()
 
-			fmt.Printf(color.Ize(color.Green, "  [+] [%d/%d] Downloaded: %s\n"), completed, len(satellites), satellite.Name)
+			fmt.Printf(Colorize(RoleSuccess, "  [+] [%d/%d] Downloaded: %s\n"), completed, len(satellites), satellite.Name)
 		}(i, sat)
 	}
 
@@ -442,7 +456,7 @@ func BatchDownloadTLE(satellites []BatchSatellite) []BatchTLEResult {
 		}
 	}
 
-	fmt.Println(color.Ize(color.Cyan, fmt.Sprintf("\n  [*] Batch download complete: %d/%d successful", successful, len(satellites))))
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("\n  [*] Batch download complete: %d/%d successful", successful, len(satellites))))
 
 	return results
 }
@@ -526,39 +540,102 @@ func CompareSatellites(results []BatchTLEResult) BatchComparisonResult {
 	return comparison
 }
 
-// DisplayComparison displays the comparison results in a formatted table.
-func DisplayComparison(comparison BatchComparisonResult) {
+// BatchOrder selects the order batch results are listed in.
+type BatchOrder int
+
+const (
+	// OrderInput lists results in the order the satellites were selected.
+	OrderInput BatchOrder = iota
+	OrderByName
+	OrderByNORAD
+	// OrderByInclination lists successful results from lowest to highest inclination, then failures.
+	OrderByInclination
+)
+
+// batchOrderLabels names each BatchOrder in the order prompt.
+var batchOrderLabels = []string{"Selection Order", "Name", "NORAD ID", "Inclination"}
+
+// SortBatchResults returns a copy of results in the given order. Ties keep their selection order,
+// so the same results always list the same way.
+func SortBatchResults(results []BatchTLEResult, order BatchOrder) []BatchTLEResult {
+	sorted := append([]BatchTLEResult(nil), results...)
+	switch order {
+	case OrderByName:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return strings.ToLower(sorted[i].Satellite.Name) < strings.ToLower(sorted[j].Satellite.Name)
+		})
+	case OrderByNORAD:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			a, errA := strconv.Atoi(sorted[i].Satellite.NORADID)
+			b, errB := strconv.Atoi(sorted[j].Satellite.NORADID)
+			if errA != nil || errB != nil {
+				// IDs that are not numbers go last
+				return errA == nil && errB != nil
+			}
+			return a < b
+		})
+	case OrderByInclination:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].Success != sorted[j].Success {
+				return sorted[i].Success
+			}
+			return sorted[i].Success && sorted[i].TLE.OrbitInclination < sorted[j].TLE.OrbitInclination
+		})
+	}
+	return sorted
+}
+
+// promptBatchOrder asks how to order the results of a batch with more than one satellite.
+func promptBatchOrder(count int) BatchOrder {
+	if count < 2 || NonInteractive {
+		return OrderInput
+	}
+	prompt := promptui.Select{
+		Label: "Order results by",
+		Items: batchOrderLabels,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return OrderInput
+	}
+	return BatchOrder(idx)
+}
+
+// DisplayComparison displays the comparison results in a formatted table, listing the individual
+// results in the given order.
+func DisplayComparison(comparison BatchComparisonResult, order BatchOrder) {
 	if len(comparison.Results) == 0 {
-		fmt.Println(color.Ize(color.Yellow, "  [!] No data to compare"))
+		fmt.Println(Colorize(RoleWarning, "  [!] No data to compare"))
 		return
 	}
 
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║              Satellite Comparison Summary                  ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║              Satellite Comparison Summary                  ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 
-	fmt.Println(color.Ize(color.Purple, GenRowString("Total Processed", strconv.Itoa(comparison.Summary.TotalProcessed))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Successful", strconv.Itoa(comparison.Summary.Successful))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Failed", strconv.Itoa(comparison.Summary.Failed))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Total Processed", strconv.Itoa(comparison.Summary.TotalProcessed))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Successful", strconv.Itoa(comparison.Summary.Successful))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Failed", strconv.Itoa(comparison.Summary.Failed))))
 
 	if comparison.Summary.AverageInclination > 0 {
-		fmt.Println(color.Ize(color.Purple, GenRowString("Average Inclination", fmt.Sprintf("%.2f°", comparison.Summary.AverageInclination))))
+		fmt.Println(Colorize(RoleHeader, GenRowString("Average Inclination", fmt.Sprintf("%.2f°", comparison.Summary.AverageInclination))))
 	}
 	if comparison.Summary.AverageMeanMotion > 0 {
-		fmt.Println(color.Ize(color.Purple, GenRowString("Average Mean Motion", fmt.Sprintf("%.4f rev/day", comparison.Summary.AverageMeanMotion))))
+		fmt.Println(Colorize(RoleHeader, GenRowString("Average Mean Motion", fmt.Sprintf("%.4f rev/day", comparison.Summary.AverageMeanMotion))))
 	}
 	if comparison.Summary.LowestAltitude > 0 {
-		fmt.Println(color.Ize(color.Purple, GenRowString("Lowest Altitude (est.)", fmt.Sprintf("%.2f km", comparison.Summary.LowestAltitude))))
+		fmt.Println(Colorize(RoleHeader, GenRowString("Lowest Altitude (est.)", fmt.Sprintf("%.2f km", comparison.Summary.LowestAltitude))))
 	}
 	if comparison.Summary.HighestAltitude > 0 {
-		fmt.Println(color.Ize(color.Purple, GenRowString("Highest Altitude (est.)", fmt.Sprintf("%.2f km", comparison.Summary.HighestAltitude))))
+		fmt.Println(Colorize(RoleHeader, GenRowString("Highest Altitude (est.)", fmt.Sprintf("%.2f km", comparison.Summary.HighestAltitude))))
 	}
 
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Purple, "║                    Individual Results                     ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, "║                    Individual Results                     ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 
-	for i, result := range comparison.Results {
+	ordered := SortBatchResults(comparison.Results, order)
+	for i, result := range ordered {
 		status := "✅ Success"
 		if !result.Success {
 			status = "❌ Failed"
@@ -567,22 +644,22 @@ func DisplayComparison(comparison BatchComparisonResult) {
 			}
 		}
 
-		fmt.Println(color.Ize(color.Purple, GenRowString(fmt.Sprintf("Satellite %d", i+1), result.Satellite.Name)))
-		fmt.Println(color.Ize(color.Purple, GenRowString("  NORAD ID", result.Satellite.NORADID)))
-		fmt.Println(color.Ize(color.Purple, GenRowString("  Status", status)))
+		fmt.Println(Colorize(RoleHeader, GenRowString(fmt.Sprintf("Satellite %d", i+1), result.Satellite.Name)))
+		fmt.Println(Colorize(RoleHeader, GenRowString("  NORAD ID", result.Satellite.NORADID)))
+		fmt.Println(Colorize(RoleHeader, GenRowString("  Status", status)))
 
 		if result.Success {
-			fmt.Println(color.Ize(color.Purple, GenRowString("  Inclination", fmt.Sprintf("%.2f°", result.TLE.OrbitInclination))))
-			fmt.Println(color.Ize(color.Purple, GenRowString("  Mean Motion", fmt.Sprintf("%.4f rev/day", result.TLE.MeanMotion))))
-			fmt.Println(color.Ize(color.Purple, GenRowString("  Eccentricity", fmt.Sprintf("%.6f", result.TLE.Eccentrcity))))
+			fmt.Println(Colorize(RoleHeader, GenRowString("  Inclination", fmt.Sprintf("%.2f°", result.TLE.OrbitInclination))))
+			fmt.Println(Colorize(RoleHeader, GenRowString("  Mean Motion", fmt.Sprintf("%.4f rev/day", result.TLE.MeanMotion))))
+			fmt.Println(Colorize(RoleHeader, GenRowString("  Eccentricity", fmt.Sprintf("%.6f", result.TLE.Eccentrcity))))
 		}
 
-		if i < len(comparison.Results)-1 {
-			fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+		if i < len(ordered)-1 {
+			fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 		}
 	}
 
-	fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 }
 
 // BatchOperations provides the main entry point for batch operations.
@@ -591,6 +668,14 @@ func BatchOperations() {
 	if operation == "" {
 		return
 	}
+	if operation == "snapshot" {
+		SnapshotFavoritesExport()
+		return
+	}
+	if operation == "overhead" {
+		TrackBestOverhead()
+		return
+	}
 
 	satellites := selectMultipleSatellites()
 	if len(satellites) == 0 {
@@ -602,8 +687,9 @@ func BatchOperations() {
 		results := BatchDownloadTLE(satellites)
 		if len(results) > 0 {
 			// Display results
-			fmt.Println(color.Ize(color.Cyan, "\n  [*] Batch TLE Download Results:"))
-			for i, result := range results {
+			order := promptBatchOrder(len(results))
+			fmt.Println(Colorize(RoleInfo, "\n  [*] Batch TLE Download Results:"))
+			for i, result := range SortBatchResults(results, order) {
 				if result.Success {
 					fmt.Printf("\n  %d. %s (%s) - ✅ Success\n", i+1, result.Satellite.Name, result.Satellite.NORADID)
 					PrintTLE(result.TLE)
@@ -618,13 +704,7 @@ func BatchOperations() {
 			}
 
 			// Offer export
-			exportPrompt := promptui.Prompt{
-				Label:     "Export batch results? (y/n)",
-				Default:   "n",
-				AllowEdit: true,
-			}
-			exportAnswer, _ := exportPrompt.Run()
-			if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+			if confirm("Export batch results?", false) {
 				exportBatchTLE(results)
 			}
 		}
@@ -633,34 +713,49 @@ func BatchOperations() {
 		results := BatchDownloadTLE(satellites)
 		if len(results) > 0 {
 			comparison := CompareSatellites(results)
-			DisplayComparison(comparison)
+			DisplayComparison(comparison, promptBatchOrder(len(comparison.Results)))
 
 			// Offer export
-			exportPrompt := promptui.Prompt{
-				Label:     "Export comparison results? (y/n)",
-				Default:   "n",
-				AllowEdit: true,
-			}
-			exportAnswer, _ := exportPrompt.Run()
-			if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+			if confirm("Export comparison results?", false) {
 				exportBatchComparison(comparison)
 			}
 		}
 
 	case "visual", "radio", "position":
-		fmt.Println(color.Ize(color.Yellow, "  [!] Batch predictions and positions coming soon"))
-		// TODO: Implement batch predictions and positions
+		batchN2YO(operation, satellites)
 	}
 }
 
+// cachedBatchTLE builds batch results from cached element sets when Space-Track is unreachable.
+// Satellites without a cached copy are reported as failed.
+func cachedBatchTLE(satellites []BatchSatellite) []BatchTLEResult {
+	results := make([]BatchTLEResult, len(satellites))
+	for i, satellite := range satellites {
+		results[i] = BatchTLEResult{Satellite: satellite}
+		cached, found := lookupCachedTLE(satellite.NORADID)
+		if !found {
+			results[i].Error = fmt.Errorf("Space-Track unreachable and no cached TLE")
+			continue
+		}
+		fmt.Println(Colorize(RoleWarning, "  [!] "+satellite.Name+": "+cached.StaleNotice()))
+		results[i].TLE = ConstructTLE(satellite.Name, cached.Line1, cached.Line2)
+		results[i].Success = true
+	}
+	return results
+}
+
 // exportBatchTLE exports batch TLE results to a file.
 func exportBatchTLE(results []BatchTLEResult) {
 	formatPrompt := promptui.Select{
 		Label: "Select Export Format",
-		Items: []string{"CSV", "JSON", "Text", "Cancel"},
+		Items: []string{"CSV", "JSON", "Text", "Sectioned CSV", "Sectioned JSON", "Combined TLE", "Per-Satellite Files", "Cancel"},
 	}
 	formatIdx, formatChoice, err := formatPrompt.Run()
-	if err != nil || formatIdx == 3 {
+	if err != nil || formatIdx == 7 {
+		return
+	}
+	if formatChoice == "Per-Satellite Files" {
+		exportBatchTLEPerSatellite(results)
 		return
 	}
 
@@ -681,25 +776,35 @@ func exportBatchTLE(results []BatchTLEResult) {
 
 	ext := ""
 	switch formatChoice {
-	case "CSV":
+	case "CSV", "Sectioned CSV":
 		ext = ".csv"
-	case "JSON":
+	case "JSON", "Sectioned JSON":
 		ext = ".json"
 	case "Text":
 		ext = ".txt"
+	case "Combined TLE":
+		ext = ".tle"
 	}
 
-	if !strings.HasSuffix(filePath, ext) {
-		filePath += ext
-	}
+	filePath = compressedPath(withExportExt(filePath, ext))
 
+	var exportErr error
 	switch formatChoice {
 	case "CSV":
-		exportBatchTLECSV(results, filePath)
+		exportErr = exportBatchTLECSV(results, filePath)
 	case "JSON":
-		exportBatchTLEJSON(results, filePath)
+		exportErr = exportBatchTLEJSON(results, filePath)
 	case "Text":
-		exportBatchTLEText(results, filePath)
+		exportErr = exportBatchTLEText(results, filePath)
+	case "Sectioned CSV":
+		exportErr = exportBatchTLESectionedCSV(results, filePath)
+	case "Sectioned JSON":
+		exportErr = exportBatchTLESectionedJSON(results, filePath)
+	case "Combined TLE":
+		exportErr = exportBatchTLENativeCombined(results, filePath)
+	}
+	if exportErr != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+exportErr.Error()))
 	}
 }
 
@@ -707,10 +812,19 @@ func exportBatchTLE(results []BatchTLEResult) {
 func exportBatchComparison(comparison BatchComparisonResult) {
 	formatPrompt := promptui.Select{
 		Label: "Select Export Format",
-		Items: []string{"CSV", "JSON", "Text", "Cancel"},
+		Items: []string{"CSV", "JSON", "JSON Summary Only", "Text", "SVG Chart", "All Formats", "Cancel"},
 	}
 	formatIdx, formatChoice, err := formatPrompt.Run()
-	if err != nil || formatIdx == 3 {
+	if err != nil || formatIdx == 6 {
+		return
+	}
+	if formatChoice == "All Formats" {
+		dir, ok := promptBatchDirectory(fmt.Sprintf("batch_comparison_%s", time.Now().Format("20060102_150405")))
+		if !ok {
+			return
+		}
+		entries, err := exportBatchComparisonFiles(comparison, dir)
+		reportBatchFiles(dir, entries, err)
 		return
 	}
 
@@ -733,36 +847,221 @@ func exportBatchComparison(comparison BatchComparisonResult) {
 	switch formatChoice {
 	case "CSV":
 		ext = ".csv"
-	case "JSON":
+	case "JSON", "JSON Summary Only":
 		ext = ".json"
 	case "Text":
 		ext = ".txt"
+	case "SVG Chart":
+		ext = ".svg"
 	}
 
-	if !strings.HasSuffix(filePath, ext) {
-		filePath += ext
-	}
+	filePath = compressedPath(withExportExt(filePath, ext))
 
 	switch formatChoice {
 	case "CSV":
 		exportBatchComparisonCSV(comparison, filePath)
 	case "JSON":
 		exportBatchComparisonJSON(comparison, filePath)
+	case "JSON Summary Only":
+		if err := exportBatchSummaryJSON(comparison.Summary, filePath); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+		}
 	case "Text":
 		exportBatchComparisonText(comparison, filePath)
+	case "SVG Chart":
+		if err := ExportComparisonSVG(comparison, filePath); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+		}
+	}
+}
+
+// batchManifestFile is the index written next to the files of a multi-file batch export.
+const batchManifestFile = "manifest.json"
+
+// BatchManifestEntry describes one file of a multi-file batch export. Satellites that could not
+// be exported are listed with Success false and no file.
+type BatchManifestEntry struct {
+	File          string `json:"file,omitempty"`
+	SatelliteName string `json:"satellite_name,omitempty"`
+	NORADID       string `json:"norad_id,omitempty"`
+	Format        string `json:"format"`
+	Success       bool   `json:"success"`
+	Error         string `json:"error,omitempty"`
+}
+
+// BatchManifest indexes the files produced by a multi-file batch export.
+type BatchManifest struct {
+	ExportTimestamp string               `json:"export_timestamp"`
+	Files           []BatchManifestEntry `json:"files"`
+}
+
+// writeBatchManifest writes the manifest for entries into dir.
+func writeBatchManifest(dir string, entries []BatchManifestEntry) error {
+	manifest := BatchManifest{
+		ExportTimestamp: time.Now().Format(time.RFC3339),
+		Files:           entries,
 	}
+	jsonData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, batchManifestFile), jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// batchFileName returns a file name for a satellite's export, e.g. "25544_ISS_ZARYA.csv".
+func batchFileName(sat BatchSatellite, ext string) string {
+	words := strings.FieldsFunc(sat.Name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-')
+	})
+	name := strings.Join(words, "_")
+	if name == "" {
+		return sat.NORADID + ext
+	}
+	return sat.NORADID + "_" + name + ext
+}
+
+// exportBatchTLEFiles writes one file per satellite into dir in the given format, followed by a
+// manifest listing every satellite and the file produced for it.
+func exportBatchTLEFiles(results []BatchTLEResult, dir string, format ExportFormat) ([]BatchManifestEntry, error) {
+	exporters := map[ExportFormat]func([]BatchTLEResult, string) error{
+		FormatCSV:  exportBatchTLECSV,
+		FormatJSON: exportBatchTLEJSON,
+		FormatText: exportBatchTLEText,
+	}
+	exporter, ok := exporters[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	var entries []BatchManifestEntry
+	for _, result := range results {
+		entry := BatchManifestEntry{
+			SatelliteName: result.Satellite.Name,
+			NORADID:       result.Satellite.NORADID,
+			Format:        string(format),
+		}
+		if !result.Success {
+			if result.Error != nil {
+				entry.Error = result.Error.Error()
+			}
+			entries = append(entries, entry)
+			continue
+		}
+
+		fileName := compressedPath(batchFileName(result.Satellite, exportExtensions[format]))
+		if err := exporter([]BatchTLEResult{result}, filepath.Join(dir, fileName)); err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.File = fileName
+			entry.Success = true
+		}
+		entries = append(entries, entry)
+	}
+	return entries, writeBatchManifest(dir, entries)
+}
+
+// exportBatchComparisonFiles writes the comparison in every format into dir, followed by a
+// manifest listing the files.
+func exportBatchComparisonFiles(comparison BatchComparisonResult, dir string) ([]BatchManifestEntry, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	outputs := []struct {
+		format string
+		file   string
+		export func(BatchComparisonResult, string) error
+	}{
+		{"CSV", "comparison.csv", exportBatchComparisonCSV},
+		{"JSON", "comparison.json", exportBatchComparisonJSON},
+		{"Text", "comparison.txt", exportBatchComparisonText},
+		{"SVG Chart", "comparison.svg", ExportComparisonSVG},
+	}
+
+	var entries []BatchManifestEntry
+	for _, output := range outputs {
+		entry := BatchManifestEntry{Format: output.format}
+		file := compressedPath(output.file)
+		if err := output.export(comparison, filepath.Join(dir, file)); err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.File = file
+			entry.Success = true
+		}
+		entries = append(entries, entry)
+	}
+	return entries, writeBatchManifest(dir, entries)
+}
+
+// promptBatchDirectory asks for the output directory of a multi-file export.
+func promptBatchDirectory(defaultDir string) (string, bool) {
+	dirPrompt := promptui.Prompt{
+		Label:     "Enter output directory",
+		Default:   defaultDir,
+		AllowEdit: true,
+	}
+	dir, err := dirPrompt.Run()
+	if err != nil {
+		return "", false
+	}
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		dir = defaultDir
+	}
+	return dir, true
+}
+
+// reportBatchFiles prints the outcome of a multi-file batch export.
+func reportBatchFiles(dir string, entries []BatchManifestEntry, err error) {
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+		return
+	}
+	written := 0
+	for _, entry := range entries {
+		if entry.Success {
+			written++
+		}
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Wrote %d of %d files and %s to: %s",
+		written, len(entries), batchManifestFile, dir)))
+}
+
+// exportBatchTLEPerSatellite exports each satellite's TLE to its own file in a directory.
+func exportBatchTLEPerSatellite(results []BatchTLEResult) {
+	formats := []ExportFormat{FormatCSV, FormatJSON, FormatText}
+	formatPrompt := promptui.Select{
+		Label: "Select Format for Each File",
+		Items: []string{string(FormatCSV), string(FormatJSON), string(FormatText), "Cancel"},
+	}
+	formatIdx, _, err := formatPrompt.Run()
+	if err != nil || formatIdx == len(formats) {
+		return
+	}
+
+	dir, ok := promptBatchDirectory(fmt.Sprintf("batch_tle_%s", time.Now().Format("20060102_150405")))
+	if !ok {
+		return
+	}
+	entries, err := exportBatchTLEFiles(results, dir, formats[formatIdx])
+	reportBatchFiles(dir, entries, err)
 }
 
 // exportBatchTLECSV exports batch TLE results to CSV format.
 func exportBatchTLECSV(results []BatchTLEResult, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write header
 	headers := []string{
@@ -808,7 +1107,10 @@ func exportBatchTLECSV(results []BatchTLEResult, filePath string) error {
 		}
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	if err := closeCSVExport(file, writer); err != nil {
+		return err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
 	return nil
 }
 
@@ -847,11 +1149,11 @@ func exportBatchTLEJSON(results []BatchTLEResult, filePath string) error {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
 	return nil
 }
 
@@ -882,24 +1184,179 @@ func exportBatchTLEText(results []BatchTLEResult, filePath string) error {
 		builder.WriteString("\n")
 	}
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// batchSectionTitle names a satellite's section in sectioned exports.
+func batchSectionTitle(index int, result BatchTLEResult) string {
+	return fmt.Sprintf("Satellite %d: %s (%s)", index+1, result.Satellite.Name, result.Satellite.NORADID)
+}
+
+// exportBatchTLESectionedCSV exports batch TLE results to one CSV file in which every satellite
+// is a section: a title row, field/value rows and a blank separator row.
+func exportBatchTLESectionedCSV(results []BatchTLEResult, filePath string) error {
+	file, err := createExportFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	rows := [][]string{
+		{"Batch TLE Download Results"},
+		{"Export Date", time.Now().Format(time.RFC3339)},
+		{"Total Satellites", strconv.Itoa(len(results))},
+		{},
+	}
+	for i, result := range results {
+		rows = append(rows,
+			[]string{"Section", batchSectionTitle(i, result)},
+			[]string{"Satellite Name", result.Satellite.Name},
+			[]string{"NORAD ID", result.Satellite.NORADID},
+			[]string{"Country", result.Satellite.Country},
+			[]string{"Object Type", result.Satellite.ObjectType},
+		)
+		if result.Success {
+			rows = append(rows,
+				[]string{"Status", "Success"},
+				[]string{"Common Name", result.TLE.CommonName},
+				[]string{"Catalog Number", strconv.Itoa(result.TLE.SatelliteCatalogNumber)},
+				[]string{"Epoch", formatEpoch(result.TLE)},
+				[]string{"Inclination", fmt.Sprintf("%.4f", result.TLE.OrbitInclination)},
+				[]string{"Right Ascension", fmt.Sprintf("%.4f", result.TLE.RightAscension)},
+				[]string{"Eccentricity", fmt.Sprintf("%.7f", result.TLE.Eccentrcity)},
+				[]string{"Argument of Perigee", fmt.Sprintf("%.4f", result.TLE.Perigee)},
+				[]string{"Mean Anomaly", fmt.Sprintf("%.4f", result.TLE.MeanAnamoly)},
+				[]string{"Mean Motion", fmt.Sprintf("%.8f", result.TLE.MeanMotion)},
+				[]string{"TLE Line 1", result.TLE.RawLine1},
+				[]string{"TLE Line 2", result.TLE.RawLine2},
+			)
+		} else {
+			errorMsg := ""
+			if result.Error != nil {
+				errorMsg = result.Error.Error()
+			}
+			rows = append(rows, []string{"Status", "Failed"}, []string{"Error", errorMsg})
+		}
+		rows = append(rows, []string{})
+	}
+
+	for _, row := range rows {
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+	}
+
+	if err := closeCSVExport(file, writer); err != nil {
+		return err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// exportBatchTLESectionedJSON exports batch TLE results to one JSON document with a section per
+// satellite, each holding the parsed elements and the original TLE lines.
+func exportBatchTLESectionedJSON(results []BatchTLEResult, filePath string) error {
+	type Section struct {
+		Title     string         `json:"title"`
+		Satellite BatchSatellite `json:"satellite"`
+		Success   bool           `json:"success"`
+		Error     string         `json:"error,omitempty"`
+		TLE       *TLE           `json:"tle,omitempty"`
+		TLELines  []string       `json:"tle_lines,omitempty"`
+	}
+
+	sections := []Section{}
+	successful := 0
+	for i, result := range results {
+		section := Section{
+			Title:     batchSectionTitle(i, result),
+			Satellite: result.Satellite,
+			Success:   result.Success,
+		}
+		if result.Success {
+			successful++
+			tle := result.TLE
+			section.TLE = &tle
+			section.TLELines = nativeTLELines(result)
+		}
+		if result.Error != nil {
+			section.Error = result.Error.Error()
+		}
+		sections = append(sections, section)
+	}
+
+	data := map[string]interface{}{
+		"export_timestamp": time.Now().Format(time.RFC3339),
+		"total_count":      len(results),
+		"successful":       successful,
+		"sections":         sections,
+	}
+
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// nativeTLELines returns the name line and two element lines of a successful result, or nil when
+// the raw lines were not kept.
+func nativeTLELines(result BatchTLEResult) []string {
+	if !result.Success || result.TLE.RawLine1 == "" || result.TLE.RawLine2 == "" {
+		return nil
+	}
+	name := strings.TrimSpace(result.TLE.CommonName)
+	if name == "" {
+		name = result.Satellite.Name
+	}
+	return []string{name, result.TLE.RawLine1, result.TLE.RawLine2}
+}
+
+// exportBatchTLENativeCombined writes every successful satellite's 3-line element set to one
+// file, ready to import into tracking software. Failed satellites are left out.
+func exportBatchTLENativeCombined(results []BatchTLEResult, filePath string) error {
+	var builder strings.Builder
+	written := 0
+	for _, result := range results {
+		lines := nativeTLELines(result)
+		if lines == nil {
+			continue
+		}
+		builder.WriteString(strings.Join(lines, "\n") + "\n")
+		written++
+	}
+	if written == 0 {
+		return NewAppError(ErrCodeAPINoData, "No successful element sets to export")
+	}
+
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported %d element sets to: %s", written, filePath)))
 	return nil
 }
 
 // exportBatchComparisonCSV exports comparison results to CSV format.
 func exportBatchComparisonCSV(comparison BatchComparisonResult, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write summary
 	summaryHeaders := []string{"Metric", "Value"}
@@ -966,7 +1423,10 @@ func exportBatchComparisonCSV(comparison BatchComparisonResult, filePath string)
 		}
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	if err := closeCSVExport(file, writer); err != nil {
+		return err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
 	return nil
 }
 
@@ -983,11 +1443,37 @@ func exportBatchComparisonJSON(comparison BatchComparisonResult, filePath string
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// exportBatchSummaryJSON exports only the summary statistics as a flat JSON object, for
+// dashboards that don't need the per-satellite results.
+func exportBatchSummaryJSON(summary BatchSummary, filePath string) error {
+	data := map[string]interface{}{
+		"total_processed":         summary.TotalProcessed,
+		"successful":              summary.Successful,
+		"failed":                  summary.Failed,
+		"average_inclination_deg": summary.AverageInclination,
+		"average_mean_motion":     summary.AverageMeanMotion,
+		"lowest_altitude_km":      summary.LowestAltitude,
+		"highest_altitude_km":     summary.HighestAltitude,
+	}
+
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
 	return nil
 }
 
@@ -1029,11 +1515,79 @@ func exportBatchComparisonText(comparison BatchComparisonResult, filePath string
 
 	builder.WriteString(fmt.Sprintf("\nExported: %s\n", time.Now().Format(time.RFC3339)))
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
-	fmt.Println(color.Ize(color.Green, "  [+] Exported to: "+filePath))
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
 	return nil
 }
 
+// SVG chart layout in pixels.
+const (
+	svgChartHeight = 300
+	svgBarWidth    = 40
+	svgBarGap      = 20
+	svgMarginLeft  = 60
+	svgMarginTop   = 40
+	svgLabelSpace  = 80
+)
+
+// ExportComparisonSVG writes a standalone SVG bar chart of orbit inclination for each
+// successfully downloaded satellite. Bar heights are proportional to inclination.
+func ExportComparisonSVG(comparison BatchComparisonResult, filePath string) error {
+	var successful []BatchTLEResult
+	maxInclination := 0.0
+	for _, result := range comparison.Results {
+		if !result.Success {
+			continue
+		}
+		successful = append(successful, result)
+		if result.TLE.OrbitInclination > maxInclination {
+			maxInclination = result.TLE.OrbitInclination
+		}
+	}
+	if len(successful) == 0 {
+		return fmt.Errorf("no successful results to chart")
+	}
+
+	width := svgMarginLeft + len(successful)*(svgBarWidth+svgBarGap) + svgBarGap
+	height := svgMarginTop + svgChartHeight + svgLabelSpace
+	baseline := svgMarginTop + svgChartHeight
+
+	var builder strings.Builder
+	builder.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	builder.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif">`+"\n", width, height, width, height))
+	builder.WriteString(`  <rect width="100%" height="100%" fill="white"/>` + "\n")
+	builder.WriteString(fmt.Sprintf(`  <text x="%d" y="24" font-size="16" text-anchor="middle">Orbit Inclination by Satellite (degrees)</text>`+"\n", width/2))
+	builder.WriteString(fmt.Sprintf(`  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", svgMarginLeft, baseline, width, baseline))
+	builder.WriteString(fmt.Sprintf(`  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", svgMarginLeft, svgMarginTop, svgMarginLeft, baseline))
+	builder.WriteString(fmt.Sprintf(`  <text x="%d" y="%d" font-size="12" text-anchor="end">%.1f</text>`+"\n", svgMarginLeft-6, svgMarginTop+4, maxInclination))
+	builder.WriteString(fmt.Sprintf(`  <text x="%d" y="%d" font-size="12" text-anchor="end">0</text>`+"\n", svgMarginLeft-6, baseline+4))
+
+	for i, result := range successful {
+		barHeight := 0.0
+		if maxInclination > 0 {
+			barHeight = result.TLE.OrbitInclination / maxInclination * svgChartHeight
+		}
+		x := svgMarginLeft + svgBarGap + i*(svgBarWidth+svgBarGap)
+		y := float64(baseline) - barHeight
+		name := html.EscapeString(result.Satellite.Name)
+
+		builder.WriteString(fmt.Sprintf(`  <rect class="bar" x="%d" y="%.2f" width="%d" height="%.2f" fill="#7b2cbf"><title>%s: %.4f°</title></rect>`+"\n",
+			x, y, svgBarWidth, barHeight, name, result.TLE.OrbitInclination))
+		builder.WriteString(fmt.Sprintf(`  <text x="%d" y="%.2f" font-size="11" text-anchor="middle">%.1f</text>`+"\n",
+			x+svgBarWidth/2, y-4, result.TLE.OrbitInclination))
+		builder.WriteString(fmt.Sprintf(`  <text x="%d" y="%d" font-size="11" text-anchor="end" transform="rotate(-45 %d %d)">%s</text>`+"\n",
+			x+svgBarWidth/2, baseline+14, x+svgBarWidth/2, baseline+14, name))
+	}
+
+	builder.WriteString("</svg>\n")
+
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
@@ -3,8 +3,11 @@ package osint
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -328,6 +331,50 @@ func TestExportBatchComparisonJSON(t *testing.T) {
 	}
 }
 
+func TestExportBatchSummaryJSON(t *testing.T) {
+	summary := BatchSummary{
+		TotalProcessed:     3,
+		Successful:         2,
+		Failed:             1,
+		AverageInclination: 51.6,
+		AverageMeanMotion:  15.5,
+		LowestAltitude:     408.2,
+		HighestAltitude:    550.1,
+	}
+
+	tempFile := filepath.Join(t.TempDir(), "summary.json")
+	if err := exportBatchSummaryJSON(summary, tempFile); err != nil {
+		t.Fatalf("exportBatchSummaryJSON() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(tempFile)
+	if err != nil {
+		t.Fatalf("Failed to read JSON file: %v", err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Failed to parse JSON: %v", err)
+	}
+
+	want := map[string]float64{
+		"total_processed":         3,
+		"successful":              2,
+		"failed":                  1,
+		"average_inclination_deg": 51.6,
+		"average_mean_motion":     15.5,
+		"lowest_altitude_km":      408.2,
+		"highest_altitude_km":     550.1,
+	}
+	if len(result) != len(want) {
+		t.Errorf("JSON has keys %v, want only the summary keys", result)
+	}
+	for key, value := range want {
+		if got, ok := result[key].(float64); !ok || got != value {
+			t.Errorf("%s = %v, want %v", key, result[key], value)
+		}
+	}
+}
+
 func TestExportBatchComparisonText(t *testing.T) {
 	comparison := BatchComparisonResult{
 		Summary: BatchSummary{
@@ -365,6 +412,67 @@ func TestExportBatchComparisonText(t *testing.T) {
 	}
 }
 
+func TestExportComparisonSVG(t *testing.T) {
+	comparison := BatchComparisonResult{
+		Results: []BatchTLEResult{
+			{Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"}, Success: true, TLE: TLE{OrbitInclination: 51.6}},
+			{Satellite: BatchSatellite{Name: "Failed Sat", NORADID: "99999"}, Success: false},
+			{Satellite: BatchSatellite{Name: "NOAA <18>", NORADID: "28654"}, Success: true, TLE: TLE{OrbitInclination: 103.2}},
+		},
+	}
+
+	tempFile := filepath.Join(t.TempDir(), "comparison.svg")
+	if err := ExportComparisonSVG(comparison, tempFile); err != nil {
+		t.Fatalf("ExportComparisonSVG() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(tempFile)
+	if err != nil {
+		t.Fatalf("Failed to read SVG file: %v", err)
+	}
+	svg := string(content)
+
+	if !strings.Contains(svg, "<svg") || !strings.HasSuffix(strings.TrimSpace(svg), "</svg>") {
+		t.Error("Output should be a standalone SVG document")
+	}
+	if strings.Contains(svg, "Failed Sat") {
+		t.Error("Failed satellites should not be charted")
+	}
+	if !strings.Contains(svg, "NOAA &lt;18&gt;") {
+		t.Error("Satellite names should be XML-escaped")
+	}
+
+	re := regexp.MustCompile(`<rect class="bar" x="\d+" y="[\d.]+" width="\d+" height="([\d.]+)"`)
+	matches := re.FindAllStringSubmatch(svg, -1)
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 bars, got %d", len(matches))
+	}
+
+	var heights []float64
+	for _, m := range matches {
+		h, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			t.Fatalf("Invalid bar height %q", m[1])
+		}
+		heights = append(heights, h)
+	}
+	// 51.6 is half of 103.2, so the first bar should be half as tall
+	if ratio := heights[0] / heights[1]; math.Abs(ratio-0.5) > 0.001 {
+		t.Errorf("Expected bar height ratio 0.5, got %f (heights %v)", ratio, heights)
+	}
+}
+
+func TestExportComparisonSVG_NoSuccessfulResults(t *testing.T) {
+	comparison := BatchComparisonResult{
+		Results: []BatchTLEResult{{Satellite: BatchSatellite{Name: "Failed"}, Success: false}},
+	}
+
+	tempFile := filepath.Join(t.TempDir(), "empty.svg")
+	if err := ExportComparisonSVG(comparison, tempFile); err == nil {
+		t.Error("Expected error when there is nothing to chart")
+	}
+}
+
 func TestBatchTLEResultStruct(t *testing.T) {
 	result := BatchTLEResult{
 		Satellite: BatchSatellite{
@@ -458,3 +566,220 @@ func BenchmarkExportBatchTLEJSON(b *testing.B) {
 	}
 }
 
+func TestExportBatchTLEFiles_WritesManifest(t *testing.T) {
+	results := []BatchTLEResult{
+		{
+			Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"},
+			Success:   true,
+			TLE:       TLE{CommonName: "ISS (ZARYA)", SatelliteCatalogNumber: 25544, MeanMotion: 15.5},
+		},
+		{
+			Satellite: BatchSatellite{Name: "Failed Sat", NORADID: "12346"},
+			Success:   false,
+			Error:     fmt.Errorf("test error"),
+		},
+	}
+
+	dir := filepath.Join(t.TempDir(), "batch")
+	entries, err := exportBatchTLEFiles(results, dir, FormatJSON)
+	if err != nil {
+		t.Fatalf("exportBatchTLEFiles() failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, batchManifestFile))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	var manifest BatchManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Manifest is not valid JSON: %v", err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Fatalf("Manifest lists %d files, want 2", len(manifest.Files))
+	}
+
+	ok := manifest.Files[0]
+	if ok.File != "25544_ISS_ZARYA.json" || ok.NORADID != "25544" || ok.Format != "JSON" || !ok.Success {
+		t.Errorf("Unexpected manifest entry for ISS: %+v", ok)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ok.File)); err != nil {
+		t.Errorf("Listed file was not written: %v", err)
+	}
+
+	failed := manifest.Files[1]
+	if failed.Success || failed.File != "" || failed.Error != "test error" || failed.SatelliteName != "Failed Sat" {
+		t.Errorf("Unexpected manifest entry for failed satellite: %+v", failed)
+	}
+}
+
+func TestExportBatchComparisonFiles_WritesManifest(t *testing.T) {
+	comparison := CompareSatellites([]BatchTLEResult{
+		{
+			Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"},
+			Success:   true,
+			TLE:       TLE{CommonName: "ISS (ZARYA)", SatelliteCatalogNumber: 25544, OrbitInclination: 51.6, MeanMotion: 15.5},
+		},
+	})
+
+	dir := t.TempDir()
+	if _, err := exportBatchComparisonFiles(comparison, dir); err != nil {
+		t.Fatalf("exportBatchComparisonFiles() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, batchManifestFile))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	var manifest BatchManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Manifest is not valid JSON: %v", err)
+	}
+
+	want := []string{"comparison.csv", "comparison.json", "comparison.txt", "comparison.svg"}
+	if len(manifest.Files) != len(want) {
+		t.Fatalf("Manifest lists %d files, want %d", len(manifest.Files), len(want))
+	}
+	for i, file := range want {
+		if manifest.Files[i].File != file || !manifest.Files[i].Success {
+			t.Errorf("Manifest entry %d = %+v, want successful %s", i, manifest.Files[i], file)
+		}
+	}
+}
+
+func TestExportBatchTLENativeCombined(t *testing.T) {
+	results := []BatchTLEResult{
+		{
+			Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"},
+			Success:   true,
+			TLE:       ConstructTLE("ISS (ZARYA)", testTLELine1, testTLELine2),
+		},
+		{
+			Satellite: BatchSatellite{Name: "Missing Sat", NORADID: "99999"},
+			Error:     fmt.Errorf("no TLE data"),
+		},
+		{
+			Satellite: BatchSatellite{Name: "VANGUARD 1", NORADID: "5"},
+			Success:   true,
+			TLE:       ConstructTLE("VANGUARD 1", vanguardTLELine1, vanguardTLELine2),
+		},
+	}
+
+	tempFile := filepath.Join(t.TempDir(), "combined.tle")
+	if err := exportBatchTLENativeCombined(results, tempFile); err != nil {
+		t.Fatalf("exportBatchTLENativeCombined() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(tempFile)
+	if err != nil {
+		t.Fatalf("Failed to read combined TLE file: %v", err)
+	}
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	want := []string{"ISS (ZARYA)", testTLELine1, testTLELine2, "VANGUARD 1", vanguardTLELine1, vanguardTLELine2}
+	if len(lines) != len(want) {
+		t.Fatalf("Combined file has %d lines, want 3 per successful satellite (%d):\n%s", len(lines), len(want), content)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("Line %d = %q, want %q", i+1, lines[i], want[i])
+		}
+	}
+	if strings.Contains(string(content), "Missing Sat") {
+		t.Error("Combined file should not include failed satellites")
+	}
+
+	err = exportBatchTLENativeCombined(results[1:2], filepath.Join(t.TempDir(), "empty.tle"))
+	if err == nil {
+		t.Error("Expected an error when no satellite succeeded")
+	}
+}
+
+func TestExportBatchTLESectioned(t *testing.T) {
+	results := []BatchTLEResult{
+		{
+			Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"},
+			Success:   true,
+			TLE:       ConstructTLE("ISS (ZARYA)", testTLELine1, testTLELine2),
+		},
+		{
+			Satellite: BatchSatellite{Name: "Missing Sat", NORADID: "99999"},
+			Error:     fmt.Errorf("no TLE data"),
+		},
+	}
+	dir := t.TempDir()
+
+	csvFile := filepath.Join(dir, "sections.csv")
+	if err := exportBatchTLESectionedCSV(results, csvFile); err != nil {
+		t.Fatalf("exportBatchTLESectionedCSV() failed: %v", err)
+	}
+	content, _ := os.ReadFile(csvFile)
+	for _, want := range []string{"Section,Satellite 1: ISS (ZARYA) (25544)", "Section,Satellite 2: Missing Sat (99999)", "TLE Line 1," + testTLELine1, "Error,no TLE data"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Sectioned CSV missing %q:\n%s", want, content)
+		}
+	}
+
+	jsonFile := filepath.Join(dir, "sections.json")
+	if err := exportBatchTLESectionedJSON(results, jsonFile); err != nil {
+		t.Fatalf("exportBatchTLESectionedJSON() failed: %v", err)
+	}
+	data, _ := os.ReadFile(jsonFile)
+	var doc struct {
+		Successful int `json:"successful"`
+		Sections   []struct {
+			Title    string   `json:"title"`
+			Success  bool     `json:"success"`
+			Error    string   `json:"error"`
+			TLELines []string `json:"tle_lines"`
+		} `json:"sections"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to parse sectioned JSON: %v", err)
+	}
+	if doc.Successful != 1 || len(doc.Sections) != 2 {
+		t.Fatalf("Expected 2 sections with 1 success, got %+v", doc)
+	}
+	if len(doc.Sections[0].TLELines) != 3 || doc.Sections[0].TLELines[2] != testTLELine2 {
+		t.Errorf("First section TLE lines = %v", doc.Sections[0].TLELines)
+	}
+	if doc.Sections[1].Success || doc.Sections[1].Error != "no TLE data" || doc.Sections[1].TLELines != nil {
+		t.Errorf("Failed section = %+v", doc.Sections[1])
+	}
+}
+
+func TestSortBatchResults(t *testing.T) {
+	results := []BatchTLEResult{
+		{Satellite: BatchSatellite{Name: "NOAA 19", NORADID: "33591"}, TLE: TLE{OrbitInclination: 99.1}, Success: true},
+		{Satellite: BatchSatellite{Name: "iss (zarya)", NORADID: "25544"}, TLE: TLE{OrbitInclination: 51.6}, Success: true},
+		{Satellite: BatchSatellite{Name: "BROKEN", NORADID: "5"}, Success: false},
+		{Satellite: BatchSatellite{Name: "HUBBLE", NORADID: "20580"}, TLE: TLE{OrbitInclination: 28.5}, Success: true},
+	}
+
+	tests := []struct {
+		order BatchOrder
+		want  []string
+	}{
+		{OrderInput, []string{"33591", "25544", "5", "20580"}},
+		{OrderByName, []string{"5", "20580", "25544", "33591"}},
+		{OrderByNORAD, []string{"5", "20580", "25544", "33591"}},
+		{OrderByInclination, []string{"20580", "25544", "33591", "5"}},
+	}
+	for _, tt := range tests {
+		t.Run(batchOrderLabels[tt.order], func(t *testing.T) {
+			sorted := SortBatchResults(results, tt.order)
+			var got []string
+			for _, result := range sorted {
+				got = append(got, result.Satellite.NORADID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("SortBatchResults() order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if results[0].Satellite.NORADID != "33591" {
+		t.Error("SortBatchResults() should not reorder its input")
+	}
+}
@@ -0,0 +1,529 @@
+package osint
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// BatchObserver is the observer location and prediction settings shared by every satellite in
+// a batch position or pass request.
+type BatchObserver struct {
+	Latitude      string
+	Longitude     string
+	Altitude      string
+	Days          string
+	MinVisibility string // seconds, for visual passes
+	MinElevation  string // degrees, for radio passes
+}
+
+// BatchPositionResult contains the current position of a satellite in batch processing.
+type BatchPositionResult struct {
+	Satellite BatchSatellite
+	Position  Position
+	Error     error
+	Success   bool
+}
+
+// BatchPassResult contains the predicted passes of a satellite in batch processing. Visual
+// holds the passes of a visual prediction and Radio those of a radio prediction.
+type BatchPassResult struct {
+	Satellite BatchSatellite
+	Visual    []Pass
+	Radio     []RadioPass
+	Error     error
+	Success   bool
+}
+
+// runBatchWorkers calls fetch for every satellite concurrently and reports each one as it
+// finishes. A failing satellite does not stop the others.
+func runBatchWorkers(satellites []BatchSatellite, fetch func(idx int, satellite BatchSatellite) error) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	completed := 0
+
+	for i, sat := range satellites {
+		wg.Add(1)
+		go func(idx int, satellite BatchSatellite) {
+			defer wg.Done()
+			err := fetch(idx, satellite)
+
+			mu.Lock()
+			completed++
+			if err != nil {
+				fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] [%d/%d] %s: %s", completed, len(satellites), satellite.Name, err.Error())))
+			} else {
+				fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] [%d/%d] Fetched: %s", completed, len(satellites), satellite.Name)))
+			}
+			mu.Unlock()
+		}(i, sat)
+	}
+	wg.Wait()
+}
+
+// BatchFetchPositions fetches the current position of every satellite from N2YO concurrently.
+func BatchFetchPositions(satellites []BatchSatellite, observer BatchObserver) []BatchPositionResult {
+	if len(satellites) == 0 {
+		return nil
+	}
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("\n  [*] Fetching positions for %d satellite(s)...", len(satellites))))
+
+	results := make([]BatchPositionResult, len(satellites))
+	runBatchWorkers(satellites, func(idx int, satellite BatchSatellite) error {
+		results[idx] = BatchPositionResult{Satellite: satellite}
+		data, err := FetchSatellitePositions(satellite.NORADID, observer.Latitude, observer.Longitude, observer.Altitude, 1)
+		if err == nil && len(data.Positions) == 0 {
+			err = fmt.Errorf("no position data returned")
+		}
+		if err != nil {
+			results[idx].Error = err
+			return err
+		}
+		results[idx].Position = data.Positions[0]
+		results[idx].Success = true
+		return nil
+	})
+	return results
+}
+
+// BatchFetchPasses fetches visual or radio pass predictions for every satellite from N2YO
+// concurrently, using the same observer for all of them.
+func BatchFetchPasses(satellites []BatchSatellite, observer BatchObserver, radio bool) []BatchPassResult {
+	if len(satellites) == 0 {
+		return nil
+	}
+	kind := "visual"
+	if radio {
+		kind = "radio"
+	}
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("\n  [*] Fetching %s pass predictions for %d satellite(s)...", kind, len(satellites))))
+
+	results := make([]BatchPassResult, len(satellites))
+	runBatchWorkers(satellites, func(idx int, satellite BatchSatellite) error {
+		results[idx] = BatchPassResult{Satellite: satellite}
+		if radio {
+			data, err := FetchRadioPasses(satellite.NORADID, observer.Latitude, observer.Longitude, observer.Altitude, observer.Days, observer.MinElevation)
+			if err != nil {
+				results[idx].Error = err
+				return err
+			}
+			results[idx].Radio = data.Passes
+		} else {
+			data, err := FetchVisualPasses(satellite.NORADID, observer.Latitude, observer.Longitude, observer.Altitude, observer.Days, observer.MinVisibility)
+			if err != nil {
+				results[idx].Error = err
+				return err
+			}
+			results[idx].Visual = data.Passes
+		}
+		results[idx].Success = true
+		return nil
+	})
+	return results
+}
+
+// promptBatchObserver asks once for the observer location, and for pass predictions the
+// prediction window, to use for every satellite in a batch. operation is "position", "visual"
+// or "radio". It returns false when the input is missing or invalid.
+func promptBatchObserver(operation string) (BatchObserver, bool) {
+	latitude, longitude, autoDetected := GetLocationWithPrompt()
+	if latitude == "" || longitude == "" {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Observer location is required"))
+		return BatchObserver{}, false
+	}
+	if autoDetected {
+		fmt.Println(Colorize(RoleSuccess, "  [+] Using auto-detected location"))
+	}
+
+	observer := BatchObserver{
+		Latitude:  cleanNumericInput(latitude),
+		Longitude: cleanNumericInput(longitude),
+		Altitude:  cleanNumericInput(scanWithDefault("ENTER ALTITUDE (meters, default: 0)", "0")),
+	}
+	_, err := strconv.ParseFloat(observer.Latitude, 64)
+	_, err2 := strconv.ParseFloat(observer.Longitude, 64)
+	_, err3 := strconv.ParseFloat(observer.Altitude, 64)
+	var err4, err5 error
+	switch operation {
+	case "visual":
+		observer.Days = scanWithDefault("ENTER DAYS OF PREDICTION (default: 3)", "3")
+		observer.MinVisibility = scanWithDefault("ENTER MIN VISIBILITY (seconds, default: 60)", "60")
+		_, err4 = strconv.Atoi(observer.Days)
+		_, err5 = strconv.Atoi(observer.MinVisibility)
+	case "radio":
+		observer.Days = scanWithDefault("ENTER DAYS OF PREDICTION (default: 3)", "3")
+		observer.MinElevation = scanWithDefault("ENTER MIN ELEVATION (default: 10)", "10")
+		_, err4 = strconv.Atoi(observer.Days)
+		_, err5 = strconv.Atoi(observer.MinElevation)
+	}
+	if err != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
+		return BatchObserver{}, false
+	}
+	return observer, true
+}
+
+// batchPositionLines renders the batch positions as one table, failures included.
+func batchPositionLines(results []BatchPositionResult) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Batch Positions", strconv.Itoa(len(results))+" satellite(s)")),
+		Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"),
+		Colorize(RoleHeader, fmt.Sprintf("  %-24s %-8s %10s %11s %10s %8s %7s", "Satellite", "NORAD", "Latitude", "Longitude", "Alt (km)", "Azimuth", "Elev")),
+	}
+	for _, result := range results {
+		name := truncateName(result.Satellite.Name, 24)
+		if !result.Success {
+			message := "no data"
+			if result.Error != nil {
+				message = result.Error.Error()
+			}
+			lines = append(lines, Colorize(RoleError, fmt.Sprintf("  %-24s %-8s failed: %s", name, result.Satellite.NORADID, message)))
+			continue
+		}
+		p := result.Position
+		role := RoleText
+		if p.Elevation > 0 {
+			role = RoleSuccess
+		}
+		lines = append(lines, Colorize(role, fmt.Sprintf("  %-24s %-8s %10s %11s %10s %8s %7s",
+			name, result.Satellite.NORADID,
+			FormatQuantity(QuantityCoordinate, p.Satlatitude),
+			FormatQuantity(QuantityCoordinate, p.Satlongitude),
+			FormatQuantity(QuantityAltitude, p.Sataltitude),
+			FormatQuantity(QuantityAngle, p.Azimuth),
+			FormatQuantity(QuantityAngle, p.Elevation))))
+	}
+	return lines
+}
+
+// batchPassRow is one pass in a combined batch schedule.
+type batchPassRow struct {
+	Satellite    BatchSatellite
+	Start        time.Time
+	End          time.Time
+	MaxElevation float64
+	Magnitude    float64
+}
+
+// batchPassRows lists the passes of every successful result, soonest first.
+func batchPassRows(results []BatchPassResult) []batchPassRow {
+	var rows []batchPassRow
+	for _, result := range results {
+		for _, pass := range result.Visual {
+			rows = append(rows, batchPassRow{
+				Satellite:    result.Satellite,
+				Start:        time.Unix(int64(pass.StartUTC), 0).UTC(),
+				End:          time.Unix(int64(pass.EndUTC), 0).UTC(),
+				MaxElevation: pass.MaxEl,
+				Magnitude:    pass.Mag,
+			})
+		}
+		for _, pass := range result.Radio {
+			rows = append(rows, batchPassRow{
+				Satellite:    result.Satellite,
+				Start:        time.Unix(pass.StartUTC, 0).UTC(),
+				End:          time.Unix(pass.EndUTC, 0).UTC(),
+				MaxElevation: pass.MaxEl,
+				Magnitude:    unknownMagnitude,
+			})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Start.Before(rows[j].Start) })
+	return rows
+}
+
+// batchPassLines renders a combined pass schedule for all satellites, followed by the
+// satellites whose prediction failed. Times are UTC.
+func batchPassLines(title string, results []BatchPassResult) []string {
+	rows := batchPassRows(results)
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString(title, strconv.Itoa(len(rows))+" pass(es)")),
+		Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"),
+	}
+	if len(rows) == 0 {
+		lines = append(lines, Colorize(RoleInfo, "  [*] No passes in the prediction window"))
+	}
+	for i, row := range rows {
+		line := fmt.Sprintf("#%d  %-24s %s - %s  maxEl %s°", i+1, truncateName(row.Satellite.Name, 24),
+			row.Start.Format("2006-01-02 15:04"), row.End.Format("15:04"), FormatQuantity(QuantityAngle, row.MaxElevation))
+		if row.Magnitude < unknownMagnitude {
+			line += fmt.Sprintf("  mag %.1f", row.Magnitude)
+		}
+		lines = append(lines, Colorize(RoleText, "  "+line))
+	}
+	for _, result := range results {
+		if result.Success {
+			continue
+		}
+		message := "no data"
+		if result.Error != nil {
+			message = result.Error.Error()
+		}
+		lines = append(lines, Colorize(RoleError, fmt.Sprintf("  [!] %s (%s) failed: %s", result.Satellite.Name, result.Satellite.NORADID, message)))
+	}
+	return lines
+}
+
+// truncateName shortens a satellite name to fit a table column of width characters.
+func truncateName(name string, width int) string {
+	runes := []rune(name)
+	if len(runes) <= width {
+		return name
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// batchN2YO runs a batch position, visual pass or radio pass request: it asks once for the
+// observer, checks the N2YO call budget, fetches every satellite and shows a combined table.
+func batchN2YO(operation string, satellites []BatchSatellite) {
+	observer, ok := promptBatchObserver(operation)
+	if !ok {
+		return
+	}
+	days := 1
+	if operation != "position" {
+		days, _ = strconv.Atoi(observer.Days)
+	}
+	endpoint := map[string]string{"visual": "visualpasses", "radio": "radiopasses", "position": "positions"}[operation]
+	if !allowN2YOCalls(endpoint, len(satellites), days) {
+		fmt.Println(Colorize(RoleInfo, "  [*] Batch cancelled"))
+		return
+	}
+
+	if operation == "position" {
+		results := BatchFetchPositions(satellites, observer)
+		printPaged(batchPositionLines(results))
+		if confirm("Export batch positions?", false) {
+			exportBatchN2YO("batch_positions", func(filePath string, asJSON bool) error {
+				if asJSON {
+					return exportBatchPositionsJSON(results, filePath)
+				}
+				return exportBatchPositionsCSV(results, filePath)
+			})
+		}
+		return
+	}
+
+	radio := operation == "radio"
+	results := BatchFetchPasses(satellites, observer, radio)
+	title := "Batch Visual Passes"
+	if radio {
+		title = "Batch Radio Passes"
+	}
+	printPaged(batchPassLines(title, results))
+	if confirm("Export batch predictions?", false) {
+		exportBatchN2YO("batch_"+operation+"_passes", func(filePath string, asJSON bool) error {
+			if asJSON {
+				return exportBatchPassesJSON(results, filePath)
+			}
+			return exportBatchPassesCSV(results, filePath)
+		})
+	}
+}
+
+// exportBatchN2YO asks for an export format and file path, then writes the file with write.
+func exportBatchN2YO(baseName string, write func(filePath string, asJSON bool) error) {
+	formatPrompt := promptui.Select{
+		Label: "Select Export Format",
+		Items: []string{"CSV", "JSON", "Cancel"},
+	}
+	formatIdx, _, err := formatPrompt.Run()
+	if err != nil || formatIdx == 2 {
+		return
+	}
+	asJSON := formatIdx == 1
+	ext := ".csv"
+	if asJSON {
+		ext = ".json"
+	}
+
+	defaultPath := fmt.Sprintf("%s_%s", baseName, time.Now().Format("20060102_150405"))
+	filePath := compressedPath(withExportExt(scanWithDefault("ENTER FILE PATH (default: "+defaultPath+ext+")", defaultPath), ext))
+	if err := write(filePath, asJSON); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+	}
+}
+
+// batchErrorMessage returns the error text of a failed batch result.
+func batchErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// exportBatchPositionsCSV exports batch positions to CSV format.
+func exportBatchPositionsCSV(results []BatchPositionResult, filePath string) error {
+	file, err := createExportFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+
+	headers := []string{
+		"Satellite Name", "NORAD ID", "Status", "Timestamp", "Latitude", "Longitude",
+		"Altitude (km)", "Azimuth", "Elevation", "Error",
+	}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, result := range results {
+		row := []string{result.Satellite.Name, result.Satellite.NORADID}
+		if result.Success {
+			p := result.Position
+			row = append(row, "Success",
+				time.Unix(p.Timestamp, 0).UTC().Format(time.RFC3339),
+				fmt.Sprintf("%.6f", p.Satlatitude),
+				fmt.Sprintf("%.6f", p.Satlongitude),
+				fmt.Sprintf("%.3f", p.Sataltitude),
+				fmt.Sprintf("%.2f", p.Azimuth),
+				fmt.Sprintf("%.2f", p.Elevation),
+				"",
+			)
+		} else {
+			row = append(row, "Failed", "", "", "", "", "", "", batchErrorMessage(result.Error))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+	}
+
+	if err := closeCSVExport(file, writer); err != nil {
+		return err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// exportBatchPositionsJSON exports batch positions to JSON format.
+func exportBatchPositionsJSON(results []BatchPositionResult, filePath string) error {
+	type ExportResult struct {
+		Satellite BatchSatellite `json:"satellite"`
+		Position  *Position      `json:"position,omitempty"`
+		Success   bool           `json:"success"`
+		Error     string         `json:"error,omitempty"`
+	}
+
+	exportResults := make([]ExportResult, 0, len(results))
+	for _, result := range results {
+		exportResult := ExportResult{
+			Satellite: result.Satellite,
+			Success:   result.Success,
+			Error:     batchErrorMessage(result.Error),
+		}
+		if result.Success {
+			position := result.Position
+			exportResult.Position = &position
+		}
+		exportResults = append(exportResults, exportResult)
+	}
+
+	data := map[string]interface{}{
+		"batch_results":    exportResults,
+		"export_timestamp": time.Now().Format(time.RFC3339),
+		"total_count":      len(results),
+	}
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// exportBatchPassesCSV exports batch pass predictions to CSV format, one row per pass. A
+// satellite without passes gets a single row so failures and empty windows are visible.
+func exportBatchPassesCSV(results []BatchPassResult, filePath string) error {
+	file, err := createExportFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+
+	headers := []string{"Satellite Name", "NORAD ID", "Status", "Start (UTC)", "End (UTC)", "Max Elevation", "Error"}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, result := range results {
+		var rows [][]string
+		for _, row := range batchPassRows([]BatchPassResult{result}) {
+			rows = append(rows, []string{
+				result.Satellite.Name, result.Satellite.NORADID, "Success",
+				row.Start.Format(time.RFC3339), row.End.Format(time.RFC3339),
+				fmt.Sprintf("%.1f", row.MaxElevation), "",
+			})
+		}
+		if len(rows) == 0 {
+			status := "No passes"
+			if !result.Success {
+				status = "Failed"
+			}
+			rows = append(rows, []string{result.Satellite.Name, result.Satellite.NORADID, status, "", "", "", batchErrorMessage(result.Error)})
+		}
+		if err := writer.WriteAll(rows); err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+	}
+
+	if err := closeCSVExport(file, writer); err != nil {
+		return err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
+
+// exportBatchPassesJSON exports batch pass predictions to JSON format.
+func exportBatchPassesJSON(results []BatchPassResult, filePath string) error {
+	type ExportResult struct {
+		Satellite    BatchSatellite `json:"satellite"`
+		VisualPasses []Pass         `json:"visual_passes,omitempty"`
+		RadioPasses  []RadioPass    `json:"radio_passes,omitempty"`
+		Success      bool           `json:"success"`
+		Error        string         `json:"error,omitempty"`
+	}
+
+	exportResults := make([]ExportResult, 0, len(results))
+	for _, result := range results {
+		exportResults = append(exportResults, ExportResult{
+			Satellite:    result.Satellite,
+			VisualPasses: result.Visual,
+			RadioPasses:  result.Radio,
+			Success:      result.Success,
+			Error:        batchErrorMessage(result.Error),
+		})
+	}
+
+	data := map[string]interface{}{
+		"batch_results":    exportResults,
+		"export_timestamp": time.Now().Format(time.RFC3339),
+		"total_count":      len(results),
+	}
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Println(Colorize(RoleSuccess, "  [+] Exported to: "+filePath))
+	return nil
+}
@@ -0,0 +1,136 @@
+package osint
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestBatchFetchPositions_CapturesPerSatelliteErrors(t *testing.T) {
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/positions/99999/") {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(createTestResponse())
+	})
+
+	satellites := []BatchSatellite{
+		{Name: "ISS (ZARYA)", NORADID: "25544"},
+		{Name: "MISSING", NORADID: "99999"},
+	}
+	observer := BatchObserver{Latitude: "40.7128", Longitude: "-74.0060", Altitude: "0"}
+	results := BatchFetchPositions(satellites, observer)
+
+	if len(results) != 2 {
+		t.Fatalf("BatchFetchPositions() returned %d results, want 2", len(results))
+	}
+	if !results[0].Success || results[0].Position.Satlatitude != createTestResponse().Positions[0].Satlatitude {
+		t.Errorf("results[0] = %+v, want the first position of the response", results[0])
+	}
+	if results[1].Success || results[1].Error == nil {
+		t.Errorf("results[1] = %+v, want a captured error", results[1])
+	}
+	if results[1].Satellite.NORADID != "99999" {
+		t.Errorf("results[1] satellite = %s, want results kept in selection order", results[1].Satellite.NORADID)
+	}
+
+	lines := strings.Join(batchPositionLines(results), "\n")
+	if !strings.Contains(lines, "ISS (ZARYA)") || !strings.Contains(lines, "MISSING") || !strings.Contains(lines, "failed") {
+		t.Errorf("batchPositionLines() should list both satellites and the failure, got:\n%s", lines)
+	}
+}
+
+func TestBatchFetchPasses_SharesObserver(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		json.NewEncoder(w).Encode(RadioPassResponse{
+			Info:   Info{SatID: 25544},
+			Passes: []RadioPass{{StartUTC: 1700000000, MaxUTC: 1700000300, EndUTC: 1700000600, MaxEl: 45}},
+		})
+	})
+
+	satellites := []BatchSatellite{{Name: "ISS (ZARYA)", NORADID: "25544"}, {Name: "NOAA 19", NORADID: "33591"}}
+	observer := BatchObserver{Latitude: "51.5", Longitude: "-0.1", Altitude: "20", Days: "5", MinElevation: "15"}
+	results := BatchFetchPasses(satellites, observer, true)
+
+	for i, result := range results {
+		if !result.Success || len(result.Radio) != 1 {
+			t.Errorf("results[%d] = %+v, want one radio pass", i, result)
+		}
+	}
+	if len(paths) != 2 {
+		t.Fatalf("made %d requests, want 2", len(paths))
+	}
+	for _, path := range paths {
+		if !strings.Contains(path, "/51.5/-0.1/20/5/15/") {
+			t.Errorf("request %q does not use the shared observer", path)
+		}
+	}
+
+	rows := batchPassRows(results)
+	if len(rows) != 2 {
+		t.Errorf("batchPassRows() returned %d rows, want 2", len(rows))
+	}
+}
+
+func TestPromptBatchObserver(t *testing.T) {
+	original := DefaultLocationProvider
+	DefaultLocationProvider = FixedLocationProvider{Location: LocationData{Latitude: 48.8566, Longitude: 2.3522}}
+	t.Cleanup(func() { DefaultLocationProvider = original })
+	withScanInput(t, "35\n7\n\n")
+	t.Setenv("HOME", t.TempDir())
+
+	oldNonInteractive := NonInteractive
+	NonInteractive = true
+	t.Cleanup(func() { NonInteractive = oldNonInteractive })
+
+	observer, ok := promptBatchObserver("radio")
+	if !ok {
+		t.Fatal("promptBatchObserver() rejected valid input")
+	}
+	want := BatchObserver{Latitude: "48.856600", Longitude: "2.352200", Altitude: "35", Days: "7", MinElevation: "10"}
+	if observer != want {
+		t.Errorf("promptBatchObserver() = %+v, want %+v", observer, want)
+	}
+
+	withScanInput(t, "high\n")
+	if _, ok := promptBatchObserver("position"); ok {
+		t.Error("promptBatchObserver() accepted a non-numeric altitude")
+	}
+}
+
+func TestExportBatchPassesCSV(t *testing.T) {
+	results := []BatchPassResult{
+		{
+			Satellite: BatchSatellite{Name: "ISS (ZARYA)", NORADID: "25544"},
+			Visual:    []Pass{{StartUTC: 1700000000, EndUTC: 1700000600, MaxEl: 60}},
+			Success:   true,
+		},
+		{Satellite: BatchSatellite{Name: "NOAA 19", NORADID: "33591"}, Success: true},
+		{Satellite: BatchSatellite{Name: "MISSING", NORADID: "99999"}, Error: os.ErrNotExist},
+	}
+	filePath := filepath.Join(t.TempDir(), "passes.csv")
+	if err := exportBatchPassesCSV(results, filePath); err != nil {
+		t.Fatalf("exportBatchPassesCSV() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{"ISS (ZARYA),25544,Success,2023-11-14T22:13:20Z", "NOAA 19,33591,No passes", "MISSING,99999,Failed"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("export missing %q, got:\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package osint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const browseCursorFile = "browse_cursor.json"
+
+// BrowseCursor records the search filters and page of a catalog browse so it can be resumed later.
+type BrowseCursor struct {
+	SearchName string `json:"search_name,omitempty"`
+	Country    string `json:"country,omitempty"`
+	ObjectType string `json:"object_type,omitempty"`
+	LaunchYear string `json:"launch_year,omitempty"`
+	Page       int    `json:"page"`
+	SavedAt    string `json:"saved_at"`
+}
+
+// Describe returns a short human-readable summary of the cursor for menus.
+func (c BrowseCursor) Describe() string {
+	parts := []string{fmt.Sprintf("page %d", c.Page)}
+	if c.SearchName != "" {
+		parts = append(parts, fmt.Sprintf("name %q", c.SearchName))
+	}
+	if c.Country != "" {
+		parts = append(parts, "country "+c.Country)
+	}
+	if c.ObjectType != "" {
+		parts = append(parts, "type "+c.ObjectType)
+	}
+	if c.LaunchYear != "" {
+		parts = append(parts, "launched "+c.LaunchYear)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SaveBrowseCursor stores the current browse position, replacing any previous one.
+func SaveBrowseCursor(cursor BrowseCursor) error {
+	if cursor.Page < 1 {
+		cursor.Page = 1
+	}
+	cursor.SavedAt = time.Now().Format("2006-01-02 15:04:05")
+	return saveJSONState(browseCursorFile, cursor)
+}
+
+// LoadBrowseCursor returns the saved browse position, or nil if none has been saved.
+func LoadBrowseCursor() (*BrowseCursor, error) {
+	var cursor BrowseCursor
+	found, err := loadJSONState(browseCursorFile, &cursor)
+	if err != nil || !found {
+		return nil, err
+	}
+	if cursor.Page < 1 {
+		cursor.Page = 1
+	}
+	return &cursor, nil
+}
+
+// clearBrowseCursor removes the saved browse position.
+func clearBrowseCursor() error {
+	return removeJSONState(browseCursorFile)
+}
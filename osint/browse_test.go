@@ -0,0 +1,97 @@
+package osint
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSaveAndLoadBrowseCursor(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cursor := BrowseCursor{
+		SearchName: "STARLINK",
+		Country:    "US",
+		ObjectType: "PAYLOAD",
+		LaunchYear: "2023",
+		Page:       7,
+	}
+	if err := SaveBrowseCursor(cursor); err != nil {
+		t.Fatalf("SaveBrowseCursor() failed: %v", err)
+	}
+
+	loaded, err := LoadBrowseCursor()
+	if err != nil {
+		t.Fatalf("LoadBrowseCursor() failed: %v", err)
+	}
+	if loaded == nil {
+		t.Fatal("Expected a saved cursor, got nil")
+	}
+	if loaded.SearchName != "STARLINK" || loaded.Country != "US" || loaded.ObjectType != "PAYLOAD" || loaded.LaunchYear != "2023" {
+		t.Errorf("Filters not restored: %+v", loaded)
+	}
+	if loaded.Page != 7 {
+		t.Errorf("Expected page 7, got %d", loaded.Page)
+	}
+	if loaded.SavedAt == "" {
+		t.Error("Expected SavedAt to be set")
+	}
+
+	// Saving again replaces the previous cursor
+	cursor.Page = 2
+	cursor.SearchName = ""
+	if err := SaveBrowseCursor(cursor); err != nil {
+		t.Fatalf("SaveBrowseCursor() failed: %v", err)
+	}
+	loaded, _ = LoadBrowseCursor()
+	if loaded.Page != 2 || loaded.SearchName != "" {
+		t.Errorf("Expected updated cursor, got %+v", loaded)
+	}
+}
+
+func TestLoadBrowseCursor_NoneSaved(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cursor, err := LoadBrowseCursor()
+	if err != nil {
+		t.Fatalf("LoadBrowseCursor() should not error without a saved cursor: %v", err)
+	}
+	if cursor != nil {
+		t.Errorf("Expected nil cursor, got %+v", cursor)
+	}
+}
+
+func TestLoadBrowseCursor_Corrupted(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := os.WriteFile(getAppDataPath(browseCursorFile), []byte("{not json"), 0644); err != nil {
+		t.Fatalf("Failed to write corrupted cursor: %v", err)
+	}
+	if _, err := LoadBrowseCursor(); err == nil {
+		t.Error("Expected error for corrupted cursor file")
+	}
+}
+
+func TestClearBrowseCursor(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	SaveBrowseCursor(BrowseCursor{Page: 3})
+	if err := clearBrowseCursor(); err != nil {
+		t.Fatalf("clearBrowseCursor() failed: %v", err)
+	}
+	if cursor, _ := LoadBrowseCursor(); cursor != nil {
+		t.Error("Expected no cursor after clearing")
+	}
+	if err := clearBrowseCursor(); err != nil {
+		t.Errorf("Clearing twice should not error: %v", err)
+	}
+}
+
+func TestBrowseCursorDescribe(t *testing.T) {
+	desc := BrowseCursor{Page: 4, Country: "PRC", ObjectType: "DEBRIS"}.Describe()
+	for _, want := range []string{"page 4", "country PRC", "type DEBRIS"} {
+		if !strings.Contains(desc, want) {
+			t.Errorf("Describe() = %q, expected it to contain %q", desc, want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package osint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const catalogCacheFile = "catalog_cache.json"
+
+// CatalogCacheTTL is how long a cached catalog query is used before Space-Track is asked again.
+// Zero disables the cache.
+var CatalogCacheTTL = 24 * time.Hour
+
+// catalogCacheMu serializes updates to the cache file, which a background catalog stream may
+// write while the menu reads it.
+var catalogCacheMu sync.Mutex
+
+// CachedCatalogQuery is the response to one catalog query, kept so browsing the same filters
+// again does not query Space-Track.
+type CachedCatalogQuery struct {
+	Data      json.RawMessage `json:"data"`
+	FetchedAt time.Time       `json:"fetched_at"`
+}
+
+// spaceTrackSession logs in to Space-Track the first time a query needs it, so catalog reads
+// answered from the cache never log in.
+type spaceTrackSession struct {
+	login  func() (*http.Client, error)
+	once   sync.Once
+	client *http.Client
+	err    error
+}
+
+// newSpaceTrackSession returns a session that logs in with Login on first use.
+func newSpaceTrackSession() *spaceTrackSession {
+	return &spaceTrackSession{login: Login}
+}
+
+// loggedInSession returns a session that uses an existing client.
+func loggedInSession(client *http.Client) *spaceTrackSession {
+	return &spaceTrackSession{login: func() (*http.Client, error) { return client, nil }}
+}
+
+// Client logs in if that has not been tried yet and returns the client or the login error.
+func (s *spaceTrackSession) Client() (*http.Client, error) {
+	s.once.Do(func() { s.client, s.err = s.login() })
+	return s.client, s.err
+}
+
+// loginFailed reports whether the session tried to log in and failed.
+func (s *spaceTrackSession) loginFailed() bool {
+	if s == nil {
+		return false
+	}
+	_, err := s.Client()
+	return err != nil
+}
+
+// catalogCacheNotice tells the user that results come from the cache fetched at fetchedAt and how
+// to update them.
+func catalogCacheNotice(fetchedAt time.Time) string {
+	return fmt.Sprintf("  [*] Using catalog cached at %s; choose Refresh Catalog to update it",
+		fetchedAt.UTC().Format("2006-01-02 15:04 UTC"))
+}
+
+// lookupCachedCatalog returns the cached response to a catalog query, keyed by its endpoint, if
+// it is younger than CatalogCacheTTL.
+func lookupCachedCatalog(endpoint string) (CachedCatalogQuery, bool) {
+	if CatalogCacheTTL <= 0 {
+		return CachedCatalogQuery{}, false
+	}
+	catalogCacheMu.Lock()
+	defer catalogCacheMu.Unlock()
+
+	cache := map[string]CachedCatalogQuery{}
+	if _, err := loadJSONCache(catalogCacheFile, &cache); err != nil {
+		return CachedCatalogQuery{}, false
+	}
+	cached, found := cache[endpoint]
+	if !found || time.Since(cached.FetchedAt) >= CatalogCacheTTL {
+		return CachedCatalogQuery{}, false
+	}
+	return cached, true
+}
+
+// storeCachedCatalog records the response to a catalog query. Anything but a JSON array, such as
+// a Space-Track error envelope or a truncated response, is not cached.
+func storeCachedCatalog(endpoint string, data []byte) {
+	trimmed := bytes.TrimSpace(data)
+	if CatalogCacheTTL <= 0 || !bytes.HasPrefix(trimmed, []byte("[")) || !json.Valid(trimmed) {
+		return
+	}
+	catalogCacheMu.Lock()
+	defer catalogCacheMu.Unlock()
+
+	cache := map[string]CachedCatalogQuery{}
+	if _, err := loadJSONCache(catalogCacheFile, &cache); err != nil {
+		cache = map[string]CachedCatalogQuery{}
+	}
+	// Drop expired queries so the file does not grow with every filter ever used
+	for key, cached := range cache {
+		if time.Since(cached.FetchedAt) >= CatalogCacheTTL {
+			delete(cache, key)
+		}
+	}
+	cache[endpoint] = CachedCatalogQuery{Data: json.RawMessage(trimmed), FetchedAt: time.Now().UTC()}
+	if err := saveJSONCache(catalogCacheFile, cache); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Could not cache catalog: "+err.Error()))
+	}
+}
+
+// ClearCatalogCache removes every cached catalog query, so the next catalog reads come from
+// Space-Track.
+func ClearCatalogCache() error {
+	catalogCacheMu.Lock()
+	defer catalogCacheMu.Unlock()
+
+	if err := os.Remove(getCachePath(catalogCacheFile)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to clear catalog cache: %w", err)
+	}
+	return nil
+}
+
+// queryCatalog runs a catalog query, answering from the cache when it holds a fresh response and
+// logging in only on a miss. It also returns when a cached response was fetched, or the zero time
+// for a live one.
+func queryCatalog(session *spaceTrackSession, endpoint string) (string, time.Time, error) {
+	if cached, found := lookupCachedCatalog(endpoint); found {
+		return string(cached.Data), cached.FetchedAt, nil
+	}
+	client, err := session.Client()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	data, err := QuerySpaceTrack(client, endpoint)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	storeCachedCatalog(endpoint, []byte(data))
+	return data, time.Time{}, nil
+}
+
+// startCachedCatalogStream is startCatalogStream answered from the cache when it holds a fresh
+// response, logging in only on a miss. A response streamed from Space-Track is cached once it has
+// been read completely.
+func startCachedCatalogStream(session *spaceTrackSession, endpoint string, keep func(Satellite) bool) *catalogStream {
+	if cached, found := lookupCachedCatalog(endpoint); found {
+		fmt.Println(Colorize(RoleInfo, catalogCacheNotice(cached.FetchedAt)))
+		return startCatalogStreamFrom(func(context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(cached.Data)), nil
+		}, keep)
+	}
+	client, err := session.Client()
+	if err != nil {
+		return startCatalogStreamFrom(func(context.Context) (io.ReadCloser, error) {
+			return nil, err
+		}, keep)
+	}
+	return startCatalogStreamFrom(func(ctx context.Context) (io.ReadCloser, error) {
+		body, err := openSpaceTrackQuery(ctx, client, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return &catalogRecorder{ReadCloser: body, endpoint: endpoint}, nil
+	}, keep)
+}
+
+// catalogRecorder copies a catalog response as it is read and caches it on reaching the end.
+// A response abandoned part way is never cached.
+type catalogRecorder struct {
+	io.ReadCloser
+	endpoint string
+	data     bytes.Buffer
+	stored   bool
+}
+
+// Read reads from the response, caching it once the end is reached.
+func (r *catalogRecorder) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.data.Write(p[:n])
+	if err == io.EOF && !r.stored {
+		r.stored = true
+		storeCachedCatalog(r.endpoint, r.data.Bytes())
+	}
+	return n, err
+}
@@ -0,0 +1,136 @@
+package osint
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const catalogCacheTestData = `[{"SATNAME":"ISS (ZARYA)","NORAD_CAT_ID":"25544"},{"SATNAME":"HST","NORAD_CAT_ID":"20580"}]`
+
+// withCountingCatalogServer serves body for every catalog query and counts the requests, with the
+// cache in a temporary directory.
+func withCountingCatalogServer(t *testing.T, body string) (*spaceTrackSession, *atomic.Int32) {
+	t.Helper()
+	t.Setenv(cacheDirEnv, t.TempDir())
+	var requests atomic.Int32
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(body))
+	})
+	return loggedInSession(client), &requests
+}
+
+func TestQueryCatalog_UsesCacheUntilExpiry(t *testing.T) {
+	session, requests := withCountingCatalogServer(t, catalogCacheTestData)
+	endpoint := buildSatcatQuery("", "US", "", "", 1, 20)
+
+	for i := 0; i < 2; i++ {
+		data, cachedAt, err := queryCatalog(session, endpoint)
+		if err != nil {
+			t.Fatalf("queryCatalog() error = %v", err)
+		}
+		var sats []Satellite
+		if err := decodeSpaceTrackJSON(data, &sats); err != nil || len(sats) != 2 {
+			t.Errorf("call %d: queryCatalog() = %q, want the two catalog entries", i+1, data)
+		}
+		if (i == 1) == cachedAt.IsZero() {
+			t.Errorf("call %d: cachedAt = %v, want a cached response only on the second call", i+1, cachedAt)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Space-Track queried %d times, want 1", got)
+	}
+
+	// Other filters are cached separately
+	if _, _, err := queryCatalog(session, buildSatcatQuery("", "PRC", "", "", 1, 20)); err != nil {
+		t.Fatalf("queryCatalog() error = %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Space-Track queried %d times after a new filter, want 2", got)
+	}
+
+	original := CatalogCacheTTL
+	CatalogCacheTTL = time.Nanosecond
+	t.Cleanup(func() { CatalogCacheTTL = original })
+	time.Sleep(time.Millisecond)
+	if _, _, err := queryCatalog(session, endpoint); err != nil {
+		t.Fatalf("queryCatalog() error = %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Space-Track queried %d times after expiry, want 3", got)
+	}
+}
+
+func TestClearCatalogCache_ForcesRefetch(t *testing.T) {
+	session, requests := withCountingCatalogServer(t, catalogCacheTestData)
+	endpoint := buildSatcatQuery("", "", "PAYLOAD", "", 1, 20)
+
+	queryCatalog(session, endpoint)
+	if err := ClearCatalogCache(); err != nil {
+		t.Fatalf("ClearCatalogCache() error = %v", err)
+	}
+	queryCatalog(session, endpoint)
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Space-Track queried %d times, want 2 after clearing the cache", got)
+	}
+}
+
+func TestQueryCatalog_DoesNotCacheErrors(t *testing.T) {
+	session, requests := withCountingCatalogServer(t, `{"error":"query limit exceeded"}`)
+	endpoint := buildSatcatQuery("", "", "", "", 1, 20)
+
+	queryCatalog(session, endpoint)
+	queryCatalog(session, endpoint)
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Space-Track queried %d times, want an error envelope never to be cached", got)
+	}
+}
+
+func TestStartCachedCatalogStream(t *testing.T) {
+	session, requests := withCountingCatalogServer(t, catalogCacheTestData)
+	endpoint := buildSatcatQuery("ISS", "", "", "", 1, 0)
+
+	for i := 0; i < 2; i++ {
+		stream := startCachedCatalogStream(session, endpoint, func(sat Satellite) bool {
+			return satelliteNameMatches(sat, "ISS")
+		})
+		sats, done, err := stream.waitFor(10)
+		if err != nil || !done || len(sats) != 1 {
+			t.Fatalf("stream %d: waitFor() = %d matches, done %v, err %v; want 1, true, nil", i+1, len(sats), done, err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Space-Track queried %d times, want the second stream served from the cache", got)
+	}
+}
+
+func TestQueryCatalog_LogsInOnlyOnMiss(t *testing.T) {
+	loggedIn, requests := withCountingCatalogServer(t, catalogCacheTestData)
+	endpoint := buildSatcatQuery("", "US", "", "", 1, 20)
+	queryCatalog(loggedIn, endpoint)
+
+	logins := 0
+	session := &spaceTrackSession{login: func() (*http.Client, error) {
+		logins++
+		return nil, NewAppError(ErrCodeAuthFailed, "Authentication failed with Space-Track API")
+	}}
+	if _, cachedAt, err := queryCatalog(session, endpoint); err != nil || cachedAt.IsZero() {
+		t.Fatalf("queryCatalog() = %v, %v; want the cached response", cachedAt, err)
+	}
+	stream := startCachedCatalogStream(session, endpoint, func(Satellite) bool { return true })
+	if _, _, err := stream.waitFor(10); err != nil {
+		t.Fatalf("cached stream failed: %v", err)
+	}
+	if logins != 0 {
+		t.Errorf("logged in %d times; want no login for cached reads", logins)
+	}
+
+	if _, _, err := queryCatalog(session, buildSatcatQuery("", "PRC", "", "", 1, 20)); err == nil {
+		t.Error("A cache miss should report the login failure")
+	}
+	if logins != 1 || requests.Load() != 1 {
+		t.Errorf("logins = %d, requests = %d; want one login attempt and no new query", logins, requests.Load())
+	}
+}
@@ -0,0 +1,157 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// catalogMirrorFile is where the local catalog mirror is kept in the data directory.
+const catalogMirrorFile = "catalog_mirror.json"
+
+// UseLocalCatalog makes catalog searches use the local mirror instead of Space-Track, as set by
+// --local-catalog.
+var UseLocalCatalog bool
+
+// CatalogFilter selects part of the satellite catalog. Empty fields match everything.
+type CatalogFilter struct {
+	Country    string `json:"country,omitempty"`
+	ObjectType string `json:"object_type,omitempty"`
+	LaunchYear string `json:"launch_year,omitempty"`
+}
+
+// String describes the filter for display.
+func (f CatalogFilter) String() string {
+	var parts []string
+	if f.Country != "" {
+		parts = append(parts, "Country: "+f.Country)
+	}
+	if f.ObjectType != "" {
+		parts = append(parts, "Type: "+f.ObjectType)
+	}
+	if f.LaunchYear != "" {
+		parts = append(parts, "Year: "+f.LaunchYear)
+	}
+	if len(parts) == 0 {
+		return "entire catalog"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// query builds the Space-Track satcat query for every object the filter matches.
+func (f CatalogFilter) query() string {
+	endpoint := "/class/satcat"
+	if f.Country != "" {
+		endpoint += "/COUNTRY/" + url.QueryEscape(f.Country)
+	}
+	if f.ObjectType != "" {
+		endpoint += "/OBJECT_TYPE/" + url.QueryEscape(f.ObjectType)
+	}
+	if f.LaunchYear != "" {
+		endpoint += "/LAUNCH_YEAR/" + url.QueryEscape(f.LaunchYear)
+	}
+	return endpoint + "/orderby/SATNAME%20asc/emptyresult/show"
+}
+
+// CatalogMirror is a subset of the Space-Track satellite catalog stored locally for offline searches.
+type CatalogMirror struct {
+	BuiltAt    time.Time       `json:"built_at"`
+	Filters    []CatalogFilter `json:"filters"`
+	Satellites []Satellite     `json:"satellites"`
+}
+
+// BuildCatalogMirror downloads the catalog entries matching any of the filters, or the whole
+// catalog when none are given, and saves them as the local mirror.
+func BuildCatalogMirror(client *http.Client, filters ...CatalogFilter) (*CatalogMirror, error) {
+	if len(filters) == 0 {
+		filters = []CatalogFilter{{}}
+	}
+
+	mirror := &CatalogMirror{BuiltAt: time.Now().UTC(), Filters: filters}
+	seen := make(map[string]bool)
+	for _, filter := range filters {
+		data, err := QuerySpaceTrack(client, filter.query())
+		if err != nil {
+			return nil, err
+		}
+		var sats []Satellite
+		if err := json.Unmarshal([]byte(data), &sats); err != nil {
+			return nil, NewAppErrorWithErr(ErrCodeAPIParseFailed, "Failed to parse satellite catalog data", err)
+		}
+		for _, sat := range sats {
+			if !seen[sat.NORAD_CAT_ID] {
+				seen[sat.NORAD_CAT_ID] = true
+				mirror.Satellites = append(mirror.Satellites, sat)
+			}
+		}
+	}
+	sort.SliceStable(mirror.Satellites, func(i, j int) bool {
+		return mirror.Satellites[i].SATNAME < mirror.Satellites[j].SATNAME
+	})
+
+	if err := saveJSONState(catalogMirrorFile, mirror); err != nil {
+		return nil, err
+	}
+	return mirror, nil
+}
+
+// LoadCatalogMirror reads the local catalog mirror. It returns nil without error if none has been built.
+func LoadCatalogMirror() (*CatalogMirror, error) {
+	var mirror CatalogMirror
+	found, err := loadJSONState(catalogMirrorFile, &mirror)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &mirror, nil
+}
+
+// Search returns the mirrored satellites matching the same filters as a Space-Track catalog
+// search, in name order. Debris is left out without an object type, as with ExcludeDebrisByDefault.
+func (m *CatalogMirror) Search(searchName, country, objectType, launchYear string) []Satellite {
+	var results []Satellite
+	for _, sat := range m.Satellites {
+		if searchName != "" && !satelliteNameMatches(sat, searchName) {
+			continue
+		}
+		if country != "" && !strings.EqualFold(sat.COUNTRY, country) {
+			continue
+		}
+		if objectType != "" && !strings.EqualFold(sat.OBJECT_TYPE, objectType) {
+			continue
+		}
+		if objectType == "" && ExcludeDebrisByDefault && !isNonDebris(sat.OBJECT_TYPE) {
+			continue
+		}
+		if launchYear != "" && sat.LAUNCH_YEAR != launchYear {
+			continue
+		}
+		if !IncludeDecayed && sat.DECAY != nil && *sat.DECAY != "" {
+			continue
+		}
+		results = append(results, sat)
+	}
+	return results
+}
+
+// isNonDebris reports whether an object type is browsed when debris is excluded.
+func isNonDebris(objectType string) bool {
+	for _, t := range nonDebrisObjectTypes {
+		if strings.EqualFold(objectType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe summarizes the mirror for display.
+func (m *CatalogMirror) Describe() string {
+	filters := make([]string, len(m.Filters))
+	for i, f := range m.Filters {
+		filters[i] = f.String()
+	}
+	return fmt.Sprintf("%d satellites (%s), built %s", len(m.Satellites), strings.Join(filters, "; "), m.BuiltAt.Format("2006-01-02"))
+}
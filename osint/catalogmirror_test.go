@@ -0,0 +1,104 @@
+package osint
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCatalogMirror_SearchWithoutNetwork(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(dataDirEnv, dir)
+	withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Space-Track queried during a local search: %s", r.URL.Path)
+	})
+
+	mirror := CatalogMirror{
+		Filters: []CatalogFilter{{Country: "US"}},
+		Satellites: []Satellite{
+			{SATNAME: "ISS (ZARYA)", NORAD_CAT_ID: "25544", COUNTRY: "ISS", OBJECT_TYPE: "PAYLOAD", LAUNCH_YEAR: "1998"},
+			{SATNAME: "STARLINK-1007", NORAD_CAT_ID: "44713", COUNTRY: "US", OBJECT_TYPE: "PAYLOAD", LAUNCH_YEAR: "2019"},
+			{SATNAME: "STARLINK-1008", NORAD_CAT_ID: "44714", COUNTRY: "US", OBJECT_TYPE: "PAYLOAD", LAUNCH_YEAR: "2019"},
+			{SATNAME: "STARLINK DEB", NORAD_CAT_ID: "50000", COUNTRY: "US", OBJECT_TYPE: "DEBRIS", LAUNCH_YEAR: "2019"},
+		},
+	}
+	data, _ := json.Marshal(mirror)
+	if err := os.WriteFile(filepath.Join(dir, catalogMirrorFile), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadCatalogMirror()
+	if err != nil || loaded == nil {
+		t.Fatalf("LoadCatalogMirror() = %v, %v", loaded, err)
+	}
+
+	tests := []struct {
+		name                                      string
+		searchName, country, objectType, launchYr string
+		want                                      []string
+	}{
+		{"name", "starlink", "", "", "", []string{"44713", "44714"}},
+		{"name includes debris with type", "starlink", "", "DEBRIS", "", []string{"50000"}},
+		{"country", "", "iss", "", "", []string{"25544"}},
+		{"year", "", "", "", "1998", []string{"25544"}},
+		{"no match", "hubble", "", "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, sat := range loaded.Search(tt.searchName, tt.country, tt.objectType, tt.launchYr) {
+				got = append(got, sat.NORAD_CAT_ID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadCatalogMirror_Missing(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+
+	mirror, err := LoadCatalogMirror()
+	if err != nil || mirror != nil {
+		t.Errorf("LoadCatalogMirror() = %v, %v; want nil, nil", mirror, err)
+	}
+}
+
+func TestBuildCatalogMirror(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+	var queries []string
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path)
+		switch {
+		case strings.Contains(r.URL.Path, "/COUNTRY/US"):
+			w.Write([]byte(`[{"SATNAME":"STARLINK-1007","NORAD_CAT_ID":"44713"},{"SATNAME":"HST","NORAD_CAT_ID":"20580"}]`))
+		default:
+			w.Write([]byte(`[{"SATNAME":"HST","NORAD_CAT_ID":"20580"},{"SATNAME":"AQUA","NORAD_CAT_ID":"27424"}]`))
+		}
+	})
+
+	mirror, err := BuildCatalogMirror(client, CatalogFilter{Country: "US"}, CatalogFilter{LaunchYear: "1990"})
+	if err != nil {
+		t.Fatalf("BuildCatalogMirror() failed: %v", err)
+	}
+	if len(queries) != 2 || strings.Contains(queries[0], "/limit/") {
+		t.Errorf("queries = %v, want two unlimited satcat queries", queries)
+	}
+
+	var names []string
+	for _, sat := range mirror.Satellites {
+		names = append(names, sat.SATNAME)
+	}
+	if got := strings.Join(names, ","); got != "AQUA,HST,STARLINK-1007" {
+		t.Errorf("mirrored satellites = %s, want AQUA,HST,STARLINK-1007 (deduplicated and sorted)", got)
+	}
+
+	saved, err := LoadCatalogMirror()
+	if err != nil || saved == nil || len(saved.Satellites) != 3 {
+		t.Errorf("LoadCatalogMirror() after build = %v, %v", saved, err)
+	}
+}
@@ -0,0 +1,134 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// catalogStatsFields maps the supported groupings to their satcat fields.
+var catalogStatsFields = map[string]string{
+	"country": "COUNTRY",
+	"type":    "OBJECT_TYPE",
+	"year":    "LAUNCH_YEAR",
+}
+
+const (
+	// catalogStatsRows is how many groups are charted before the rest are summed as "OTHER".
+	catalogStatsRows = 20
+	// catalogStatsBarWidth is the length of the longest bar in the chart.
+	catalogStatsBarWidth = 30
+)
+
+// buildCatalogStatsQuery constructs a satcat query returning only the grouping field of every
+// object still in orbit.
+func buildCatalogStatsQuery(field string) string {
+	return "/class/satcat/CURRENT/Y/DECAY/null-val/predicates/" + field + "/format/json"
+}
+
+// CatalogStats counts the objects in orbit by country, type or launch year. Space-Track has no
+// grouped count query, so only the grouping field is fetched and counted here.
+func CatalogStats(client *http.Client, groupBy string) (map[string]int, error) {
+	field, ok := catalogStatsFields[strings.ToLower(strings.TrimSpace(groupBy))]
+	if !ok {
+		return nil, NewAppErrorWithContext(ErrCodeInputInvalid,
+			fmt.Sprintf("Unknown grouping: %s", groupBy), "Use country, type or year")
+	}
+
+	data, err := QuerySpaceTrack(client, buildCatalogStatsQuery(field))
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed to query catalog statistics", err)
+		appErr.Context = "Group by: " + groupBy
+		return nil, appErr
+	}
+
+	var rows []map[string]*string
+	if err := json.Unmarshal([]byte(data), &rows); err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIParseFailed, "Failed to parse catalog statistics", err)
+		appErr.Context = fmt.Sprintf("Group by: %s, Response length: %d bytes", groupBy, len(data))
+		return nil, appErr
+	}
+
+	counts := make(map[string]int)
+	for _, row := range rows {
+		key := "UNKNOWN"
+		if value := row[field]; value != nil && strings.TrimSpace(*value) != "" {
+			key = strings.TrimSpace(*value)
+		}
+		counts[key]++
+	}
+	return counts, nil
+}
+
+// catalogStatsLines renders counts as an ASCII bar chart. Launch years are listed in order, other
+// groupings largest first, with groups beyond catalogStatsRows summed into one row.
+func catalogStatsLines(groupBy string, counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	total := 0
+	for key, count := range counts {
+		keys = append(keys, key)
+		total += count
+	}
+	if groupBy == "year" {
+		sort.Strings(keys)
+	} else {
+		sort.Slice(keys, func(i, j int) bool {
+			if counts[keys[i]] != counts[keys[j]] {
+				return counts[keys[i]] > counts[keys[j]]
+			}
+			return keys[i] < keys[j]
+		})
+	}
+
+	type row struct {
+		label string
+		count int
+	}
+	var rows []row
+	other := 0
+	for i, key := range keys {
+		if groupBy != "year" && len(keys) > catalogStatsRows && i >= catalogStatsRows-1 {
+			other += counts[key]
+			continue
+		}
+		rows = append(rows, row{key, counts[key]})
+	}
+	if other > 0 {
+		rows = append(rows, row{"OTHER", other})
+	}
+
+	largest := 0
+	for _, r := range rows {
+		if r.count > largest {
+			largest = r.count
+		}
+	}
+
+	lines := []string{
+		Colorize(RoleHeader, fmt.Sprintf("\n  Objects in orbit by %s (%d total)\n", groupBy, total)),
+	}
+	for _, r := range rows {
+		bar := 0
+		if largest > 0 {
+			bar = (r.count*catalogStatsBarWidth + largest - 1) / largest
+		}
+		lines = append(lines, fmt.Sprintf("  %-12s %6d  %s", r.label, r.count, Colorize(RoleAccent, strings.Repeat("█", bar))))
+	}
+	return lines
+}
+
+// PrintCatalogStats logs in to Space-Track and charts the objects in orbit by groupBy.
+func PrintCatalogStats(groupBy string) error {
+	client, err := Login()
+	if err != nil {
+		return err
+	}
+	counts, err := CatalogStats(client, groupBy)
+	if err != nil {
+		return err
+	}
+	printPaged(catalogStatsLines(strings.ToLower(strings.TrimSpace(groupBy)), counts))
+	return nil
+}
@@ -0,0 +1,68 @@
+package osint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCatalogStats(t *testing.T) {
+	var requestedPath string
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Write([]byte(`[{"COUNTRY":"US"},{"COUNTRY":"PRC"},{"COUNTRY":"US"},{"COUNTRY":null},{"COUNTRY":"CIS"},{"COUNTRY":"US"}]`))
+	})
+
+	counts, err := CatalogStats(client, "Country")
+	if err != nil {
+		t.Fatalf("CatalogStats() failed: %v", err)
+	}
+
+	wantPath := "/class/satcat/CURRENT/Y/DECAY/null-val/predicates/COUNTRY/format/json"
+	if requestedPath != wantPath {
+		t.Errorf("Requested path = %q, want %q", requestedPath, wantPath)
+	}
+	want := map[string]int{"US": 3, "PRC": 1, "CIS": 1, "UNKNOWN": 1}
+	if len(counts) != len(want) {
+		t.Errorf("counts = %v, want %v", counts, want)
+	}
+	for key, count := range want {
+		if counts[key] != count {
+			t.Errorf("counts[%q] = %d, want %d", key, counts[key], count)
+		}
+	}
+}
+
+func TestCatalogStats_Errors(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("not json"))
+	})
+
+	var appErr *AppError
+	if _, err := CatalogStats(client, "mass"); !errors.As(err, &appErr) || appErr.Code != ErrCodeInputInvalid {
+		t.Errorf("Expected %s for unknown grouping, got %v", ErrCodeInputInvalid, err)
+	}
+	if _, err := CatalogStats(client, "type"); !errors.As(err, &appErr) || appErr.Code != ErrCodeAPIParseFailed {
+		t.Errorf("Expected %s for bad response, got %v", ErrCodeAPIParseFailed, err)
+	}
+}
+
+func TestCatalogStatsLines_GroupsTail(t *testing.T) {
+	counts := map[string]int{}
+	for i := 0; i < catalogStatsRows+5; i++ {
+		counts[fmt.Sprintf("C%02d", i)] = 100 - i
+	}
+
+	lines := catalogStatsLines("country", counts)
+	if len(lines) != catalogStatsRows+1 {
+		t.Fatalf("Expected header and %d rows, got %d lines", catalogStatsRows, len(lines))
+	}
+	if !strings.Contains(lines[1], "C00") {
+		t.Errorf("Largest group should come first, got %q", lines[1])
+	}
+	if last := lines[len(lines)-1]; !strings.Contains(last, "OTHER") {
+		t.Errorf("Expected groups beyond the limit summed as OTHER, got %q", last)
+	}
+}
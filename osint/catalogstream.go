@@ -0,0 +1,157 @@
+package osint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// StreamSatellites decodes a JSON array of catalog entries from r one element at a time, calling
+// fn for each as soon as it is parsed. Decoding stops early when fn returns false. An empty body
+// is treated as an empty array.
+func StreamSatellites(r io.Reader, fn func(Satellite) bool) error {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read catalog data: %w", err)
+	}
+	if delim, ok := token.(json.Delim); ok && delim == '{' {
+		// Read the rest of the object to report a Space-Track error envelope
+		var envelope spaceTrackErrorEnvelope
+		rest := io.MultiReader(strings.NewReader("{"), decoder.Buffered(), r)
+		if json.NewDecoder(rest).Decode(&envelope) == nil {
+			if err := envelope.envelopeError(); err != nil {
+				return err
+			}
+		}
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("catalog data is not a JSON array")
+	}
+
+	for decoder.More() {
+		var sat Satellite
+		if err := decoder.Decode(&sat); err != nil {
+			return fmt.Errorf("failed to parse catalog entry: %w", err)
+		}
+		if !fn(sat) {
+			return nil
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("failed to read catalog data: %w", err)
+	}
+	return nil
+}
+
+// catalogStream loads a catalog query in the background, keeping the entries that pass a filter,
+// so the first page can be shown while the rest of the response is still arriving.
+type catalogStream struct {
+	mu    sync.Mutex
+	ready *sync.Cond
+	sats  []Satellite
+	// scanned counts every entry decoded, kept or not
+	scanned int
+	done    bool
+	err     error
+	cancel  context.CancelFunc
+}
+
+// startCatalogStream queries endpoint and streams the entries for which keep returns true.
+func startCatalogStream(client *http.Client, endpoint string, keep func(Satellite) bool) *catalogStream {
+	return startCatalogStreamFrom(func(ctx context.Context) (io.ReadCloser, error) {
+		return openSpaceTrackQuery(ctx, client, endpoint)
+	}, keep)
+}
+
+// startCatalogStreamFrom streams the entries for which keep returns true from the catalog
+// response that open returns.
+func startCatalogStreamFrom(open func(ctx context.Context) (io.ReadCloser, error), keep func(Satellite) bool) *catalogStream {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	stream := &catalogStream{cancel: cancel}
+	stream.ready = sync.NewCond(&stream.mu)
+
+	go func() {
+		defer cancel()
+		err := stream.load(ctx, open, keep)
+
+		stream.mu.Lock()
+		stream.done = true
+		if ctx.Err() != context.Canceled {
+			stream.err = err
+		}
+		stream.mu.Unlock()
+		stream.ready.Broadcast()
+	}()
+	return stream
+}
+
+// load reads the response and appends matching entries as they are decoded.
+func (s *catalogStream) load(ctx context.Context, open func(ctx context.Context) (io.ReadCloser, error), keep func(Satellite) bool) error {
+	body, err := open(ctx)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	err = StreamSatellites(body, func(sat Satellite) bool {
+		kept := keep(sat)
+		s.mu.Lock()
+		s.scanned++
+		if kept {
+			s.sats = append(s.sats, sat)
+		}
+		s.mu.Unlock()
+		if kept {
+			s.ready.Broadcast()
+		}
+		return ctx.Err() == nil
+	})
+	if err == nil && ctx.Err() == nil {
+		// Read to the end so a recording body sees the whole response
+		io.Copy(io.Discard, body)
+	}
+	return err
+}
+
+// waitFor blocks until at least n entries are loaded or the stream ends, then returns the entries
+// loaded so far and whether loading has finished.
+func (s *catalogStream) waitFor(n int) ([]Satellite, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.sats) < n && !s.done {
+		s.ready.Wait()
+	}
+	return s.sats[:len(s.sats):len(s.sats)], s.done, s.err
+}
+
+// seed puts sats ahead of the entries the stream loads, for matches found by another query.
+func (s *catalogStream) seed(sats []Satellite) {
+	if len(sats) == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.sats = append(append([]Satellite{}, sats...), s.sats...)
+	s.mu.Unlock()
+	s.ready.Broadcast()
+}
+
+// fetched returns how many entries the query has returned so far, including filtered-out ones.
+func (s *catalogStream) fetched() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scanned
+}
+
+// Stop abandons the rest of the response.
+func (s *catalogStream) Stop() {
+	s.cancel()
+}
@@ -0,0 +1,204 @@
+package osint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamSatellites_YieldsBeforeBodyIsRead(t *testing.T) {
+	reader, writer := io.Pipe()
+	received := make(chan Satellite)
+	result := make(chan error, 1)
+	go func() {
+		result <- StreamSatellites(reader, func(sat Satellite) bool {
+			received <- sat
+			return true
+		})
+	}()
+
+	// Write the first element only; the rest of the array has not been sent yet.
+	go writer.Write([]byte(`[{"SATNAME":"ISS (ZARYA)","NORAD_CAT_ID":"25544"},`))
+	select {
+	case sat := <-received:
+		if sat.NORAD_CAT_ID != "25544" {
+			t.Errorf("first satellite = %q, want 25544", sat.NORAD_CAT_ID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("first satellite was not yielded before the body was complete")
+	}
+
+	go func() {
+		writer.Write([]byte(`{"SATNAME":"HST","NORAD_CAT_ID":"20580"}]`))
+		writer.Close()
+	}()
+	if sat := <-received; sat.NORAD_CAT_ID != "20580" {
+		t.Errorf("second satellite = %q, want 20580", sat.NORAD_CAT_ID)
+	}
+	if err := <-result; err != nil {
+		t.Errorf("StreamSatellites() error = %v", err)
+	}
+}
+
+func TestStreamSatellites(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    int
+		wantErr bool
+	}{
+		{"empty body", "", 0, false},
+		{"empty array", "[]", 0, false},
+		{"two entries", `[{"NORAD_CAT_ID":"1"},{"NORAD_CAT_ID":"2"}]`, 2, false},
+		{"not an array", `{"error":"unauthorized"}`, 0, true},
+		{"truncated", `[{"NORAD_CAT_ID":"1"},{"NORAD_`, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 0
+			err := StreamSatellites(strings.NewReader(tt.body), func(Satellite) bool {
+				count++
+				return true
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if count != tt.want {
+				t.Errorf("yielded %d satellites, want %d", count, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamSatellites_StopsEarly(t *testing.T) {
+	count := 0
+	err := StreamSatellites(strings.NewReader(`[{"NORAD_CAT_ID":"1"},{"NORAD_CAT_ID":"2"},{"NORAD_CAT_ID":"3"}]`), func(Satellite) bool {
+		count++
+		return count < 2
+	})
+	if err != nil {
+		t.Fatalf("StreamSatellites() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("yielded %d satellites, want 2", count)
+	}
+}
+
+func TestCatalogStream_FirstPageBeforeResponseEnds(t *testing.T) {
+	release := make(chan struct{})
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("["))
+		for i := 1; i <= 3; i++ {
+			fmt.Fprintf(w, `{"SATNAME":"STARLINK-%d","NORAD_CAT_ID":"%d"},`, i, i)
+		}
+		fmt.Fprint(w, `{"SATNAME":"ISS (ZARYA)","NORAD_CAT_ID":"25544"},`)
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte(`{"SATNAME":"STARLINK-4","NORAD_CAT_ID":"4"}]`))
+	})
+	t.Cleanup(func() { close(release) })
+
+	stream := startCatalogStream(client, "/satcat", func(sat Satellite) bool {
+		return satelliteNameMatches(sat, "starlink")
+	})
+	defer stream.Stop()
+
+	sats, done, err := stream.waitFor(3)
+	if err != nil {
+		t.Fatalf("waitFor() error = %v", err)
+	}
+	if done {
+		t.Error("stream reported done while the response was still open")
+	}
+	if len(sats) != 3 {
+		t.Errorf("loaded %d matches, want 3", len(sats))
+	}
+}
+
+func TestCatalogStream_Complete(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"SATNAME":"STARLINK-1","NORAD_CAT_ID":"1"},{"SATNAME":"HST","NORAD_CAT_ID":"20580"}]`))
+	})
+
+	stream := startCatalogStream(client, "/satcat", func(sat Satellite) bool {
+		return satelliteNameMatches(sat, "starlink")
+	})
+	sats, done, err := stream.waitFor(10)
+	if err != nil {
+		t.Fatalf("waitFor() error = %v", err)
+	}
+	if !done || len(sats) != 1 {
+		t.Errorf("waitFor() = %d matches, done %v; want 1, true", len(sats), done)
+	}
+}
+
+func TestCatalogStream_Error(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	stream := startCatalogStream(client, "/satcat", func(Satellite) bool { return true })
+	if _, done, err := stream.waitFor(1); err == nil || !done {
+		t.Errorf("waitFor() done = %v, err = %v; want done with an error", done, err)
+	}
+}
+
+func TestSearchLimitWarning_AtCap(t *testing.T) {
+	original := NameSearchLimit
+	NameSearchLimit = 3
+	t.Cleanup(func() { NameSearchLimit = original })
+
+	for _, tt := range []struct {
+		returned int
+		warn     bool
+	}{{3, true}, {2, false}} {
+		client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if !strings.Contains(r.URL.Path, "/limit/3/") {
+				t.Errorf("query %s does not use the configured limit", r.URL.Path)
+			}
+			var entries []string
+			for i := 0; i < tt.returned; i++ {
+				entries = append(entries, fmt.Sprintf(`{"SATNAME":"SAT %d","NORAD_CAT_ID":"%d"}`, i, i))
+			}
+			w.Write([]byte("[" + strings.Join(entries, ",") + "]"))
+		})
+
+		// Only one entry matches, but the cap is about what the query returned
+		stream := startCatalogStream(client, buildSatcatQuery("SAT 0", "", "", "", 1, 0), func(sat Satellite) bool {
+			return satelliteNameMatches(sat, "SAT 0")
+		})
+		if _, done, err := stream.waitFor(100); !done || err != nil {
+			t.Fatalf("waitFor() done = %v, err = %v", done, err)
+		}
+		warning := searchLimitWarning(stream.fetched(), NameSearchLimit)
+		if (warning != "") != tt.warn {
+			t.Errorf("%d of %d entries returned: warning = %q, want warning %v", tt.returned, NameSearchLimit, warning, tt.warn)
+		}
+	}
+}
+
+func TestStreamSatellites_ErrorEnvelope(t *testing.T) {
+	err := StreamSatellites(strings.NewReader(`{"error":"Query limit exceeded"}`), func(Satellite) bool { return true })
+	if appErr, ok := err.(*AppError); !ok || !strings.Contains(appErr.Message, "Query limit exceeded") {
+		t.Errorf("StreamSatellites() error = %v, want the Space-Track message", err)
+	}
+}
+
+func TestCatalogStream_Seed(t *testing.T) {
+	stream := startCatalogStreamFrom(func(context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(`[{"SATNAME":"LANDSAT 7","NORAD_CAT_ID":"25682"}]`)), nil
+	}, func(Satellite) bool { return true })
+	stream.seed([]Satellite{{SATNAME: "LANDSAT 8", NORAD_CAT_ID: "39084"}})
+
+	sats, done, err := stream.waitFor(3)
+	if !done || err != nil {
+		t.Fatalf("waitFor() done = %v, err = %v", done, err)
+	}
+	if len(sats) != 2 || sats[0].NORAD_CAT_ID != "39084" || sats[1].NORAD_CAT_ID != "25682" {
+		t.Errorf("stream = %+v, want the seeded entry first", sats)
+	}
+}
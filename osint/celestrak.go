@@ -0,0 +1,137 @@
+package osint
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// celesTrakGPURL is the CelesTrak general perturbations endpoint.
+const celesTrakGPURL = "https://celestrak.org/NORAD/elements/gp.php"
+
+// TLEProvider selects where TLE lookups come from.
+type TLEProvider string
+
+const (
+	// TLEProviderSpaceTrack queries Space-Track, which needs an account.
+	TLEProviderSpaceTrack TLEProvider = "spacetrack"
+	// TLEProviderCelesTrak queries CelesTrak, which needs no account.
+	TLEProviderCelesTrak TLEProvider = "celestrak"
+)
+
+// DefaultTLEProvider is the source preselected when looking up a TLE.
+var DefaultTLEProvider = TLEProviderSpaceTrack
+
+// chosenTLEProvider is the source picked in chooseTLEProvider, kept for the rest of the session.
+var chosenTLEProvider TLEProvider
+
+// ParseTLEProvider converts a config value such as "celestrak" to a TLEProvider.
+func ParseTLEProvider(name string) (TLEProvider, error) {
+	switch provider := TLEProvider(strings.ToLower(strings.TrimSpace(name))); provider {
+	case TLEProviderSpaceTrack, TLEProviderCelesTrak:
+		return provider, nil
+	}
+	return "", fmt.Errorf("unknown TLE source %q (use spacetrack or celestrak)", name)
+}
+
+// FetchTLEFromCelesTrak returns the two element lines of a satellite's latest TLE from CelesTrak.
+// No credentials are needed.
+func FetchTLEFromCelesTrak(norad string) (string, string, error) {
+	return fetchTLEFromCelesTrak(celesTrakGPURL, norad)
+}
+
+// fetchTLEFromCelesTrak is FetchTLEFromCelesTrak against the gp.php endpoint at gpURL.
+func fetchTLEFromCelesTrak(gpURL, norad string) (string, string, error) {
+	norad = strings.TrimSpace(norad)
+	context := "NORAD ID: " + norad
+	if Offline {
+		return "", "", NewAppError(ErrCodeAPIRequestFailed, "CelesTrak is unavailable in offline mode")
+	}
+
+	query := url.Values{}
+	query.Set("CATNR", norad)
+	query.Set("FORMAT", "TLE")
+	resp, err := NewHTTPClient(requestTimeout).Get(gpURL + "?" + query.Encode())
+	if err != nil {
+		code := ErrCodeAPIRequestFailed
+		if isTimeout(err) {
+			code = ErrCodeNetworkTimeout
+		}
+		appErr := NewAppErrorWithErr(code, "Failed to fetch TLE data from CelesTrak", err)
+		appErr.Context = context
+		return "", "", appErr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", NewAppErrorWithContext(ErrCodeAPIResponseFailed, fmt.Sprintf("CelesTrak returned %s", resp.Status), context)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed to read CelesTrak response", err)
+		appErr.Context = context
+		return "", "", appErr
+	}
+
+	// CelesTrak answers an unknown or decayed object with a plain-text notice and a 200 status
+	if strings.Contains(string(data), "No GP data found") {
+		return "", "", NewAppErrorWithContext(ErrCodeAPINoData, "CelesTrak has no TLE for this satellite", context)
+	}
+	tles := parseTLEText(norad, string(data))
+	if len(tles) == 0 {
+		return "", "", NewAppErrorWithContext(ErrCodeTLEInvalidFormat, "CelesTrak response contains no TLE", context)
+	}
+	return tles[0].RawLine1, tles[0].RawLine2, nil
+}
+
+// chooseTLEProvider asks where to look up a TLE, starting on DefaultTLEProvider, and keeps the
+// answer for the rest of the session. Without credentials CelesTrak is the only source and is used
+// without asking; non-interactive runs use DefaultTLEProvider.
+func chooseTLEProvider() (TLEProvider, bool) {
+	if !CredentialsConfigured() {
+		return TLEProviderCelesTrak, true
+	}
+	if chosenTLEProvider != "" {
+		return chosenTLEProvider, true
+	}
+	if NonInteractive {
+		return DefaultTLEProvider, true
+	}
+
+	providers := []TLEProvider{TLEProviderSpaceTrack, TLEProviderCelesTrak}
+	cursor := 0
+	if DefaultTLEProvider == TLEProviderCelesTrak {
+		cursor = 1
+	}
+	prompt := promptui.Select{
+		Label:     "TLE Source",
+		Items:     []string{"Space-Track", "CelesTrak (no account needed)"},
+		CursorPos: cursor,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return "", false
+	}
+	chosenTLEProvider = providers[idx]
+	return chosenTLEProvider, true
+}
+
+// printCelesTrakTLE fetches and displays a satellite's TLE from CelesTrak, falling back to the
+// cached copy when CelesTrak cannot be reached.
+func printCelesTrakTLE(norad, name string) {
+	lineOne, lineTwo, err := FetchTLEFromCelesTrak(norad)
+	if err != nil {
+		if printCachedTLE(norad, name, err) {
+			return
+		}
+		HandleErrorWithContext(err, ErrCodeAPINoData, "Failed to fetch TLE data from CelesTrak", "NORAD ID: "+norad)
+		return
+	}
+	storeCachedTLE(norad, lineOne, lineTwo)
+	warnTLEChecksum(lineOne, lineTwo)
+	PrintTLEWithRawLines(ConstructTLE(name, lineOne, lineTwo), lineOne, lineTwo)
+}
@@ -0,0 +1,101 @@
+package osint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// celesTrakServer starts a test server standing in for CelesTrak and returns its URL.
+func celesTrakServer(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestFetchTLEFromCelesTrak(t *testing.T) {
+	var query string
+	gpURL := celesTrakServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprintf(w, "ISS (ZARYA)             \r\n%s\r\n%s\r\n", testTLELine1, testTLELine2)
+	})
+
+	line1, line2, err := fetchTLEFromCelesTrak(gpURL, "25544")
+	if err != nil {
+		t.Fatalf("fetchTLEFromCelesTrak() failed: %v", err)
+	}
+	if line1 != testTLELine1 || line2 != testTLELine2 {
+		t.Errorf("fetchTLEFromCelesTrak() = %q, %q, want the element lines", line1, line2)
+	}
+	if query != "CATNR=25544&FORMAT=TLE" {
+		t.Errorf("query = %q, want CATNR=25544&FORMAT=TLE", query)
+	}
+}
+
+func TestFetchTLEFromCelesTrak_NoData(t *testing.T) {
+	gpURL := celesTrakServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "No GP data found")
+	})
+
+	_, _, err := fetchTLEFromCelesTrak(gpURL, "99999")
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAPINoData {
+		t.Fatalf("fetchTLEFromCelesTrak() error = %v, want %s", err, ErrCodeAPINoData)
+	}
+}
+
+func TestFetchTLEFromCelesTrak_ErrorStatus(t *testing.T) {
+	gpURL := celesTrakServer(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+	})
+
+	_, _, err := fetchTLEFromCelesTrak(gpURL, "25544")
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAPIResponseFailed {
+		t.Fatalf("fetchTLEFromCelesTrak() error = %v, want %s", err, ErrCodeAPIResponseFailed)
+	}
+}
+
+func TestParseTLEProvider(t *testing.T) {
+	if provider, err := ParseTLEProvider(" CelesTrak "); err != nil || provider != TLEProviderCelesTrak {
+		t.Errorf("ParseTLEProvider(CelesTrak) = %q, %v", provider, err)
+	}
+	if _, err := ParseTLEProvider("n2yo"); err == nil {
+		t.Error("ParseTLEProvider(n2yo) should fail")
+	}
+}
+
+func TestChooseTLEProvider_PrefersCelesTrakWithoutCredentials(t *testing.T) {
+	oldNonInteractive := NonInteractive
+	NonInteractive = true
+	t.Cleanup(func() { NonInteractive = oldNonInteractive })
+
+	t.Setenv("SPACE_TRACK_USERNAME", "")
+	t.Setenv("SPACE_TRACK_PASSWORD", "")
+	if provider, ok := chooseTLEProvider(); !ok || provider != TLEProviderCelesTrak {
+		t.Errorf("chooseTLEProvider() without credentials = %q, want celestrak", provider)
+	}
+
+	t.Setenv("SPACE_TRACK_USERNAME", "user")
+	t.Setenv("SPACE_TRACK_PASSWORD", "secret")
+	t.Setenv("N2YO_API_KEY", "key")
+	if provider, ok := chooseTLEProvider(); !ok || provider != DefaultTLEProvider {
+		t.Errorf("chooseTLEProvider() with credentials = %q, want %q", provider, DefaultTLEProvider)
+	}
+}
+
+func TestChooseTLEProvider_KeepsSessionChoice(t *testing.T) {
+	t.Cleanup(func() { chosenTLEProvider = "" })
+	t.Setenv("SPACE_TRACK_USERNAME", "user")
+	t.Setenv("SPACE_TRACK_PASSWORD", "secret")
+	t.Setenv("N2YO_API_KEY", "key")
+
+	// A source picked earlier in the session is used without asking again
+	chosenTLEProvider = TLEProviderCelesTrak
+	if provider, ok := chooseTLEProvider(); !ok || provider != TLEProviderCelesTrak {
+		t.Errorf("chooseTLEProvider() after choosing CelesTrak = %q, want celestrak", provider)
+	}
+}
@@ -0,0 +1,112 @@
+package osint
+
+import (
+	"strings"
+)
+
+// city is one entry of the bundled city database.
+type city struct {
+	Name      string
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// majorCities lets users who don't know their coordinates pick a nearby city instead. City
+// centers are accurate to a few kilometers, which is plenty for pass predictions.
+var majorCities = []city{
+	{"Abu Dhabi", "United Arab Emirates", 24.4539, 54.3773},
+	{"Accra", "Ghana", 5.6037, -0.1870},
+	{"Amsterdam", "Netherlands", 52.3676, 4.9041},
+	{"Anchorage", "United States", 61.2181, -149.9003},
+	{"Athens", "Greece", 37.9838, 23.7275},
+	{"Auckland", "New Zealand", -36.8485, 174.7633},
+	{"Bangkok", "Thailand", 13.7563, 100.5018},
+	{"Barcelona", "Spain", 41.3874, 2.1686},
+	{"Beijing", "China", 39.9042, 116.4074},
+	{"Berlin", "Germany", 52.5200, 13.4050},
+	{"Bogota", "Colombia", 4.7110, -74.0721},
+	{"Boston", "United States", 42.3601, -71.0589},
+	{"Brussels", "Belgium", 50.8503, 4.3517},
+	{"Buenos Aires", "Argentina", -34.6037, -58.3816},
+	{"Cairo", "Egypt", 30.0444, 31.2357},
+	{"Cape Town", "South Africa", -33.9249, 18.4241},
+	{"Chicago", "United States", 41.8781, -87.6298},
+	{"Copenhagen", "Denmark", 55.6761, 12.5683},
+	{"Delhi", "India", 28.7041, 77.1025},
+	{"Denver", "United States", 39.7392, -104.9903},
+	{"Dubai", "United Arab Emirates", 25.2048, 55.2708},
+	{"Dublin", "Ireland", 53.3498, -6.2603},
+	{"Helsinki", "Finland", 60.1699, 24.9384},
+	{"Hong Kong", "China", 22.3193, 114.1694},
+	{"Honolulu", "United States", 21.3069, -157.8583},
+	{"Houston", "United States", 29.7604, -95.3698},
+	{"Istanbul", "Turkey", 41.0082, 28.9784},
+	{"Jakarta", "Indonesia", -6.2088, 106.8456},
+	{"Johannesburg", "South Africa", -26.2041, 28.0473},
+	{"Karachi", "Pakistan", 24.8607, 67.0011},
+	{"Lagos", "Nigeria", 6.5244, 3.3792},
+	{"Lahore", "Pakistan", 31.5204, 74.3587},
+	{"Lima", "Peru", -12.0464, -77.0428},
+	{"Lisbon", "Portugal", 38.7223, -9.1393},
+	{"London", "United Kingdom", 51.5074, -0.1278},
+	{"Los Angeles", "United States", 34.0522, -118.2437},
+	{"Madrid", "Spain", 40.4168, -3.7038},
+	{"Manila", "Philippines", 14.5995, 120.9842},
+	{"Melbourne", "Australia", -37.8136, 144.9631},
+	{"Mexico City", "Mexico", 19.4326, -99.1332},
+	{"Miami", "United States", 25.7617, -80.1918},
+	{"Montreal", "Canada", 45.5019, -73.5674},
+	{"Moscow", "Russia", 55.7558, 37.6173},
+	{"Mumbai", "India", 19.0760, 72.8777},
+	{"Nairobi", "Kenya", -1.2921, 36.8219},
+	{"New York", "United States", 40.7128, -74.0060},
+	{"Oslo", "Norway", 59.9139, 10.7522},
+	{"Paris", "France", 48.8566, 2.3522},
+	{"Rio de Janeiro", "Brazil", -22.9068, -43.1729},
+	{"Riyadh", "Saudi Arabia", 24.7136, 46.6753},
+	{"Rome", "Italy", 41.9028, 12.4964},
+	{"San Francisco", "United States", 37.7749, -122.4194},
+	{"Santiago", "Chile", -33.4489, -70.6693},
+	{"Sao Paulo", "Brazil", -23.5505, -46.6333},
+	{"Seattle", "United States", 47.6062, -122.3321},
+	{"Seoul", "South Korea", 37.5665, 126.9780},
+	{"Shanghai", "China", 31.2304, 121.4737},
+	{"Singapore", "Singapore", 1.3521, 103.8198},
+	{"Stockholm", "Sweden", 59.3293, 18.0686},
+	{"Sydney", "Australia", -33.8688, 151.2093},
+	{"Taipei", "Taiwan", 25.0330, 121.5654},
+	{"Tehran", "Iran", 35.6892, 51.3890},
+	{"Tokyo", "Japan", 35.6762, 139.6503},
+	{"Toronto", "Canada", 43.6532, -79.3832},
+	{"Vancouver", "Canada", 49.2827, -123.1207},
+	{"Vienna", "Austria", 48.2082, 16.3738},
+	{"Warsaw", "Poland", 52.2297, 21.0122},
+	{"Washington", "United States", 38.9072, -77.0369},
+	{"Zurich", "Switzerland", 47.3769, 8.5417},
+}
+
+// findCity returns the bundled city named name, ignoring case and surrounding spaces. A
+// trailing country such as "Paris, France" must match the city's country when given.
+func findCity(name string) (city, bool) {
+	cityName, country, hasCountry := strings.Cut(name, ",")
+	cityName = strings.TrimSpace(cityName)
+	country = strings.TrimSpace(country)
+	for _, c := range majorCities {
+		if !strings.EqualFold(c.Name, cityName) {
+			continue
+		}
+		if hasCountry && !strings.EqualFold(c.Country, country) {
+			continue
+		}
+		return c, true
+	}
+	return city{}, false
+}
+
+// LookupCity resolves a major city name to its coordinates. ok is false for cities that are
+// not in the bundled database.
+func LookupCity(name string) (lat, lon float64, ok bool) {
+	c, ok := findCity(name)
+	return c.Latitude, c.Longitude, ok
+}
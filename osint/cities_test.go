@@ -0,0 +1,41 @@
+package osint
+
+import "testing"
+
+func TestLookupCity(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		ok       bool
+	}{
+		{"London", 51.5074, -0.1278, true},
+		{"  new york ", 40.7128, -74.0060, true},
+		{"Paris, France", 48.8566, 2.3522, true},
+		{"Paris, Texas", 0, 0, false},
+		{"Atlantis", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		lat, lon, ok := LookupCity(tt.name)
+		if lat != tt.lat || lon != tt.lon || ok != tt.ok {
+			t.Errorf("LookupCity(%q) = %v, %v, %v; want %v, %v, %v", tt.name, lat, lon, ok, tt.lat, tt.lon, tt.ok)
+		}
+	}
+}
+
+func TestGetManualLocation_CityName(t *testing.T) {
+	withScanInput(t, "Buenos Aires\n")
+
+	lat, lon, detected := getManualLocation()
+	if lat != "-34.603700" || lon != "-58.381600" || detected {
+		t.Errorf("getManualLocation() = %q, %q, %v; want Buenos Aires coordinates", lat, lon, detected)
+	}
+}
+
+func TestGetManualLocation_UnknownCity(t *testing.T) {
+	withScanInput(t, "Atlantis\n")
+
+	if lat, lon, _ := getManualLocation(); lat != "" || lon != "" {
+		t.Errorf("getManualLocation() = %q, %q; want no location for an unknown city", lat, lon)
+	}
+}
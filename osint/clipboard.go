@@ -0,0 +1,73 @@
+package osint
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// clipboardCommand describes an external program that accepts clipboard content on stdin.
+type clipboardCommand struct {
+	name string
+	args []string
+}
+
+// clipboardContent assembles the three-line element set (name, line 1, line 2) copied to the clipboard.
+func clipboardContent(name, line1, line2 string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "UNSPECIFIED"
+	}
+	return strings.Join([]string{name, strings.TrimSpace(line1), strings.TrimSpace(line2)}, "\n") + "\n"
+}
+
+// clipboardCommands returns the clipboard programs to try for the current platform, in order of preference.
+func clipboardCommands() []clipboardCommand {
+	switch runtime.GOOS {
+	case "darwin":
+		return []clipboardCommand{{name: "pbcopy"}}
+	case "windows":
+		return []clipboardCommand{{name: "clip"}}
+	default:
+		return []clipboardCommand{
+			{name: "wl-copy"},
+			{name: "xclip", args: []string{"-selection", "clipboard"}},
+			{name: "xsel", args: []string{"--clipboard", "--input"}},
+		}
+	}
+}
+
+// copyToClipboard writes text to the system clipboard using the first available clipboard program.
+func copyToClipboard(text string) error {
+	for _, c := range clipboardCommands() {
+		path, err := exec.LookPath(c.name)
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, c.args...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%s failed: %w", c.name, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("no clipboard utility available")
+}
+
+// offerClipboardCopy asks whether to copy the raw TLE to the clipboard, printing it instead if copying fails.
+func offerClipboardCopy(name, line1, line2 string) {
+	if !confirm("Copy TLE to clipboard?", false) {
+		return
+	}
+
+	content := clipboardContent(name, line1, line2)
+	if err := copyToClipboard(content); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Clipboard unavailable ("+err.Error()+"), copy the TLE below:"))
+		fmt.Println()
+		fmt.Print(content)
+		fmt.Println()
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] TLE copied to clipboard"))
+}
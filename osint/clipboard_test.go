@@ -0,0 +1,43 @@
+package osint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClipboardContent(t *testing.T) {
+	line1 := "1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9993"
+	line2 := "2 25544  51.6335 344.7760 0007976 126.2523 325.9359 15.70406856328906"
+
+	content := clipboardContent("ISS (ZARYA)", "  "+line1+" ", line2+"\r")
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %q", len(lines), content)
+	}
+	if lines[0] != "ISS (ZARYA)" {
+		t.Errorf("Expected name line 'ISS (ZARYA)', got %q", lines[0])
+	}
+	if lines[1] != line1 {
+		t.Errorf("Expected line 1 %q, got %q", line1, lines[1])
+	}
+	if lines[2] != line2 {
+		t.Errorf("Expected line 2 %q, got %q", line2, lines[2])
+	}
+	if !strings.HasSuffix(content, "\n") {
+		t.Error("Expected content to end with a newline")
+	}
+}
+
+func TestClipboardContent_EmptyName(t *testing.T) {
+	content := clipboardContent("", "1 A", "2 B")
+	if !strings.HasPrefix(content, "UNSPECIFIED\n") {
+		t.Errorf("Expected UNSPECIFIED name for empty name, got %q", content)
+	}
+}
+
+func TestClipboardCommands(t *testing.T) {
+	if len(clipboardCommands()) == 0 {
+		t.Error("Expected at least one clipboard command for the current platform")
+	}
+}
@@ -0,0 +1,150 @@
+package osint
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompressExports adds .gz to export file names (.kmz for KML) so they are written compressed.
+// HTML pages are left uncompressed. main sets it from the config.
+var CompressExports = false
+
+const (
+	gzipExtension = ".gz"
+	kmzExtension  = ".kmz"
+	// kmzDocument is the name Google Earth looks for inside a KMZ archive.
+	kmzDocument = "doc.kml"
+)
+
+// compressedPath returns the file name an export is written to when CompressExports is set:
+// KML becomes KMZ and other files gain .gz. Names that are already compressed are unchanged, and
+// so are HTML pages, which a browser cannot open from a local .gz file.
+func compressedPath(filePath string) string {
+	if !CompressExports {
+		return filePath
+	}
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case gzipExtension, kmzExtension, ".html":
+		return filePath
+	case ".kml":
+		return strings.TrimSuffix(filePath, filepath.Ext(filePath)) + kmzExtension
+	}
+	return filePath + gzipExtension
+}
+
+// uncompressedExt returns the extension of filePath ignoring a trailing .gz, so "track.csv.gz"
+// gives ".csv".
+func uncompressedExt(filePath string) string {
+	if strings.EqualFold(filepath.Ext(filePath), gzipExtension) {
+		filePath = filePath[:len(filePath)-len(gzipExtension)]
+	}
+	return filepath.Ext(filePath)
+}
+
+// hasExportExt reports whether filePath already ends in ext, optionally followed by .gz. KMZ
+// counts as KML.
+func hasExportExt(filePath, ext string) bool {
+	actual := strings.ToLower(uncompressedExt(filePath))
+	if strings.EqualFold(ext, ".kml") && strings.EqualFold(filepath.Ext(filePath), kmzExtension) {
+		return true
+	}
+	return actual == strings.ToLower(ext)
+}
+
+// withExportExt adds ext to filePath unless it is already there, keeping a trailing .gz last.
+func withExportExt(filePath, ext string) string {
+	if hasExportExt(filePath, ext) {
+		return filePath
+	}
+	if strings.EqualFold(filepath.Ext(filePath), gzipExtension) {
+		return filePath[:len(filePath)-len(gzipExtension)] + ext + gzipExtension
+	}
+	return filePath + ext
+}
+
+// createExportFile creates an export file. Files named *.gz are gzip-compressed and *.kmz files
+// are zip archives holding the content as doc.kml. Closing the writer finishes the file and runs
+// the post-export hook.
+func createExportFile(filePath string) (io.WriteCloser, error) {
+	w, err := openExportFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &hookedExportFile{WriteCloser: w, path: filePath}, nil
+}
+
+// openExportFile creates the file for createExportFile, compressing by extension.
+func openExportFile(filePath string) (io.WriteCloser, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case gzipExtension:
+		return &compressedFile{Writer: gzip.NewWriter(file), file: file}, nil
+	case kmzExtension:
+		archive := zip.NewWriter(file)
+		entry, err := archive.Create(kmzDocument)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		return &compressedFile{Writer: entry, archive: archive, file: file}, nil
+	}
+	return file, nil
+}
+
+// writeExportFile is os.WriteFile for exports, compressing by extension like createExportFile.
+func writeExportFile(filePath string, data []byte) error {
+	w, err := createExportFile(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close() // the failed write keeps the hook from running
+		return err
+	}
+	return w.Close()
+}
+
+// closeCSVExport flushes a CSV export and closes its file. Compressed exports only get their gzip
+// or zip trailer on Close, so an export is complete only when both succeed.
+func closeCSVExport(file io.Closer, writer *csv.Writer) error {
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+	return nil
+}
+
+// compressedFile writes through a gzip stream or zip entry to the underlying file.
+type compressedFile struct {
+	io.Writer
+	archive *zip.Writer
+	file    *os.File
+}
+
+// Close finishes the compressed stream and closes the file.
+func (c *compressedFile) Close() error {
+	var err error
+	if gz, ok := c.Writer.(*gzip.Writer); ok {
+		err = gz.Close()
+	}
+	if c.archive != nil {
+		err = c.archive.Close()
+	}
+	if closeErr := c.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
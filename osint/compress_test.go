@@ -0,0 +1,125 @@
+package osint
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteExportFile_KMZ(t *testing.T) {
+	kml := generateKMLContent(createTestResponse())
+	path := filepath.Join(t.TempDir(), "track.kmz")
+	if err := writeExportFile(path, []byte(kml)); err != nil {
+		t.Fatalf("writeExportFile() failed: %v", err)
+	}
+
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatalf("KMZ is not a valid zip: %v", err)
+	}
+	defer archive.Close()
+	if len(archive.File) != 1 || archive.File[0].Name != "doc.kml" {
+		t.Fatalf("KMZ should hold only doc.kml, got %d entries", len(archive.File))
+	}
+	entry, err := archive.File[0].Open()
+	if err != nil {
+		t.Fatalf("Failed to open doc.kml: %v", err)
+	}
+	defer entry.Close()
+	content, err := io.ReadAll(entry)
+	if err != nil {
+		t.Fatalf("Failed to read doc.kml: %v", err)
+	}
+	if string(content) != kml {
+		t.Errorf("doc.kml does not match the generated KML:\n%s", content)
+	}
+}
+
+func TestExportSatellitePositionCSV_Gzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "positions.csv.gz")
+	if err := exportSatellitePositionCSV(createTestResponse(), path); err != nil {
+		t.Fatalf("exportSatellitePositionCSV() failed: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open export: %v", err)
+	}
+	defer file.Close()
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Export is not gzip-compressed: %v", err)
+	}
+	reader := csv.NewReader(zr)
+	reader.FieldsPerRecord = -1 // position exports have an info section before the rows
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read compressed CSV: %v", err)
+	}
+	if len(records) < 2 {
+		t.Errorf("Expected a header and position rows, got %d records", len(records))
+	}
+}
+
+// failingCloser buffers writes and fails to close, like a compressed file whose trailer cannot be
+// written.
+type failingCloser struct {
+	bytes.Buffer
+}
+
+func (f *failingCloser) Close() error {
+	return errors.New("disk full")
+}
+
+func TestCloseCSVExport_ReportsCloseError(t *testing.T) {
+	file := &failingCloser{}
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"a", "b"}); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+
+	err := closeCSVExport(file, writer)
+	if err == nil {
+		t.Fatal("Expected the close error to be returned")
+	}
+	if file.String() != "a,b\n" {
+		t.Errorf("Rows should be flushed before closing, got %q", file.String())
+	}
+}
+
+func TestCompressedPath(t *testing.T) {
+	original := CompressExports
+	t.Cleanup(func() { CompressExports = original })
+
+	CompressExports = false
+	if got := compressedPath("track.kml"); got != "track.kml" {
+		t.Errorf("compressedPath() with compression off = %q, want it unchanged", got)
+	}
+
+	CompressExports = true
+	tests := map[string]string{
+		"track.kml":       "track.kmz",
+		"track.kmz":       "track.kmz",
+		"passes.json":     "passes.json.gz",
+		"passes.json.gz":  "passes.json.gz",
+		"map_export.html": "map_export.html",
+	}
+	for in, want := range tests {
+		if got := compressedPath(in); got != want {
+			t.Errorf("compressedPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	if got := withExportExt("passes.gz", ".csv"); got != "passes.csv.gz" {
+		t.Errorf("withExportExt() = %q, want the extension before .gz", got)
+	}
+	if got := withExportExt("track.kmz", ".kml"); got != "track.kmz" {
+		t.Errorf("withExportExt() = %q, KMZ should count as KML", got)
+	}
+}
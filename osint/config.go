@@ -0,0 +1,271 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const configFile = "config.json"
+
+// Config holds credentials and preferences read from the user's config directory
+// (e.g. ~/.config/satintel/config.json). Values set in the environment or in a .env file take precedence.
+type Config struct {
+	SpaceTrackUsername string `json:"space_track_username,omitempty"`
+	SpaceTrackPassword string `json:"space_track_password,omitempty"`
+	N2YOAPIKey         string `json:"n2yo_api_key,omitempty"`
+	Theme              string `json:"theme,omitempty"`
+
+	// PostExportCommand runs after every export with the file's path in place of {file}.
+	PostExportCommand string `json:"post_export_command,omitempty"`
+
+	// IncludeDebris shows DEBRIS and ROCKET BODY objects when browsing the catalog without an object type filter.
+	IncludeDebris bool `json:"include_debris,omitempty"`
+
+	// DisableFavoritePrompt stops asking to save each selected satellite to favorites.
+	DisableFavoritePrompt bool `json:"disable_favorite_prompt,omitempty"`
+
+	// PositionSource preselects where current positions come from: "n2yo" (default) or "sgp4".
+	PositionSource string `json:"position_source,omitempty"`
+
+	// TLESource preselects where TLE lookups come from: "spacetrack" (default) or "celestrak".
+	TLESource string `json:"tle_source,omitempty"`
+
+	// PassMarginDegrees and PassMergeGapSeconds tune offline pass prediction: a pass must peak this
+	// far above the minimum elevation, and passes separated by a shorter dip are merged.
+	PassMarginDegrees   *float64 `json:"pass_margin_deg,omitempty"`
+	PassMergeGapSeconds *int     `json:"pass_merge_gap_seconds,omitempty"`
+
+	// TLELineTolerance is how many trailing columns a TLE line may be missing before SGP4 rejects it.
+	TLELineTolerance *int `json:"tle_line_tolerance,omitempty"`
+
+	// NameSearchLimit is how many catalog entries a name search fetches.
+	NameSearchLimit *int `json:"name_search_limit,omitempty"`
+
+	// CatalogCacheHours is how long catalog query results are reused before Space-Track is asked
+	// again. Zero disables the cache.
+	CatalogCacheHours *int `json:"catalog_cache_hours,omitempty"`
+
+	// SnapshotConcurrency and SnapshotTimeoutSeconds bound the favorites snapshot: how many
+	// favorites are looked up at once and how long each may take.
+	SnapshotConcurrency    *int `json:"snapshot_concurrency,omitempty"`
+	SnapshotTimeoutSeconds *int `json:"snapshot_timeout_seconds,omitempty"`
+
+	// N2YOCallBudget is how many N2YO requests a run may make before asking for confirmation.
+	// Zero disables the check.
+	N2YOCallBudget *int `json:"n2yo_call_budget,omitempty"`
+
+	// MapTrackSamples is how many SGP4 positions are added between fetched positions on maps.
+	MapTrackSamples *int `json:"map_track_samples,omitempty"`
+
+	// MapGridlines draws a latitude/longitude grid on the ASCII maps.
+	MapGridlines bool `json:"map_gridlines,omitempty"`
+
+	// CompressExports writes exports gzip-compressed (.gz), and KML as KMZ.
+	CompressExports bool `json:"compress_exports,omitempty"`
+
+	// MapFadeMinutes is how far back in time map markers fade to their dimmest color.
+	MapFadeMinutes *int `json:"map_fade_minutes,omitempty"`
+
+	// Location replaces IP geolocation with a fixed observer location.
+	Location *ConfigLocation `json:"location,omitempty"`
+
+	// Precision sets decimal places per quantity ("coordinate", "altitude", "velocity", "angle").
+	Precision map[string]int `json:"precision,omitempty"`
+}
+
+// ConfigPath returns the location of the config file in the config directory (see ConfigDir).
+func ConfigPath() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, configFile), nil
+}
+
+// LoadConfig reads the config file. A missing file is not an error and yields an empty Config.
+func LoadConfig() (*Config, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return &Config{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Config{}, nil
+		}
+		return &Config{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return &Config{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// ConfigLocation is a fixed observer location set in the config file.
+type ConfigLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name,omitempty"`
+}
+
+// ApplyPrecision applies the configured decimal places, returning the first invalid setting.
+func (c *Config) ApplyPrecision() error {
+	for name, digits := range c.Precision {
+		if err := SetPrecisionByName(name, digits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnvValues maps the environment variable names SatIntel reads to the values set in the config.
+// Empty fields are omitted.
+func (c *Config) EnvValues() map[string]string {
+	values := map[string]string{
+		"SPACE_TRACK_USERNAME": c.SpaceTrackUsername,
+		"SPACE_TRACK_PASSWORD": c.SpaceTrackPassword,
+		"N2YO_API_KEY":         c.N2YOAPIKey,
+		"SATINTEL_THEME":       c.Theme,
+		"SATINTEL_POST_EXPORT": c.PostExportCommand,
+	}
+	for key, value := range values {
+		if value == "" {
+			delete(values, key)
+		}
+	}
+	return values
+}
+
+// ApplyPassDetection applies the configured pass margin and merge gap.
+func (c *Config) ApplyPassDetection() error {
+	if c.PassMarginDegrees != nil {
+		if *c.PassMarginDegrees < 0 {
+			return fmt.Errorf("pass_margin_deg must not be negative")
+		}
+		PassDetection.Margin = *c.PassMarginDegrees
+	}
+	if c.PassMergeGapSeconds != nil {
+		if *c.PassMergeGapSeconds < 0 {
+			return fmt.Errorf("pass_merge_gap_seconds must not be negative")
+		}
+		PassDetection.MergeGap = time.Duration(*c.PassMergeGapSeconds) * time.Second
+	}
+	return nil
+}
+
+// ApplySnapshotOptions applies the configured favorites snapshot concurrency and timeout.
+func (c *Config) ApplySnapshotOptions() error {
+	if c.SnapshotConcurrency != nil {
+		if *c.SnapshotConcurrency < 1 {
+			return fmt.Errorf("snapshot_concurrency must be at least 1")
+		}
+		SnapshotSettings.MaxConcurrency = *c.SnapshotConcurrency
+	}
+	if c.SnapshotTimeoutSeconds != nil {
+		if *c.SnapshotTimeoutSeconds < 1 {
+			return fmt.Errorf("snapshot_timeout_seconds must be at least 1")
+		}
+		SnapshotSettings.ItemTimeout = time.Duration(*c.SnapshotTimeoutSeconds) * time.Second
+	}
+	return nil
+}
+
+// ApplyN2YOCallBudget applies the configured N2YO request budget.
+func (c *Config) ApplyN2YOCallBudget() error {
+	if c.N2YOCallBudget == nil {
+		return nil
+	}
+	if *c.N2YOCallBudget < 0 {
+		return fmt.Errorf("n2yo_call_budget must not be negative")
+	}
+	N2YOCallBudget = *c.N2YOCallBudget
+	return nil
+}
+
+// ApplyLocation makes the configured location the default location provider, if one is set.
+func (c *Config) ApplyLocation() error {
+	if c.Location == nil {
+		return nil
+	}
+	if c.Location.Latitude < -90 || c.Location.Latitude > 90 {
+		return fmt.Errorf("location latitude must be between -90 and 90")
+	}
+	if c.Location.Longitude < -180 || c.Location.Longitude > 180 {
+		return fmt.Errorf("location longitude must be between -180 and 180")
+	}
+	DefaultLocationProvider = FixedLocationProvider{Location: LocationData{
+		Latitude:  c.Location.Latitude,
+		Longitude: c.Location.Longitude,
+		City:      c.Location.Name,
+	}}
+	return nil
+}
+
+// ApplyNameSearchLimit applies the configured name search limit.
+func (c *Config) ApplyNameSearchLimit() error {
+	if c.NameSearchLimit == nil {
+		return nil
+	}
+	if *c.NameSearchLimit <= 0 {
+		return fmt.Errorf("name_search_limit must be positive")
+	}
+	NameSearchLimit = *c.NameSearchLimit
+	return nil
+}
+
+// ApplyCatalogCacheTTL applies the configured catalog cache lifetime.
+func (c *Config) ApplyCatalogCacheTTL() error {
+	if c.CatalogCacheHours == nil {
+		return nil
+	}
+	if *c.CatalogCacheHours < 0 {
+		return fmt.Errorf("catalog_cache_hours must not be negative")
+	}
+	CatalogCacheTTL = time.Duration(*c.CatalogCacheHours) * time.Hour
+	return nil
+}
+
+// maxMapTrackSamples bounds map densification so a typo can't stall the map for minutes.
+const maxMapTrackSamples = 10000
+
+// ApplyMapTrackSamples applies the configured number of positions added to mapped tracks.
+func (c *Config) ApplyMapTrackSamples() error {
+	if c.MapTrackSamples == nil {
+		return nil
+	}
+	if *c.MapTrackSamples < 0 || *c.MapTrackSamples > maxMapTrackSamples {
+		return fmt.Errorf("map_track_samples must be between 0 and %d", maxMapTrackSamples)
+	}
+	MapTrackSamples = *c.MapTrackSamples
+	return nil
+}
+
+// ApplyMapFade applies the configured map marker fade window.
+func (c *Config) ApplyMapFade() error {
+	if c.MapFadeMinutes == nil {
+		return nil
+	}
+	if *c.MapFadeMinutes <= 0 {
+		return fmt.Errorf("map_fade_minutes must be positive")
+	}
+	MapFadeWindow = time.Duration(*c.MapFadeMinutes) * time.Minute
+	return nil
+}
+
+// ApplyTLELineTolerance applies the configured TLE line length tolerance.
+func (c *Config) ApplyTLELineTolerance() error {
+	if c.TLELineTolerance == nil {
+		return nil
+	}
+	if *c.TLELineTolerance < 0 || *c.TLELineTolerance > maxTLELineTolerance {
+		return fmt.Errorf("tle_line_tolerance must be between 0 and %d", maxTLELineTolerance)
+	}
+	TLELineTolerance = *c.TLELineTolerance
+	return nil
+}
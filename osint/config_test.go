@@ -0,0 +1,283 @@
+package osint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func withConfigDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("AppData", dir)
+	return dir
+}
+
+func writeConfigFile(t *testing.T, content string) {
+	t.Helper()
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+}
+
+func TestLoadConfig_MissingFile(t *testing.T) {
+	withConfigDir(t)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected no error for missing config, got %v", err)
+	}
+	if cfg == nil {
+		t.Fatal("Expected empty config, got nil")
+	}
+	if len(cfg.EnvValues()) != 0 {
+		t.Errorf("Expected no values from empty config, got %v", cfg.EnvValues())
+	}
+}
+
+func TestLoadConfig_ReadsValues(t *testing.T) {
+	withConfigDir(t)
+	writeConfigFile(t, `{
+  "space_track_username": "cfguser",
+  "space_track_password": "cfgpass",
+  "n2yo_api_key": "cfgkey",
+  "theme": "mono"
+}`)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+
+	expected := map[string]string{
+		"SPACE_TRACK_USERNAME": "cfguser",
+		"SPACE_TRACK_PASSWORD": "cfgpass",
+		"N2YO_API_KEY":         "cfgkey",
+		"SATINTEL_THEME":       "mono",
+	}
+	values := cfg.EnvValues()
+	for key, want := range expected {
+		if values[key] != want {
+			t.Errorf("%s = %q, want %q", key, values[key], want)
+		}
+	}
+}
+
+func TestLoadConfig_PartialValues(t *testing.T) {
+	withConfigDir(t)
+	writeConfigFile(t, `{"n2yo_api_key": "cfgkey"}`)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	values := cfg.EnvValues()
+	if len(values) != 1 || values["N2YO_API_KEY"] != "cfgkey" {
+		t.Errorf("Expected only N2YO_API_KEY, got %v", values)
+	}
+}
+
+func TestLoadConfig_Malformed(t *testing.T) {
+	withConfigDir(t)
+	writeConfigFile(t, `{not json`)
+
+	cfg, err := LoadConfig()
+	if err == nil {
+		t.Error("Expected error for malformed config")
+	}
+	if cfg == nil {
+		t.Error("Expected a non-nil config even on error")
+	}
+}
+
+func TestConfig_ApplyPassDetection(t *testing.T) {
+	original := PassDetection
+	t.Cleanup(func() { PassDetection = original })
+
+	withConfigDir(t)
+	writeConfigFile(t, `{"pass_margin_deg": 0, "pass_merge_gap_seconds": 60}`)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if err := cfg.ApplyPassDetection(); err != nil {
+		t.Fatalf("ApplyPassDetection() error: %v", err)
+	}
+	if PassDetection.Margin != 0 || PassDetection.MergeGap != time.Minute {
+		t.Errorf("PassDetection = %+v, want margin 0 and a one minute merge gap", PassDetection)
+	}
+
+	negative := -1.0
+	if err := (&Config{PassMarginDegrees: &negative}).ApplyPassDetection(); err == nil {
+		t.Error("Expected error for negative margin")
+	}
+}
+
+func TestConfig_ApplySnapshotOptions(t *testing.T) {
+	original := SnapshotSettings
+	t.Cleanup(func() { SnapshotSettings = original })
+
+	withConfigDir(t)
+	writeConfigFile(t, `{"snapshot_concurrency": 8, "snapshot_timeout_seconds": 5}`)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if err := cfg.ApplySnapshotOptions(); err != nil {
+		t.Fatalf("ApplySnapshotOptions() error: %v", err)
+	}
+	if SnapshotSettings.MaxConcurrency != 8 || SnapshotSettings.ItemTimeout != 5*time.Second {
+		t.Errorf("SnapshotSettings = %+v, want 8 at a time and a 5 second timeout", SnapshotSettings)
+	}
+
+	zero := 0
+	if err := (&Config{SnapshotConcurrency: &zero}).ApplySnapshotOptions(); err == nil {
+		t.Error("Expected error for zero concurrency")
+	}
+}
+
+func TestConfig_ApplyN2YOCallBudget(t *testing.T) {
+	original := N2YOCallBudget
+	t.Cleanup(func() { N2YOCallBudget = original })
+
+	withConfigDir(t)
+	writeConfigFile(t, `{"n2yo_call_budget": 50}`)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if err := cfg.ApplyN2YOCallBudget(); err != nil {
+		t.Fatalf("ApplyN2YOCallBudget() error: %v", err)
+	}
+	if N2YOCallBudget != 50 {
+		t.Errorf("N2YOCallBudget = %d, want 50", N2YOCallBudget)
+	}
+
+	negative := -1
+	if err := (&Config{N2YOCallBudget: &negative}).ApplyN2YOCallBudget(); err == nil {
+		t.Error("Expected error for a negative budget")
+	}
+}
+
+func TestConfig_ApplyLocation(t *testing.T) {
+	original := DefaultLocationProvider
+	t.Cleanup(func() { DefaultLocationProvider = original })
+
+	withConfigDir(t)
+	writeConfigFile(t, `{"location": {"latitude": 40.7128, "longitude": -74.006, "name": "New York"}}`)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if err := cfg.ApplyLocation(); err != nil {
+		t.Fatalf("ApplyLocation() error: %v", err)
+	}
+	location, err := DefaultLocationProvider.Locate()
+	if err != nil || location.Latitude != 40.7128 || location.Longitude != -74.006 || location.City != "New York" {
+		t.Errorf("Default provider location = %+v, %v; want the configured location", location, err)
+	}
+
+	if err := (&Config{Location: &ConfigLocation{Latitude: 91}}).ApplyLocation(); err == nil {
+		t.Error("Expected error for latitude out of range")
+	}
+}
+
+func TestConfig_ApplyTLELineTolerance(t *testing.T) {
+	original := TLELineTolerance
+	t.Cleanup(func() { TLELineTolerance = original })
+
+	tolerance := 2
+	if err := (&Config{TLELineTolerance: &tolerance}).ApplyTLELineTolerance(); err != nil {
+		t.Fatalf("ApplyTLELineTolerance() error: %v", err)
+	}
+	if TLELineTolerance != 2 {
+		t.Errorf("TLELineTolerance = %d, want 2", TLELineTolerance)
+	}
+
+	tooLarge := maxTLELineTolerance + 1
+	if err := (&Config{TLELineTolerance: &tooLarge}).ApplyTLELineTolerance(); err == nil {
+		t.Error("Expected error for a tolerance above the maximum")
+	}
+}
+
+func TestConfig_ApplyNameSearchLimit(t *testing.T) {
+	original := NameSearchLimit
+	t.Cleanup(func() { NameSearchLimit = original })
+
+	limit := 2000
+	if err := (&Config{NameSearchLimit: &limit}).ApplyNameSearchLimit(); err != nil {
+		t.Fatalf("ApplyNameSearchLimit() error: %v", err)
+	}
+	if NameSearchLimit != 2000 {
+		t.Errorf("NameSearchLimit = %d, want 2000", NameSearchLimit)
+	}
+
+	negative := -1
+	if err := (&Config{NameSearchLimit: &negative}).ApplyNameSearchLimit(); err == nil {
+		t.Error("Expected error for a negative limit")
+	}
+}
+
+func TestConfig_ApplyMapTrackSamples(t *testing.T) {
+	original := MapTrackSamples
+	t.Cleanup(func() { MapTrackSamples = original })
+
+	samples := 50
+	if err := (&Config{MapTrackSamples: &samples}).ApplyMapTrackSamples(); err != nil {
+		t.Fatalf("ApplyMapTrackSamples() error: %v", err)
+	}
+	if MapTrackSamples != 50 {
+		t.Errorf("MapTrackSamples = %d, want 50", MapTrackSamples)
+	}
+
+	tooMany := maxMapTrackSamples + 1
+	if err := (&Config{MapTrackSamples: &tooMany}).ApplyMapTrackSamples(); err == nil {
+		t.Error("Expected error for too many samples")
+	}
+}
+
+func TestConfig_ApplyMapFade(t *testing.T) {
+	original := MapFadeWindow
+	t.Cleanup(func() { MapFadeWindow = original })
+
+	minutes := 30
+	if err := (&Config{MapFadeMinutes: &minutes}).ApplyMapFade(); err != nil {
+		t.Fatalf("ApplyMapFade() error: %v", err)
+	}
+	if MapFadeWindow != 30*time.Minute {
+		t.Errorf("MapFadeWindow = %v, want 30m", MapFadeWindow)
+	}
+
+	zero := 0
+	if err := (&Config{MapFadeMinutes: &zero}).ApplyMapFade(); err == nil {
+		t.Error("Expected error for a zero fade window")
+	}
+}
+
+func TestConfig_ApplyCatalogCacheTTL(t *testing.T) {
+	original := CatalogCacheTTL
+	t.Cleanup(func() { CatalogCacheTTL = original })
+
+	hours := 6
+	if err := (&Config{CatalogCacheHours: &hours}).ApplyCatalogCacheTTL(); err != nil {
+		t.Fatalf("ApplyCatalogCacheTTL() error: %v", err)
+	}
+	if CatalogCacheTTL != 6*time.Hour {
+		t.Errorf("CatalogCacheTTL = %v, want 6h", CatalogCacheTTL)
+	}
+
+	negative := -1
+	if err := (&Config{CatalogCacheHours: &negative}).ApplyCatalogCacheTTL(); err == nil {
+		t.Error("Expected error for a negative cache lifetime")
+	}
+}
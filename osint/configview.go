@@ -0,0 +1,186 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Where an effective setting comes from.
+const (
+	configSourceEnvironment = "environment"
+	configSourceFile        = "config file"
+	configSourceDefault     = "default"
+)
+
+// ConfigSetting is one setting in effect, as listed by `config show`.
+type ConfigSetting struct {
+	Name   string // key in the config file
+	Value  string
+	Source string // "environment", "config file" or "default"
+}
+
+// EffectiveConfig lists every setting with the value in effect for cfg and where it comes from.
+// Values in the environment override the config file for the settings that read one; secrets are
+// masked. Unset settings show their built-in defaults.
+func EffectiveConfig(cfg *Config) []ConfigSetting {
+	var settings []ConfigSetting
+	fromFile := func(name string, set bool, value, def string) {
+		setting := ConfigSetting{Name: name, Value: def, Source: configSourceDefault}
+		if set {
+			setting.Value, setting.Source = value, configSourceFile
+		}
+		settings = append(settings, setting)
+	}
+	fromEnv := func(name, envKey, fileValue, def string, secret bool) {
+		setting := ConfigSetting{Name: name, Value: def, Source: configSourceDefault}
+		// main copies config values into unset variables, so a matching value came from the file
+		if value := os.Getenv(envKey); value != "" && value != fileValue {
+			setting.Value, setting.Source = value, configSourceEnvironment
+		} else if fileValue != "" {
+			setting.Value, setting.Source = fileValue, configSourceFile
+		}
+		if secret && setting.Source != configSourceDefault {
+			setting.Value = "********"
+		}
+		settings = append(settings, setting)
+	}
+
+	fromEnv("space_track_username", "SPACE_TRACK_USERNAME", cfg.SpaceTrackUsername, "(not set)", false)
+	fromEnv("space_track_password", "SPACE_TRACK_PASSWORD", cfg.SpaceTrackPassword, "(not set)", true)
+	fromEnv("n2yo_api_key", "N2YO_API_KEY", cfg.N2YOAPIKey, "(not set)", true)
+	fromEnv("theme", "SATINTEL_THEME", cfg.Theme, "default", false)
+	fromEnv("post_export_command", postExportEnv, cfg.PostExportCommand, "(none)", false)
+
+	fromFile("include_debris", cfg.IncludeDebris, "true", "false")
+	switch strings.ToLower(strings.TrimSpace(os.Getenv("SATINTEL_NO_FAVORITE_PROMPT"))) {
+	case "1", "true", "yes":
+		settings = append(settings, ConfigSetting{Name: "disable_favorite_prompt", Value: "true", Source: configSourceEnvironment})
+	default:
+		fromFile("disable_favorite_prompt", cfg.DisableFavoritePrompt, "true", "false")
+	}
+	fromFile("position_source", cfg.PositionSource != "", cfg.PositionSource, string(PositionSourceN2YO))
+	fromFile("tle_source", cfg.TLESource != "", cfg.TLESource, string(TLEProviderSpaceTrack))
+
+	fromFile("pass_margin_deg", cfg.PassMarginDegrees != nil, formatOptionalFloat(cfg.PassMarginDegrees),
+		strconv.FormatFloat(defaultPassMargin, 'f', -1, 64))
+	fromFile("pass_merge_gap_seconds", cfg.PassMergeGapSeconds != nil, formatOptionalInt(cfg.PassMergeGapSeconds),
+		strconv.Itoa(int(defaultPassMergeGap.Seconds())))
+	fromFile("tle_line_tolerance", cfg.TLELineTolerance != nil, formatOptionalInt(cfg.TLELineTolerance), strconv.Itoa(TLELineTolerance))
+	fromFile("name_search_limit", cfg.NameSearchLimit != nil, formatOptionalInt(cfg.NameSearchLimit), strconv.Itoa(NameSearchLimit))
+	fromFile("catalog_cache_hours", cfg.CatalogCacheHours != nil, formatOptionalInt(cfg.CatalogCacheHours),
+		strconv.Itoa(int(CatalogCacheTTL.Hours())))
+	fromFile("snapshot_concurrency", cfg.SnapshotConcurrency != nil, formatOptionalInt(cfg.SnapshotConcurrency),
+		strconv.Itoa(SnapshotSettings.MaxConcurrency))
+	fromFile("snapshot_timeout_seconds", cfg.SnapshotTimeoutSeconds != nil, formatOptionalInt(cfg.SnapshotTimeoutSeconds),
+		strconv.Itoa(int(SnapshotSettings.ItemTimeout.Seconds())))
+	fromFile("n2yo_call_budget", cfg.N2YOCallBudget != nil, formatOptionalInt(cfg.N2YOCallBudget), strconv.Itoa(N2YOCallBudget))
+	fromFile("map_track_samples", cfg.MapTrackSamples != nil, formatOptionalInt(cfg.MapTrackSamples), strconv.Itoa(MapTrackSamples))
+	fromFile("map_gridlines", cfg.MapGridlines, "true", "false")
+	fromFile("compress_exports", cfg.CompressExports, "true", "false")
+	fromFile("map_fade_minutes", cfg.MapFadeMinutes != nil, formatOptionalInt(cfg.MapFadeMinutes),
+		strconv.Itoa(int(MapFadeWindow.Minutes())))
+
+	location := ""
+	if cfg.Location != nil {
+		location = fmt.Sprintf("%.4f, %.4f", cfg.Location.Latitude, cfg.Location.Longitude)
+		if cfg.Location.Name != "" {
+			location += " (" + cfg.Location.Name + ")"
+		}
+	}
+	fromFile("location", cfg.Location != nil, location, "detected from IP address")
+
+	digits := make(map[string]int, len(quantityNames))
+	for name, q := range quantityNames {
+		digits[name] = defaultPrecision[q]
+	}
+	def := formatPrecision(digits)
+	for name, value := range cfg.Precision {
+		digits[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+	fromFile("precision", len(cfg.Precision) > 0, formatPrecision(digits), def)
+
+	return settings
+}
+
+// formatOptionalInt formats an optional config number, or returns "" when it is unset.
+func formatOptionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// formatOptionalFloat formats an optional config number, or returns "" when it is unset.
+func formatOptionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+// formatPrecision lists decimal places per quantity by name, such as "altitude=2, angle=2".
+func formatPrecision(digits map[string]int) string {
+	names := make([]string, 0, len(digits))
+	for name := range digits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%d", name, digits[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PrintEffectiveConfig prints the settings in effect, one per line with their source.
+func PrintEffectiveConfig(settings []ConfigSetting) {
+	if path, err := ConfigPath(); err == nil {
+		fmt.Println("Config file: " + path)
+	}
+	for _, setting := range settings {
+		fmt.Printf("  %-25s %-40s %s\n", setting.Name, setting.Value, setting.Source)
+	}
+}
+
+// ResetConfig restores every preference in the config file to its default, keeping only the
+// Space-Track and N2YO credentials. The previous file is kept alongside it with a .bak suffix,
+// whose path is returned. A missing config file is left alone and gives an empty path.
+func ResetConfig() (string, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	backup := path + ".bak"
+	if err := os.WriteFile(backup, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	reset := Config{
+		SpaceTrackUsername: cfg.SpaceTrackUsername,
+		SpaceTrackPassword: cfg.SpaceTrackPassword,
+		N2YOAPIKey:         cfg.N2YOAPIKey,
+	}
+	data, err = json.MarshalIndent(reset, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return backup, nil
+}
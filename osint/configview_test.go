@@ -0,0 +1,65 @@
+package osint
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEffectiveConfig_Sources(t *testing.T) {
+	withConfigDir(t)
+	writeConfigFile(t, `{"theme": "dark", "n2yo_api_key": "FILEKEY", "name_search_limit": 2000}`)
+	t.Setenv("SATINTEL_THEME", "mono")
+	t.Setenv("N2YO_API_KEY", "FILEKEY")
+	t.Setenv("SPACE_TRACK_USERNAME", "")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	settings := make(map[string]ConfigSetting)
+	for _, setting := range EffectiveConfig(cfg) {
+		settings[setting.Name] = setting
+	}
+
+	tests := []struct {
+		name, value, source string
+	}{
+		{"theme", "mono", configSourceEnvironment},
+		{"n2yo_api_key", "********", configSourceFile},
+		{"name_search_limit", "2000", configSourceFile},
+		{"space_track_username", "(not set)", configSourceDefault},
+		{"snapshot_concurrency", "4", configSourceDefault},
+	}
+	for _, tt := range tests {
+		if got := settings[tt.name]; got.Value != tt.value || got.Source != tt.source {
+			t.Errorf("%s = %q from %s, want %q from %s", tt.name, got.Value, got.Source, tt.value, tt.source)
+		}
+	}
+}
+
+func TestResetConfig_KeepsCredentials(t *testing.T) {
+	withConfigDir(t)
+	if backup, err := ResetConfig(); err != nil || backup != "" {
+		t.Fatalf("ResetConfig() without a config = %q, %v; want nothing done", backup, err)
+	}
+
+	writeConfigFile(t, `{"space_track_username": "user", "theme": "dark", "compress_exports": true, "name_search_limit": 2000}`)
+	backup, err := ResetConfig()
+	if err != nil {
+		t.Fatalf("ResetConfig() error: %v", err)
+	}
+	if _, err := os.Stat(backup); err != nil {
+		t.Errorf("Expected the previous config backed up at %s: %v", backup, err)
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error: %v", err)
+	}
+	if cfg.SpaceTrackUsername != "user" {
+		t.Errorf("SpaceTrackUsername = %q, want the credentials kept", cfg.SpaceTrackUsername)
+	}
+	if cfg.Theme != "" || cfg.CompressExports || cfg.NameSearchLimit != nil {
+		t.Errorf("Expected preferences cleared, got %+v", cfg)
+	}
+}
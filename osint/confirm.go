@@ -0,0 +1,45 @@
+package osint
+
+import (
+	"io"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// AssumeDefaults answers every confirmation with its default, as set by --yes.
+var AssumeDefaults bool
+
+// confirmInput is where confirmations are read from. Nil means standard input.
+var confirmInput io.ReadCloser
+
+// confirm asks a yes/no question and returns the answer. An empty answer takes the default, and
+// in non-interactive runs or with AssumeDefaults the default is returned without prompting.
+// Cancelling the prompt counts as no.
+func confirm(label string, defaultYes bool) bool {
+	if NonInteractive || AssumeDefaults {
+		return defaultYes
+	}
+
+	defaultAnswer := "n"
+	if defaultYes {
+		defaultAnswer = "y"
+	}
+	prompt := promptui.Prompt{
+		Label:   label + " (y/n)",
+		Default: defaultAnswer,
+		Stdin:   confirmInput,
+	}
+	answer, err := prompt.Run()
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "":
+		return defaultYes
+	case "y", "yes":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package osint
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+// failingReader fails the test if a prompt reads from it.
+type failingReader struct{ t *testing.T }
+
+func (r failingReader) Read([]byte) (int, error) {
+	r.t.Error("confirm() read input in non-interactive mode")
+	return 0, io.EOF
+}
+
+func (r failingReader) Close() error { return nil }
+
+func withConfirmInput(t *testing.T, input io.ReadCloser) {
+	t.Helper()
+	original := confirmInput
+	confirmInput = input
+	t.Cleanup(func() { confirmInput = original })
+}
+
+func TestConfirm_NonInteractiveReturnsDefault(t *testing.T) {
+	withConfirmInput(t, failingReader{t})
+
+	NonInteractive = true
+	t.Cleanup(func() { NonInteractive = false })
+	if !confirm("Use this location?", true) {
+		t.Error("Expected default yes")
+	}
+	if confirm("Export?", false) {
+		t.Error("Expected default no")
+	}
+
+	NonInteractive = false
+	AssumeDefaults = true
+	t.Cleanup(func() { AssumeDefaults = false })
+	if !confirm("Use this location?", true) || confirm("Export?", false) {
+		t.Error("AssumeDefaults should return the defaults")
+	}
+}
+
+func TestConfirm_ReadsAnswer(t *testing.T) {
+	tests := []struct {
+		input      string
+		defaultYes bool
+		want       bool
+	}{
+		{"y\n", false, true},
+		{"yes\n", false, true},
+		{"\n", true, true},
+		{"\n", false, false},
+	}
+	for _, tt := range tests {
+		withConfirmInput(t, io.NopCloser(strings.NewReader(tt.input)))
+		if got := confirm("Export?", tt.defaultYes); got != tt.want {
+			t.Errorf("confirm() with input %q, default %v = %v, want %v", tt.input, tt.defaultYes, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// meanEarthRadiusKm is used for great-circle distances between sub-satellite points.
+const meanEarthRadiusKm = 6371.0
+
+// staleTLEThresholdKm is the ground-track disagreement above which the TLE is likely out of date.
+const staleTLEThresholdKm = 50.0
+
+// PositionDiscrepancy compares an N2YO-reported position with a local SGP4 prediction for the same instant.
+type PositionDiscrepancy struct {
+	NORADID   string
+	Timestamp int64
+	N2YO      Position
+	SGP4      SGP4PositionResult
+
+	LatitudeDelta    float64 // N2YO minus SGP4, degrees
+	LongitudeDelta   float64 // N2YO minus SGP4, degrees, wrapped to [-180, 180]
+	AltitudeDelta    float64 // N2YO minus SGP4, km
+	AzimuthDelta     float64 // N2YO minus SGP4, degrees, wrapped to [-180, 180]
+	ElevationDelta   float64 // N2YO minus SGP4, degrees
+	GroundDistanceKm float64 // Great-circle distance between the two sub-satellite points
+}
+
+// LikelyStaleTLE reports whether the two positions disagree enough to suggest an outdated element set.
+func (d PositionDiscrepancy) LikelyStaleTLE() bool {
+	return d.GroundDistanceKm > staleTLEThresholdKm
+}
+
+// wrapDegrees normalizes an angle difference to the range [-180, 180].
+func wrapDegrees(delta float64) float64 {
+	delta = math.Mod(delta+180, 360)
+	if delta < 0 {
+		delta += 360
+	}
+	return delta - 180
+}
+
+// greatCircleDistanceKm returns the haversine distance between two latitude/longitude points.
+func greatCircleDistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * meanEarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// comparePositions computes the discrepancy between an N2YO position and an SGP4 result.
+func comparePositions(norad string, n2yo Position, sgp4 SGP4PositionResult) PositionDiscrepancy {
+	return PositionDiscrepancy{
+		NORADID:          norad,
+		Timestamp:        n2yo.Timestamp,
+		N2YO:             n2yo,
+		SGP4:             sgp4,
+		LatitudeDelta:    n2yo.Satlatitude - sgp4.Position.Latitude,
+		LongitudeDelta:   wrapDegrees(n2yo.Satlongitude - sgp4.Position.Longitude),
+		AltitudeDelta:    n2yo.Sataltitude - sgp4.Position.Altitude,
+		AzimuthDelta:     wrapDegrees(n2yo.Azimuth - sgp4.LookAngles.Azimuth),
+		ElevationDelta:   n2yo.Elevation - sgp4.LookAngles.Elevation,
+		GroundDistanceKm: greatCircleDistanceKm(n2yo.Satlatitude, n2yo.Satlongitude, sgp4.Position.Latitude, sgp4.Position.Longitude),
+	}
+}
+
+// DiagnosePositionDiscrepancy fetches the current N2YO position of a satellite, propagates the cached TLE
+// with SGP4 to the same instant, and reports how far the two positions disagree. A large disagreement means
+// the cached element set is out of date. Only the position request uses N2YO quota.
+func DiagnosePositionDiscrepancy(norad string, observer ObserverPosition) (PositionDiscrepancy, error) {
+	positions, err := FetchSatellitePositions(
+		norad,
+		strconv.FormatFloat(observer.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(observer.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(observer.Altitude, 'f', -1, 64),
+		1,
+	)
+	if err != nil {
+		return PositionDiscrepancy{}, err
+	}
+	if len(positions.Positions) == 0 {
+		return PositionDiscrepancy{}, NewAppErrorWithContext(ErrCodeAPIResponseFailed, "N2YO returned no positions", fmt.Sprintf("NORAD ID: %s", norad))
+	}
+
+	line1, line2, err := cachedTLE(norad)
+	if err != nil {
+		return PositionDiscrepancy{}, err
+	}
+
+	n2yoPos := positions.Positions[0]
+	at := time.Unix(n2yoPos.Timestamp, 0).UTC()
+	predicted, err := CalculateSGP4PositionWithObserver(line1, line2, at, observer)
+	if err != nil {
+		return PositionDiscrepancy{}, err
+	}
+
+	return comparePositions(norad, n2yoPos, predicted), nil
+}
+
+// PrintPositionDiscrepancy displays an N2YO versus SGP4 comparison in a formatted table.
+func PrintPositionDiscrepancy(d PositionDiscrepancy) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║              N2YO vs SGP4 Position Check                    ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("NORAD ID", d.NORADID)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Time (UTC)", time.Unix(d.Timestamp, 0).UTC().Format("2006-01-02 15:04:05"))))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Latitude Delta (degrees)", FormatQuantity(QuantityCoordinate, d.LatitudeDelta))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Longitude Delta (degrees)", FormatQuantity(QuantityCoordinate, d.LongitudeDelta))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Altitude Delta (km)", FormatQuantity(QuantityAltitude, d.AltitudeDelta))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Azimuth Delta (degrees)", FormatQuantity(QuantityAngle, d.AzimuthDelta))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Elevation Delta (degrees)", FormatQuantity(QuantityAngle, d.ElevationDelta))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Ground Distance (km)", fmt.Sprintf("%.2f", d.GroundDistanceKm))))
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+
+	if d.LikelyStaleTLE() {
+		fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] Positions differ by more than %.0f km, the TLE may be stale", staleTLEThresholdKm)))
+	} else {
+		fmt.Println(Colorize(RoleSuccess, "  [+] N2YO and SGP4 positions agree"))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,166 @@
+package osint
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrapDegrees(t *testing.T) {
+	tests := []struct {
+		in, want float64
+	}{
+		{0, 0},
+		{10, 10},
+		{-10, -10},
+		{359, -1},
+		{-359, 1},
+		{190, -170},
+	}
+	for _, tt := range tests {
+		if got := wrapDegrees(tt.in); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("wrapDegrees(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestGreatCircleDistanceKm(t *testing.T) {
+	// One degree of latitude is about 111.19 km on a 6371 km sphere
+	if d := greatCircleDistanceKm(0, 0, 1, 0); math.Abs(d-111.19) > 0.01 {
+		t.Errorf("Expected ~111.19 km, got %.4f", d)
+	}
+	if d := greatCircleDistanceKm(10, 179.5, 10, -179.5); d > 120 {
+		t.Errorf("Expected short distance across the antimeridian, got %.2f km", d)
+	}
+}
+
+// withPositionServer serves pos as the N2YO position of the ISS and fails the test on any TLE
+// request, since the diagnostic must use the cached element set.
+func withPositionServer(t *testing.T, pos Position) {
+	t.Helper()
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/positions/25544/") {
+			t.Errorf("unexpected N2YO request %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(Response{
+			SatelliteInfo: SatelliteInfo{Satname: "SPACE STATION", Satid: 25544},
+			Positions:     []Position{pos},
+		})
+	})
+}
+
+func TestDiagnosePositionDiscrepancy(t *testing.T) {
+	t.Setenv(cacheDirEnv, t.TempDir())
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+	observer := ObserverPosition{Latitude: 40.0, Longitude: -74.0, Altitude: 0}
+	at := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+
+	predicted, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, at, observer)
+	if err != nil {
+		t.Fatalf("SGP4 propagation failed: %v", err)
+	}
+
+	// N2YO reports a position offset from the SGP4 prediction by known amounts
+	withPositionServer(t, Position{
+		Satlatitude:  predicted.Position.Latitude + 0.5,
+		Satlongitude: predicted.Position.Longitude - 1.0,
+		Sataltitude:  predicted.Position.Altitude + 3.0,
+		Azimuth:      predicted.LookAngles.Azimuth + 2.0,
+		Elevation:    predicted.LookAngles.Elevation - 1.5,
+		Timestamp:    at.Unix(),
+	})
+
+	d, err := DiagnosePositionDiscrepancy("25544", observer)
+	if err != nil {
+		t.Fatalf("DiagnosePositionDiscrepancy() failed: %v", err)
+	}
+
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"LatitudeDelta", d.LatitudeDelta, 0.5},
+		{"LongitudeDelta", d.LongitudeDelta, -1.0},
+		{"AltitudeDelta", d.AltitudeDelta, 3.0},
+		{"AzimuthDelta", d.AzimuthDelta, 2.0},
+		{"ElevationDelta", d.ElevationDelta, -1.5},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-6 {
+			t.Errorf("%s = %.6f, want %.6f", c.name, c.got, c.want)
+		}
+	}
+
+	if d.GroundDistanceKm < 50 || d.GroundDistanceKm > 150 {
+		t.Errorf("GroundDistanceKm = %.2f, expected roughly 60-120 km for a 0.5/1.0 degree offset", d.GroundDistanceKm)
+	}
+	if !d.LikelyStaleTLE() {
+		t.Error("Expected a discrepancy this large to be flagged as a likely stale TLE")
+	}
+	if d.Timestamp != at.Unix() {
+		t.Errorf("Timestamp = %d, want %d", d.Timestamp, at.Unix())
+	}
+}
+
+func TestDiagnosePositionDiscrepancy_StaleCachedTLE(t *testing.T) {
+	t.Setenv(cacheDirEnv, t.TempDir())
+	observer := ObserverPosition{Latitude: 40.0, Longitude: -74.0}
+	at := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+
+	// The satellite is really where a newer element set puts it: 20 degrees further along its orbit
+	newerLine2 := RecomputeTLEChecksum(strings.Replace(testTLELine2, "325.9359", "345.9359", 1))
+	actual, err := CalculateSGP4PositionWithObserver(testTLELine1, newerLine2, at, observer)
+	if err != nil {
+		t.Fatalf("SGP4 propagation failed: %v", err)
+	}
+	withPositionServer(t, Position{
+		Satlatitude:  actual.Position.Latitude,
+		Satlongitude: actual.Position.Longitude,
+		Sataltitude:  actual.Position.Altitude,
+		Timestamp:    at.Unix(),
+	})
+
+	storeCachedTLE("25544", testTLELine1, newerLine2)
+	if d, err := DiagnosePositionDiscrepancy("25544", observer); err != nil || d.LikelyStaleTLE() {
+		t.Errorf("An up to date cached TLE should agree with N2YO: %+v, %v", d, err)
+	}
+
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+	d, err := DiagnosePositionDiscrepancy("25544", observer)
+	if err != nil {
+		t.Fatalf("DiagnosePositionDiscrepancy() failed: %v", err)
+	}
+	if !d.LikelyStaleTLE() {
+		t.Errorf("GroundDistanceKm = %.2f, want the outdated cached TLE reported as stale", d.GroundDistanceKm)
+	}
+}
+
+func TestDiagnosePositionDiscrepancy_NoPositions(t *testing.T) {
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Response{})
+	})
+
+	if _, err := DiagnosePositionDiscrepancy("25544", ObserverPosition{}); err == nil {
+		t.Error("Expected error when N2YO returns no positions")
+	}
+}
+
+func TestN2YOTLEResponse_Lines(t *testing.T) {
+	resp := N2YOTLEResponse{TLE: testTLELine1 + "\r\n" + testTLELine2}
+	l1, l2, err := resp.Lines()
+	if err != nil {
+		t.Fatalf("Lines() failed: %v", err)
+	}
+	if l1 != testTLELine1 || l2 != testTLELine2 {
+		t.Errorf("Lines() = %q, %q", l1, l2)
+	}
+
+	if _, _, err := (N2YOTLEResponse{}).Lines(); err == nil {
+		t.Error("Expected error for empty TLE")
+	}
+}
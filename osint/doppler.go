@@ -0,0 +1,190 @@
+package osint
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// speedOfLightKmS is the speed of light in km/s.
+const speedOfLightKmS = 299792.458
+
+// CorrectedFrequency returns the frequency heard on the ground for a transmitter at nominalHz
+// moving with rangeRate km/s along the line of sight. A negative range rate (approaching)
+// shifts the frequency up, a positive one (receding) shifts it down.
+func CorrectedFrequency(nominalHz, rangeRate float64) float64 {
+	return nominalHz * speedOfLightKmS / (speedOfLightKmS + rangeRate)
+}
+
+// DopplerShift returns how far the frequency heard on the ground moves from frequencyMHz, in MHz,
+// for a transmitter moving with rangeRate km/s along the line of sight. The shift is positive
+// while the satellite approaches (negative range rate) and negative while it recedes.
+func DopplerShift(rangeRate, frequencyMHz float64) float64 {
+	return CorrectedFrequency(frequencyMHz, rangeRate) - frequencyMHz
+}
+
+// UplinkFrequency returns the frequency to transmit, in MHz, so that a satellite moving with
+// rangeRate km/s receives frequencyMHz. It is shifted opposite to the downlink.
+func UplinkFrequency(rangeRate, frequencyMHz float64) float64 {
+	return frequencyMHz * (speedOfLightKmS + rangeRate) / speedOfLightKmS
+}
+
+// frequencyPattern matches a frequency with its unit, such as "437.800 MHz" or "2.4GHz".
+var frequencyPattern = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(ghz|mhz|khz|hz)\b`)
+
+// ParseFrequencyHz finds the first frequency mentioned in text (typically favorite notes) and
+// returns it in Hz.
+func ParseFrequencyHz(text string) (float64, bool) {
+	match := frequencyPattern.FindStringSubmatch(text)
+	if match == nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil || value <= 0 {
+		return 0, false
+	}
+
+	switch strings.ToLower(match[2]) {
+	case "ghz":
+		value *= 1e9
+	case "mhz":
+		value *= 1e6
+	case "khz":
+		value *= 1e3
+	}
+	return value, true
+}
+
+// DopplerSample is the corrected frequency at one moment of a pass.
+type DopplerSample struct {
+	Time        time.Time
+	Elevation   float64 // degrees
+	RangeRate   float64 // km/s, positive when receding
+	FrequencyHz float64
+}
+
+// DopplerProfile propagates a TLE across [start, end] and returns the corrected frequency of a
+// nominalHz transmitter for the observer at each step.
+func DopplerProfile(line1, line2 string, observer ObserverPosition, nominalHz float64, start, end time.Time, step time.Duration) ([]DopplerSample, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be positive")
+	}
+	if start.After(end) {
+		return nil, fmt.Errorf("start time must be before end time")
+	}
+
+	var samples []DopplerSample
+	for t := start; !t.After(end); t = t.Add(step) {
+		result, err := CalculateSGP4PositionWithObserver(line1, line2, t, observer)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, DopplerSample{
+			Time:        t,
+			Elevation:   result.LookAngles.Elevation,
+			RangeRate:   result.LookAngles.RangeRate,
+			FrequencyHz: CorrectedFrequency(nominalHz, result.LookAngles.RangeRate),
+		})
+	}
+	return samples, nil
+}
+
+// dopplerLines renders a Doppler profile as table rows.
+func dopplerLines(nominalHz float64, samples []DopplerSample) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Nominal Frequency (MHz)", fmt.Sprintf("%.6f", nominalHz/1e6))),
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+	}
+	for _, sample := range samples {
+		value := fmt.Sprintf("%.6f MHz (%+.0f Hz, el %s)",
+			sample.FrequencyHz/1e6, sample.FrequencyHz-nominalHz, FormatQuantity(QuantityAngle, sample.Elevation))
+		lines = append(lines, Colorize(RoleHeader, GenRowString(sample.Time.UTC().Format("15:04:05 UTC"), value)))
+	}
+	lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+	return lines
+}
+
+// favoriteFrequencyHz returns the nominal frequency recorded in a favorite's notes.
+func favoriteFrequencyHz(norad string) (float64, bool) {
+	favorites, err := LoadFavorites()
+	if err != nil {
+		return 0, false
+	}
+	for _, fav := range favorites {
+		if fav.NORADID == norad {
+			return ParseFrequencyHz(fav.Notes)
+		}
+	}
+	return 0, false
+}
+
+// offerDopplerProfile shows the Doppler-corrected frequency across a radio pass when the
+// satellite is a favorite whose notes mention a frequency.
+func offerDopplerProfile(norad string, observer ObserverPosition, passes []RadioPass) {
+	nominalHz, ok := favoriteFrequencyHz(norad)
+	if !ok || len(passes) == 0 || NonInteractive {
+		return
+	}
+
+	var items []string
+	for i, pass := range passes {
+		items = append(items, fmt.Sprintf("Pass %d: %s, max elevation %s°", i+1,
+			time.Unix(pass.StartUTC, 0).UTC().Format("2006-01-02 15:04 UTC"), FormatQuantity(QuantityAngle, pass.MaxEl)))
+	}
+	items = append(items, "Skip")
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Show Doppler-corrected %.3f MHz for a pass?", nominalHz/1e6),
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx >= len(passes) {
+		return
+	}
+
+	line1, line2, err := newSnapshotTLESource()(context.Background(), norad)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+
+	pass := passes[idx]
+	samples, err := DopplerProfile(line1, line2, observer, nominalHz,
+		time.Unix(pass.StartUTC, 0).UTC(), time.Unix(pass.EndUTC, 0).UTC(), 30*time.Second)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	printPaged(dopplerLines(nominalHz, samples))
+}
+
+// offerSGP4Doppler shows the current look angles with the Doppler-corrected downlink when the
+// satellite is a favorite whose notes mention a frequency.
+func offerSGP4Doppler(norad string, observer ObserverPosition) {
+	nominalHz, ok := favoriteFrequencyHz(norad)
+	if !ok || NonInteractive {
+		return
+	}
+	if !confirm(fmt.Sprintf("Show Doppler-corrected %.3f MHz for the current position?", nominalHz/1e6), false) {
+		return
+	}
+
+	line1, line2, err := cachedTLE(norad)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	result, err := CalculateSGP4PositionWithObserver(line1, line2, time.Now().UTC(), observer)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	PrintSGP4PositionWithDoppler(result, nominalHz/1e6, 0)
+}
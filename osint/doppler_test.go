@@ -0,0 +1,121 @@
+package osint
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCorrectedFrequency(t *testing.T) {
+	const nominal = 437.8e6
+
+	if got := CorrectedFrequency(nominal, 0); got != nominal {
+		t.Errorf("CorrectedFrequency() with zero range rate = %v, want %v", got, nominal)
+	}
+
+	approaching := CorrectedFrequency(nominal, -7)
+	if approaching <= nominal {
+		t.Errorf("Approaching satellite should shift up, got %v", approaching)
+	}
+	receding := CorrectedFrequency(nominal, 7)
+	if receding >= nominal {
+		t.Errorf("Receding satellite should shift down, got %v", receding)
+	}
+
+	// 7 km/s at 437.8 MHz is roughly a 10.2 kHz shift.
+	if shift := approaching - nominal; math.Abs(shift-10222) > 10 {
+		t.Errorf("Shift = %.0f Hz, want about 10222 Hz", shift)
+	}
+}
+
+func TestParseFrequencyHz(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+		ok    bool
+	}{
+		{"Downlink 437.800 MHz FM", 437.8e6, true},
+		{"beacon 145.825mhz", 145.825e6, true},
+		{"S-band 2.4 GHz", 2.4e9, true},
+		{"HF 14100 kHz", 14.1e6, true},
+		{"no frequency here", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseFrequencyHz(tt.input)
+		if ok != tt.ok || math.Abs(got-tt.want) > 1e-3 {
+			t.Errorf("ParseFrequencyHz(%q) = %v, %v; want %v, %v", tt.input, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDopplerProfile_RangeRateMatchesRangeChange(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060, Altitude: 0.01}
+	start := time.Date(2004, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	samples, err := DopplerProfile(testTLELine1, testTLELine2, observer, 437.8e6, start, start.Add(10*time.Minute), time.Minute)
+	if err != nil {
+		t.Fatalf("DopplerProfile() failed: %v", err)
+	}
+	if len(samples) != 11 {
+		t.Fatalf("Expected 11 samples, got %d", len(samples))
+	}
+
+	for _, sample := range samples {
+		if math.Abs(sample.RangeRate) > 12 {
+			t.Errorf("Range rate %v km/s is implausible for LEO", sample.RangeRate)
+		}
+
+		before, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, sample.Time.Add(-time.Second), observer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		after, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, sample.Time.Add(time.Second), observer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(sample.RangeRate) > 0.5 && (after.LookAngles.Range > before.LookAngles.Range) != (sample.RangeRate > 0) {
+			t.Errorf("Range rate %v at %v disagrees with change in range", sample.RangeRate, sample.Time)
+		}
+	}
+
+	if _, err := DopplerProfile(testTLELine1, testTLELine2, observer, 437.8e6, start, start, 0); err == nil {
+		t.Error("Expected error for zero step")
+	}
+}
+
+func TestDopplerShift(t *testing.T) {
+	const downlink = 437.8 // MHz
+
+	if shift := DopplerShift(-7, downlink); shift <= 0 || math.Abs(shift*1e6-10222) > 10 {
+		t.Errorf("DopplerShift() approaching = %.6f MHz, want about +0.010222", shift)
+	}
+	if shift := DopplerShift(7, downlink); shift >= 0 {
+		t.Errorf("DopplerShift() receding = %.6f MHz, want a negative shift", shift)
+	}
+	if shift := DopplerShift(0, downlink); shift != 0 {
+		t.Errorf("DopplerShift() with zero range rate = %v, want 0", shift)
+	}
+
+	// The uplink is pre-shifted the other way, so the satellite hears the nominal frequency
+	if transmit := UplinkFrequency(-7, 145.9); transmit >= 145.9 {
+		t.Errorf("UplinkFrequency() approaching = %.6f MHz, want below the nominal frequency", transmit)
+	}
+	if heard := CorrectedFrequency(UplinkFrequency(-7, 145.9), -7); math.Abs(heard-145.9) > 1e-9 {
+		t.Errorf("satellite hears %.9f MHz, want 145.9", heard)
+	}
+}
+
+func TestDopplerTableLines(t *testing.T) {
+	lines := dopplerTableLines(-7, 437.8, 0)
+	if len(lines) != 3 {
+		t.Fatalf("dopplerTableLines() with only a downlink = %d rows, want 3", len(lines))
+	}
+	if !strings.Contains(lines[2], "+10.2") {
+		t.Errorf("shift row = %q, want a shift of about +10.2 kHz", lines[2])
+	}
+	if len(dopplerTableLines(-7, 437.8, 145.9)) != 6 {
+		t.Error("dopplerTableLines() with both frequencies should add uplink rows")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/TwiN/go-color"
 )
 
 // ErrorCode represents a unique error code for troubleshooting.
@@ -36,6 +35,7 @@ const (
 	ErrCodeTLEParseFailed      ErrorCode = "TLE-1302"
 	ErrCodeTLEInsufficientData ErrorCode = "TLE-1303"
 	ErrCodeTLEChecksumFailed   ErrorCode = "TLE-1304"
+	ErrCodeTLEDecayed          ErrorCode = "TLE-1305"
 
 	// File errors (1400-1499)
 	ErrCodeFileNotFound        ErrorCode = "FILE-1401"
@@ -54,6 +54,60 @@ const (
 	ErrCodeNetworkDNS          ErrorCode = "NET-1603"
 )
 
+// AllErrorCodes lists every ErrorCode in the order of its numeric range.
+var AllErrorCodes = []ErrorCode{
+	ErrCodeAuthFailed, ErrCodeAuthCredentials, ErrCodeAuthConnection, ErrCodeAuthCookieJar,
+	ErrCodeAPIRequestFailed, ErrCodeAPIResponseFailed, ErrCodeAPIParseFailed, ErrCodeAPINoData, ErrCodeAPIInvalidEndpoint,
+	ErrCodeInputEmpty, ErrCodeInputInvalid, ErrCodeInputOutOfRange, ErrCodeInputFormat,
+	ErrCodeTLEInvalidFormat, ErrCodeTLEParseFailed, ErrCodeTLEInsufficientData, ErrCodeTLEChecksumFailed, ErrCodeTLEDecayed,
+	ErrCodeFileNotFound, ErrCodeFileReadFailed, ErrCodeFilePathInvalid, ErrCodeFilePermission,
+	ErrCodeSatNotFound, ErrCodeSatInvalidNORAD, ErrCodeSatNoResults,
+	ErrCodeNetworkTimeout, ErrCodeNetworkUnreachable, ErrCodeNetworkDNS,
+}
+
+// errorCategories names each ErrorCode family by its prefix.
+var errorCategories = map[string]string{
+	"AUTH":  "Authentication",
+	"API":   "API",
+	"INPUT": "Input validation",
+	"TLE":   "TLE",
+	"FILE":  "File",
+	"SAT":   "Satellite selection",
+	"NET":   "Network",
+}
+
+// errorDescriptions explains what went wrong for each ErrorCode.
+var errorDescriptions = map[ErrorCode]string{
+	ErrCodeAuthFailed:          "Space-Track rejected the login",
+	ErrCodeAuthCredentials:     "Space-Track credentials or the N2YO API key are missing or invalid",
+	ErrCodeAuthConnection:      "Could not connect to Space-Track to log in",
+	ErrCodeAuthCookieJar:       "Could not create the session cookie jar",
+	ErrCodeAPIRequestFailed:    "An API request could not be sent or completed",
+	ErrCodeAPIResponseFailed:   "An API answered with an error status",
+	ErrCodeAPIParseFailed:      "An API response could not be parsed",
+	ErrCodeAPINoData:           "An API query returned no data",
+	ErrCodeAPIInvalidEndpoint:  "The API endpoint is not valid",
+	ErrCodeInputEmpty:          "A required value was left empty",
+	ErrCodeInputInvalid:        "A value is not a valid number",
+	ErrCodeInputOutOfRange:     "A value is outside its allowed range",
+	ErrCodeInputFormat:         "A value does not match the expected format",
+	ErrCodeTLEInvalidFormat:    "TLE lines are not in the two-line element format",
+	ErrCodeTLEParseFailed:      "A field of the TLE could not be parsed",
+	ErrCodeTLEInsufficientData: "The TLE is missing a line or fields",
+	ErrCodeTLEChecksumFailed:   "A TLE line does not match its checksum",
+	ErrCodeTLEDecayed:          "The orbit decayed before the requested time",
+	ErrCodeFileNotFound:        "The file does not exist",
+	ErrCodeFileReadFailed:      "The file could not be read or has an invalid format",
+	ErrCodeFilePathInvalid:     "The file path is not allowed",
+	ErrCodeFilePermission:      "The file cannot be accessed with the current permissions",
+	ErrCodeSatNotFound:         "No satellite has the given NORAD ID",
+	ErrCodeSatInvalidNORAD:     "The NORAD ID is not a number",
+	ErrCodeSatNoResults:        "The satellite search matched nothing",
+	ErrCodeNetworkTimeout:      "A request timed out",
+	ErrCodeNetworkUnreachable:  "The service could not be reached",
+	ErrCodeNetworkDNS:          "The service host name could not be resolved",
+}
+
 // AppError represents a structured application error with code, message, and suggestions.
 type AppError struct {
 	Code       ErrorCode
@@ -73,21 +127,21 @@ func (e *AppError) Error() string {
 
 // Display formats and displays the error with suggestions.
 func (e *AppError) Display() {
-	fmt.Println(color.Ize(color.Red, fmt.Sprintf("  [!] ERROR [%s]: %s", e.Code, e.Message)))
+	fmt.Println(Colorize(RoleError, fmt.Sprintf("  [!] ERROR [%s]: %s", e.Code, e.Message)))
 	
 	if e.Context != "" {
-		fmt.Println(color.Ize(color.Yellow, fmt.Sprintf("       Context: %s", e.Context)))
+		fmt.Println(Colorize(RoleWarning, fmt.Sprintf("       Context: %s", e.Context)))
 	}
 	
 	if len(e.Suggestions) > 0 {
-		fmt.Println(color.Ize(color.Cyan, "       Suggestions:"))
+		fmt.Println(Colorize(RoleInfo, "       Suggestions:"))
 		for i, suggestion := range e.Suggestions {
-			fmt.Println(color.Ize(color.Cyan, fmt.Sprintf("         %d. %s", i+1, suggestion)))
+			fmt.Println(Colorize(RoleInfo, fmt.Sprintf("         %d. %s", i+1, suggestion)))
 		}
 	}
 	
 	if e.OriginalErr != nil {
-		fmt.Println(color.Ize(color.Gray, fmt.Sprintf("       Technical details: %v", e.OriginalErr)))
+		fmt.Println(Colorize(RoleMuted, fmt.Sprintf("       Technical details: %v", e.OriginalErr)))
 	}
 }
 
@@ -216,6 +270,11 @@ func getDefaultSuggestions(code ErrorCode) []string {
 			"Verify the TLE data is not corrupted",
 			"Try fetching fresh TLE data from Space-Track",
 		},
+		ErrCodeTLEDecayed: {
+			"The object may have reentered the atmosphere",
+			"Check the satellite's decay date in the catalog",
+			"Use a more recent TLE or a time closer to the TLE epoch",
+		},
 
 		// File errors
 		ErrCodeFileNotFound: {
@@ -281,6 +340,37 @@ func getDefaultSuggestions(code ErrorCode) []string {
 	return []string{"Please check the error details and try again"}
 }
 
+// DescribeErrorCode returns the category, description and default suggestions for code, so
+// users can look up a code they were shown.
+func DescribeErrorCode(code ErrorCode) (category, description string, suggestions []string) {
+	family, _, _ := strings.Cut(string(code), "-")
+	category, ok := errorCategories[family]
+	if !ok {
+		category = "Unknown"
+	}
+	description, ok = errorDescriptions[code]
+	if !ok {
+		description = "Unknown error code"
+	}
+	return category, description, getDefaultSuggestions(code)
+}
+
+// PrintErrorCodes lists every error code with its description and suggestions.
+func PrintErrorCodes() {
+	lastCategory := ""
+	for _, code := range AllErrorCodes {
+		category, description, suggestions := DescribeErrorCode(code)
+		if category != lastCategory {
+			fmt.Println(Colorize(RoleHeader, "\n"+category+" errors"))
+			lastCategory = category
+		}
+		fmt.Println(Colorize(RoleInfo, fmt.Sprintf("  [%s] %s", code, description)))
+		for _, suggestion := range suggestions {
+			fmt.Println(Colorize(RoleMuted, "       - "+suggestion))
+		}
+	}
+}
+
 // HandleError displays an error if it's an AppError, otherwise creates a generic error.
 func HandleError(err error, defaultCode ErrorCode, defaultMessage string) {
 	if err == nil {
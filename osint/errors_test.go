@@ -0,0 +1,34 @@
+package osint
+
+import "testing"
+
+func TestDescribeErrorCode_AllCodes(t *testing.T) {
+	seen := make(map[ErrorCode]bool)
+	for _, code := range AllErrorCodes {
+		if seen[code] {
+			t.Errorf("%s is listed twice", code)
+		}
+		seen[code] = true
+
+		category, description, suggestions := DescribeErrorCode(code)
+		if category == "Unknown" {
+			t.Errorf("%s has no category", code)
+		}
+		if description == "" || description == "Unknown error code" {
+			t.Errorf("%s has no description", code)
+		}
+		if len(suggestions) == 0 || suggestions[0] == "Please check the error details and try again" {
+			t.Errorf("%s has no suggestions of its own", code)
+		}
+	}
+	if len(errorDescriptions) != len(AllErrorCodes) {
+		t.Errorf("%d descriptions for %d listed codes", len(errorDescriptions), len(AllErrorCodes))
+	}
+}
+
+func TestDescribeErrorCode_Unknown(t *testing.T) {
+	category, description, suggestions := DescribeErrorCode("XYZ-9999")
+	if category != "Unknown" || description != "Unknown error code" || len(suggestions) == 0 {
+		t.Errorf("DescribeErrorCode(XYZ-9999) = %q, %q, %v", category, description, suggestions)
+	}
+}
@@ -0,0 +1,59 @@
+package osint
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// Process exit codes. Scripts can rely on these staying stable across releases.
+const (
+	ExitSuccess      = 0 // the command completed
+	ExitFailure      = 1 // any failure without a more specific code
+	ExitAuth         = 2 // credentials are missing or were rejected
+	ExitNetwork      = 3 // a service could not be reached or answered with an error
+	ExitNoData       = 4 // the query succeeded but matched nothing
+	ExitInvalidInput = 5 // arguments, files or element sets were invalid
+)
+
+// ExitCodeFor maps an error to the exit code for its ErrorCode family. Errors that are not
+// AppErrors exit with ExitFailure unless they are network failures.
+func ExitCodeFor(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	if isNetworkFailure(err) {
+		return ExitNetwork
+	}
+
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		return ExitFailure
+	}
+	switch appErr.Code {
+	case ErrCodeAPINoData, ErrCodeSatNotFound, ErrCodeSatNoResults:
+		return ExitNoData
+	case ErrCodeSatInvalidNORAD, ErrCodeFileNotFound, ErrCodeFilePathInvalid:
+		return ExitInvalidInput
+	}
+
+	family, _, _ := strings.Cut(string(appErr.Code), "-")
+	switch family {
+	case "AUTH":
+		return ExitAuth
+	case "API", "NET":
+		return ExitNetwork
+	case "INPUT", "TLE":
+		return ExitInvalidInput
+	}
+	return ExitFailure
+}
+
+// exitProcess is how Exit ends the process: os.Exit, without running deferred calls.
+var exitProcess = os.Exit
+
+// Exit ends the process with code. Every fatal path goes through it so exit codes follow the
+// contract above.
+func Exit(code int) {
+	exitProcess(code)
+}
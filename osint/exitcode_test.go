@@ -0,0 +1,55 @@
+package osint
+
+import (
+	"errors"
+	"io/fs"
+	"net"
+	"testing"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	dnsErr := &net.DNSError{Err: "no such host", Name: "www.space-track.org", IsNotFound: true}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitSuccess},
+		{"plain error", errors.New("boom"), ExitFailure},
+		{"auth failed", NewAppError(ErrCodeAuthFailed, "Authentication failed"), ExitAuth},
+		{"auth credentials", NewAppError(ErrCodeAuthCredentials, "Missing credentials"), ExitAuth},
+		{"auth connection", NewAppError(ErrCodeAuthConnection, "Could not connect"), ExitNetwork},
+		{"network timeout", NewAppError(ErrCodeNetworkTimeout, "Timed out"), ExitNetwork},
+		{"api request", NewAppError(ErrCodeAPIRequestFailed, "Request failed"), ExitNetwork},
+		{"wrapped net error", NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed", dnsErr), ExitNetwork},
+		{"api no data", NewAppError(ErrCodeAPINoData, "No data"), ExitNoData},
+		{"satellite not found", NewAppError(ErrCodeSatNotFound, "Not found"), ExitNoData},
+		{"no results", NewAppError(ErrCodeSatNoResults, "No results"), ExitNoData},
+		{"input empty", NewAppError(ErrCodeInputEmpty, "Empty"), ExitInvalidInput},
+		{"invalid NORAD", NewAppError(ErrCodeSatInvalidNORAD, "Bad NORAD ID"), ExitInvalidInput},
+		{"tle checksum", NewAppError(ErrCodeTLEChecksumFailed, "Bad checksum"), ExitInvalidInput},
+		{"file not found", NewAppError(ErrCodeFileNotFound, "Missing"), ExitInvalidInput},
+		{"file permission", NewAppError(ErrCodeFilePermission, "Denied"), ExitFailure},
+		{"wrapped file error", NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed", &fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}), ExitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCodeFor(tt.err); got != tt.want {
+				t.Errorf("ExitCodeFor(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExit(t *testing.T) {
+	var got []int
+	original := exitProcess
+	exitProcess = func(code int) { got = append(got, code) }
+	t.Cleanup(func() { exitProcess = original })
+
+	Exit(ExitNoData)
+	if len(got) != 1 || got[0] != ExitNoData {
+		t.Errorf("Exit(%d) exited with %v", ExitNoData, got)
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -17,26 +15,49 @@ import (
 type ExportFormat string
 
 const (
-	FormatCSV  ExportFormat = "CSV"
-	FormatJSON ExportFormat = "JSON"
-	FormatText ExportFormat = "Text"
+	FormatCSV     ExportFormat = "CSV"
+	FormatJSON    ExportFormat = "JSON"
+	FormatText    ExportFormat = "Text"
+	FormatGeoJSON ExportFormat = "GeoJSON"
+	FormatTLE     ExportFormat = "TLE"
+	// FormatBinary is the compact binary format for position time series (see ExportPositionsBinary).
+	FormatBinary ExportFormat = "Binary"
 )
 
+// exportExtensions maps each export format to its file extension.
+var exportExtensions = map[ExportFormat]string{
+	FormatCSV:     ".csv",
+	FormatJSON:    ".json",
+	FormatText:    ".txt",
+	FormatGeoJSON: ".geojson",
+	FormatTLE:     ".tle",
+	FormatBinary:  ".spos",
+}
+
 // showExportMenu displays a menu for selecting export format and file path.
 func showExportMenu(defaultFilename string) (ExportFormat, string, error) {
-	formatItems := []string{"CSV", "JSON", "Text", "Cancel"}
+	return showExportMenuWithFormats(defaultFilename, FormatCSV, FormatJSON, FormatText)
+}
+
+// showExportMenuWithFormats is showExportMenu limited to the given formats.
+func showExportMenuWithFormats(defaultFilename string, formats ...ExportFormat) (ExportFormat, string, error) {
+	var formatItems []string
+	for _, format := range formats {
+		formatItems = append(formatItems, string(format))
+	}
+	formatItems = append(formatItems, "Cancel")
 	
 	formatPrompt := promptui.Select{
 		Label: "Select Export Format",
 		Items: formatItems,
 	}
 
-	formatIdx, formatChoice, err := formatPrompt.Run()
-	if err != nil || formatIdx == 3 {
+	formatIdx, _, err := formatPrompt.Run()
+	if err != nil || formatIdx == len(formats) {
 		return "", "", fmt.Errorf("export cancelled")
 	}
 
-	format := ExportFormat(formatChoice)
+	format := formats[formatIdx]
 
 	pathPrompt := promptui.Prompt{
 		Label:    "Enter file path (or press Enter for default)",
@@ -55,20 +76,7 @@ func showExportMenu(defaultFilename string) (ExportFormat, string, error) {
 	}
 
 	// Add appropriate extension if not present
-	ext := filepath.Ext(filePath)
-	expectedExt := ""
-	switch format {
-	case FormatCSV:
-		expectedExt = ".csv"
-	case FormatJSON:
-		expectedExt = ".json"
-	case FormatText:
-		expectedExt = ".txt"
-	}
-
-	if ext != expectedExt {
-		filePath += expectedExt
-	}
+	filePath = compressedPath(withExportExt(filePath, exportExtensions[format]))
 
 	return format, filePath, nil
 }
@@ -89,14 +97,13 @@ func ExportTLE(tle TLE, format ExportFormat, filePath string) error {
 
 // exportTLECSV exports TLE data to CSV format.
 func exportTLECSV(tle TLE, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write header
 	headers := []string{
@@ -112,7 +119,7 @@ func exportTLECSV(tle TLE, filePath string) error {
 		{"Satellite Catalog Number", strconv.Itoa(tle.SatelliteCatalogNumber)},
 		{"Elset Classification", tle.ElsetClassificiation},
 		{"International Designator", tle.InternationalDesignator},
-		{"Element Set Epoch (UTC)", fmt.Sprintf("%f", tle.ElementSetEpoch)},
+		{"Element Set Epoch (UTC)", formatEpoch(tle)},
 		{"1st Derivative of Mean Motion", fmt.Sprintf("%f", tle.FirstDerivativeMeanMotion)},
 		{"2nd Derivative of Mean Motion", tle.SecondDerivativeMeanMotion},
 		{"B* Drag Term", tle.BDragTerm},
@@ -135,7 +142,7 @@ func exportTLECSV(tle TLE, filePath string) error {
 		}
 	}
 
-	return nil
+	return closeCSVExport(file, writer)
 }
 
 // exportTLEJSON exports TLE data to JSON format.
@@ -145,7 +152,8 @@ func exportTLEJSON(tle TLE, filePath string) error {
 		"satellite_catalog_number":         tle.SatelliteCatalogNumber,
 		"elset_classification":             tle.ElsetClassificiation,
 		"international_designator":          tle.InternationalDesignator,
-		"element_set_epoch_utc":            tle.ElementSetEpoch,
+		"element_set_epoch":                tle.ElementSetEpoch,
+		"element_set_epoch_utc":            tle.EpochTime().Format(time.RFC3339Nano),
 		"first_derivative_mean_motion":      tle.FirstDerivativeMeanMotion,
 		"second_derivative_mean_motion":     tle.SecondDerivativeMeanMotion,
 		"b_drag_term":                       tle.BDragTerm,
@@ -168,7 +176,7 @@ func exportTLEJSON(tle TLE, filePath string) error {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write JSON file: %w", err)
 	}
 
@@ -186,7 +194,7 @@ func exportTLEText(tle TLE, filePath string) error {
 	builder.WriteString(fmt.Sprintf("Satellite Catalog Number: %d\n", tle.SatelliteCatalogNumber))
 	builder.WriteString(fmt.Sprintf("Elset Classification: %s\n", tle.ElsetClassificiation))
 	builder.WriteString(fmt.Sprintf("International Designator: %s\n", tle.InternationalDesignator))
-	builder.WriteString(fmt.Sprintf("Element Set Epoch (UTC): %f\n", tle.ElementSetEpoch))
+	builder.WriteString(fmt.Sprintf("Element Set Epoch (UTC): %s\n", formatEpoch(tle)))
 	builder.WriteString(fmt.Sprintf("1st Derivative of Mean Motion: %f\n", tle.FirstDerivativeMeanMotion))
 	builder.WriteString(fmt.Sprintf("2nd Derivative of Mean Motion: %s\n", tle.SecondDerivativeMeanMotion))
 	builder.WriteString(fmt.Sprintf("B* Drag Term: %s\n", tle.BDragTerm))
@@ -203,13 +211,24 @@ func exportTLEText(tle TLE, filePath string) error {
 	builder.WriteString(fmt.Sprintf("Checksum Line Two: %d\n", tle.ChecksumTwo))
 	builder.WriteString(fmt.Sprintf("\nExported: %s\n", time.Now().Format(time.RFC3339)))
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write text file: %w", err)
 	}
 
 	return nil
 }
 
+// ExportRawTLE writes the element lines in the standard three-line format (name, line 1, line 2).
+// Checksums are recomputed so the file always validates.
+func ExportRawTLE(name, line1, line2, filePath string) error {
+	content := fmt.Sprintf("%s\n%s\n%s\n", strings.TrimSpace(name), RecomputeTLEChecksum(line1), RecomputeTLEChecksum(line2))
+	if err := writeExportFile(filePath, []byte(content)); err != nil {
+		return fmt.Errorf("failed to write TLE file: %w", err)
+	}
+
+	return nil
+}
+
 // ExportVisualPrediction exports visual pass predictions to the specified format.
 func ExportVisualPrediction(data VisualPassesResponse, format ExportFormat, filePath string) error {
 	switch format {
@@ -226,14 +245,13 @@ func ExportVisualPrediction(data VisualPassesResponse, format ExportFormat, file
 
 // exportVisualPredictionCSV exports visual pass predictions to CSV format.
 func exportVisualPredictionCSV(data VisualPassesResponse, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write satellite info
 	infoHeaders := []string{"Satellite Name", "Satellite ID", "Transactions Count", "Passes Count"}
@@ -270,17 +288,17 @@ func exportVisualPredictionCSV(data VisualPassesResponse, filePath string) error
 	for i, pass := range data.Passes {
 		row := []string{
 			strconv.Itoa(i + 1),
-			fmt.Sprintf("%f", pass.StartAz),
+			FormatQuantity(QuantityAngle, pass.StartAz),
 			pass.StartAzCompass,
-			fmt.Sprintf("%f", pass.StartEl),
+			FormatQuantity(QuantityAngle, pass.StartEl),
 			strconv.Itoa(pass.StartUTC),
-			fmt.Sprintf("%f", pass.MaxAz),
+			FormatQuantity(QuantityAngle, pass.MaxAz),
 			pass.MaxAzCompass,
-			fmt.Sprintf("%f", pass.MaxEl),
+			FormatQuantity(QuantityAngle, pass.MaxEl),
 			strconv.Itoa(pass.MaxUTC),
-			fmt.Sprintf("%f", pass.EndAz),
+			FormatQuantity(QuantityAngle, pass.EndAz),
 			pass.EndAzCompass,
-			fmt.Sprintf("%f", pass.EndEl),
+			FormatQuantity(QuantityAngle, pass.EndEl),
 			strconv.Itoa(pass.EndUTC),
 			fmt.Sprintf("%f", pass.Mag),
 			strconv.Itoa(pass.Duration),
@@ -290,7 +308,7 @@ func exportVisualPredictionCSV(data VisualPassesResponse, filePath string) error
 		}
 	}
 
-	return nil
+	return closeCSVExport(file, writer)
 }
 
 // exportVisualPredictionJSON exports visual pass predictions to JSON format.
@@ -311,7 +329,7 @@ func exportVisualPredictionJSON(data VisualPassesResponse, filePath string) erro
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write JSON file: %w", err)
 	}
 
@@ -336,19 +354,19 @@ func exportVisualPredictionText(data VisualPassesResponse, filePath string) erro
 
 	for i, pass := range data.Passes {
 		builder.WriteString(fmt.Sprintf("\nPass #%d:\n", i+1))
-		builder.WriteString(fmt.Sprintf("  Start: Azimuth %.2f° (%s), Elevation %.2f°, UTC %d\n",
-			pass.StartAz, pass.StartAzCompass, pass.StartEl, pass.StartUTC))
-		builder.WriteString(fmt.Sprintf("  Max:   Azimuth %.2f° (%s), Elevation %.2f°, UTC %d\n",
-			pass.MaxAz, pass.MaxAzCompass, pass.MaxEl, pass.MaxUTC))
-		builder.WriteString(fmt.Sprintf("  End:   Azimuth %.2f° (%s), Elevation %.2f°, UTC %d\n",
-			pass.EndAz, pass.EndAzCompass, pass.EndEl, pass.EndUTC))
+		builder.WriteString(fmt.Sprintf("  Start: Azimuth %s° (%s), Elevation %s°, UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.StartAz), pass.StartAzCompass, FormatQuantity(QuantityAngle, pass.StartEl), pass.StartUTC))
+		builder.WriteString(fmt.Sprintf("  Max:   Azimuth %s° (%s), Elevation %s°, UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.MaxAz), pass.MaxAzCompass, FormatQuantity(QuantityAngle, pass.MaxEl), pass.MaxUTC))
+		builder.WriteString(fmt.Sprintf("  End:   Azimuth %s° (%s), Elevation %s°, UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.EndAz), pass.EndAzCompass, FormatQuantity(QuantityAngle, pass.EndEl), pass.EndUTC))
 		builder.WriteString(fmt.Sprintf("  Magnitude: %.2f, Duration: %d seconds\n",
 			pass.Mag, pass.Duration))
 	}
 
 	builder.WriteString(fmt.Sprintf("\nExported: %s\n", time.Now().Format(time.RFC3339)))
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write text file: %w", err)
 	}
 
@@ -371,14 +389,13 @@ func ExportRadioPrediction(data RadioPassResponse, format ExportFormat, filePath
 
 // exportRadioPredictionCSV exports radio pass predictions to CSV format.
 func exportRadioPredictionCSV(data RadioPassResponse, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write satellite info
 	infoHeaders := []string{"Satellite Name", "Satellite ID", "Transactions Count", "Passes Count"}
@@ -414,14 +431,14 @@ func exportRadioPredictionCSV(data RadioPassResponse, filePath string) error {
 	for i, pass := range data.Passes {
 		row := []string{
 			strconv.Itoa(i + 1),
-			fmt.Sprintf("%f", pass.StartAz),
+			FormatQuantity(QuantityAngle, pass.StartAz),
 			pass.StartAzCompass,
 			strconv.FormatInt(pass.StartUTC, 10),
-			fmt.Sprintf("%f", pass.MaxAz),
+			FormatQuantity(QuantityAngle, pass.MaxAz),
 			pass.MaxAzCompass,
-			fmt.Sprintf("%f", pass.MaxEl),
+			FormatQuantity(QuantityAngle, pass.MaxEl),
 			strconv.FormatInt(pass.MaxUTC, 10),
-			fmt.Sprintf("%f", pass.EndAz),
+			FormatQuantity(QuantityAngle, pass.EndAz),
 			pass.EndAzCompass,
 			strconv.FormatInt(pass.EndUTC, 10),
 		}
@@ -430,7 +447,7 @@ func exportRadioPredictionCSV(data RadioPassResponse, filePath string) error {
 		}
 	}
 
-	return nil
+	return closeCSVExport(file, writer)
 }
 
 // exportRadioPredictionJSON exports radio pass predictions to JSON format.
@@ -451,7 +468,7 @@ func exportRadioPredictionJSON(data RadioPassResponse, filePath string) error {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write JSON file: %w", err)
 	}
 
@@ -476,17 +493,17 @@ func exportRadioPredictionText(data RadioPassResponse, filePath string) error {
 
 	for i, pass := range data.Passes {
 		builder.WriteString(fmt.Sprintf("\nPass #%d:\n", i+1))
-		builder.WriteString(fmt.Sprintf("  Start: Azimuth %.2f° (%s), UTC %d\n",
-			pass.StartAz, pass.StartAzCompass, pass.StartUTC))
-		builder.WriteString(fmt.Sprintf("  Max:   Azimuth %.2f° (%s), Elevation %.2f°, UTC %d\n",
-			pass.MaxAz, pass.MaxAzCompass, pass.MaxEl, pass.MaxUTC))
-		builder.WriteString(fmt.Sprintf("  End:   Azimuth %.2f° (%s), UTC %d\n",
-			pass.EndAz, pass.EndAzCompass, pass.EndUTC))
+		builder.WriteString(fmt.Sprintf("  Start: Azimuth %s° (%s), UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.StartAz), pass.StartAzCompass, pass.StartUTC))
+		builder.WriteString(fmt.Sprintf("  Max:   Azimuth %s° (%s), Elevation %s°, UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.MaxAz), pass.MaxAzCompass, FormatQuantity(QuantityAngle, pass.MaxEl), pass.MaxUTC))
+		builder.WriteString(fmt.Sprintf("  End:   Azimuth %s° (%s), UTC %d\n",
+			FormatQuantity(QuantityAngle, pass.EndAz), pass.EndAzCompass, pass.EndUTC))
 	}
 
 	builder.WriteString(fmt.Sprintf("\nExported: %s\n", time.Now().Format(time.RFC3339)))
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write text file: %w", err)
 	}
 
@@ -502,6 +519,8 @@ func ExportSatellitePosition(data Response, format ExportFormat, filePath string
 		return exportSatellitePositionJSON(data, filePath)
 	case FormatText:
 		return exportSatellitePositionText(data, filePath)
+	case FormatGeoJSON:
+		return exportSatellitePositionGeoJSON(data, filePath)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -509,14 +528,13 @@ func ExportSatellitePosition(data Response, format ExportFormat, filePath string
 
 // exportSatellitePositionCSV exports satellite positions to CSV format.
 func exportSatellitePositionCSV(data Response, filePath string) error {
-	file, err := os.Create(filePath)
+	file, err := createExportFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
 	defer file.Close()
 
 	writer := csv.NewWriter(file)
-	defer writer.Flush()
 
 	// Write satellite info
 	infoHeaders := []string{"Satellite Name", "Satellite ID"}
@@ -549,11 +567,11 @@ func exportSatellitePositionCSV(data Response, filePath string) error {
 	for i, pos := range data.Positions {
 		row := []string{
 			strconv.Itoa(i + 1),
-			fmt.Sprintf("%f", pos.Satlatitude),
-			fmt.Sprintf("%f", pos.Satlongitude),
-			fmt.Sprintf("%f", pos.Sataltitude),
-			fmt.Sprintf("%f", pos.Azimuth),
-			fmt.Sprintf("%f", pos.Dec),
+			FormatQuantity(QuantityCoordinate, pos.Satlatitude),
+			FormatQuantity(QuantityCoordinate, pos.Satlongitude),
+			FormatQuantity(QuantityAltitude, pos.Sataltitude),
+			FormatQuantity(QuantityAngle, pos.Azimuth),
+			FormatQuantity(QuantityAngle, pos.Dec),
 			strconv.FormatInt(pos.Timestamp, 10),
 		}
 		if err := writer.Write(row); err != nil {
@@ -561,7 +579,7 @@ func exportSatellitePositionCSV(data Response, filePath string) error {
 		}
 	}
 
-	return nil
+	return closeCSVExport(file, writer)
 }
 
 // exportSatellitePositionJSON exports satellite positions to JSON format.
@@ -580,13 +598,22 @@ func exportSatellitePositionJSON(data Response, filePath string) error {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	if err := os.WriteFile(filePath, jsonData, 0644); err != nil {
+	if err := writeExportFile(filePath, jsonData); err != nil {
 		return fmt.Errorf("failed to write JSON file: %w", err)
 	}
 
 	return nil
 }
 
+// exportSatellitePositionGeoJSON exports satellite positions and ground track to GeoJSON format.
+func exportSatellitePositionGeoJSON(data Response, filePath string) error {
+	if err := writeExportFile(filePath, []byte(generateGeoJSONContent(data))); err != nil {
+		return fmt.Errorf("failed to write GeoJSON file: %w", err)
+	}
+
+	return nil
+}
+
 // exportSatellitePositionText exports satellite positions to text format.
 func exportSatellitePositionText(data Response, filePath string) error {
 	var builder strings.Builder
@@ -603,20 +630,74 @@ func exportSatellitePositionText(data Response, filePath string) error {
 
 	for i, pos := range data.Positions {
 		builder.WriteString(fmt.Sprintf("\nPosition #%d:\n", i+1))
-		builder.WriteString(fmt.Sprintf("  Latitude:  %.6f°\n", pos.Satlatitude))
-		builder.WriteString(fmt.Sprintf("  Longitude: %.6f°\n", pos.Satlongitude))
-		builder.WriteString(fmt.Sprintf("  Altitude:  %.2f km\n", pos.Sataltitude))
-		builder.WriteString(fmt.Sprintf("  Azimuth:   %.2f°\n", pos.Azimuth))
-		builder.WriteString(fmt.Sprintf("  Declination: %.2f°\n", pos.Dec))
+		builder.WriteString(fmt.Sprintf("  Latitude:  %s°\n", FormatQuantity(QuantityCoordinate, pos.Satlatitude)))
+		builder.WriteString(fmt.Sprintf("  Longitude: %s°\n", FormatQuantity(QuantityCoordinate, pos.Satlongitude)))
+		builder.WriteString(fmt.Sprintf("  Altitude:  %s km\n", FormatQuantity(QuantityAltitude, pos.Sataltitude)))
+		builder.WriteString(fmt.Sprintf("  Azimuth:   %s°\n", FormatQuantity(QuantityAngle, pos.Azimuth)))
+		builder.WriteString(fmt.Sprintf("  Declination: %s°\n", FormatQuantity(QuantityAngle, pos.Dec)))
 		builder.WriteString(fmt.Sprintf("  Timestamp: %d\n", pos.Timestamp))
 	}
 
 	builder.WriteString(fmt.Sprintf("\nExported: %s\n", time.Now().Format(time.RFC3339)))
 
-	if err := os.WriteFile(filePath, []byte(builder.String()), 0644); err != nil {
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
 		return fmt.Errorf("failed to write text file: %w", err)
 	}
 
 	return nil
 }
 
+// ExportFavoritesAs exports the favorites list, including notes, to the specified format.
+func ExportFavoritesAs(favorites []FavoriteSatellite, format ExportFormat, filePath string) error {
+	switch format {
+	case FormatCSV:
+		return exportFavoritesCSV(favorites, filePath)
+	case FormatJSON:
+		return exportFavoritesJSON(favorites, filePath)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// exportFavoritesCSV exports the favorites list to CSV format.
+func exportFavoritesCSV(favorites []FavoriteSatellite, filePath string) error {
+	file, err := createExportFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+
+	headers := []string{"Satellite Name", "NORAD ID", "Country", "Object Type", "Added", "Notes"}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, fav := range favorites {
+		row := []string{fav.SatelliteName, fav.NORADID, fav.Country, fav.ObjectType, fav.AddedDate, fav.Notes}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	return closeCSVExport(file, writer)
+}
+
+// exportFavoritesJSON exports the favorites list to JSON format.
+func exportFavoritesJSON(favorites []FavoriteSatellite, filePath string) error {
+	exportData := map[string]interface{}{
+		"favorites":        favorites,
+		"export_timestamp": time.Now().Format(time.RFC3339),
+	}
+
+	jsonData, err := json.MarshalIndent(exportData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+
+	return nil
+}
@@ -116,6 +116,10 @@ func TestExportTLEJSON(t *testing.T) {
 		t.Errorf("JSON satellite_catalog_number = %v, want %v", result["satellite_catalog_number"], 12345)
 	}
 
+	if result["element_set_epoch_utc"] != "2024-01-01T12:00:00Z" {
+		t.Errorf("JSON element_set_epoch_utc = %v, want %v", result["element_set_epoch_utc"], "2024-01-01T12:00:00Z")
+	}
+
 	if result["export_timestamp"] == nil {
 		t.Error("JSON should include export_timestamp")
 	}
@@ -468,6 +472,22 @@ func TestExportTLE(t *testing.T) {
 	}
 }
 
+func TestExportRawTLE(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "iss.tle")
+	if err := ExportRawTLE("ISS (ZARYA)", testTLELine1[:68]+"0", testTLELine2, filePath); err != nil {
+		t.Fatalf("ExportRawTLE() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read TLE file: %v", err)
+	}
+	want := "ISS (ZARYA)\n" + testTLELine1 + "\n" + testTLELine2 + "\n"
+	if string(content) != want {
+		t.Errorf("TLE file = %q, want %q", content, want)
+	}
+}
+
 func TestExportFormatConstants(t *testing.T) {
 	if FormatCSV != "CSV" {
 		t.Errorf("FormatCSV = %q, want %q", FormatCSV, "CSV")
@@ -0,0 +1,145 @@
+package osint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// postExportEnv holds the command run after each export, such as "scp {file} host:".
+	postExportEnv = "SATINTEL_POST_EXPORT"
+	// postExportPlaceholder is replaced by the exported file's path in the command.
+	postExportPlaceholder = "{file}"
+)
+
+// runPostExportCommand runs a post-export command directly, without a shell, with its output on
+// the terminal.
+var runPostExportCommand = func(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// splitCommandTemplate splits a command line into arguments at spaces. Single or double quotes
+// group an argument containing spaces; there are no escapes or other shell syntax.
+func splitCommandTemplate(template string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	for _, r := range template {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in %s", quote, postExportEnv)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// postExportArgs builds the post-export command for filePath from template. The path replaces
+// {file} inside arguments, or is added as the last argument when there is no placeholder. Each
+// argument is passed to the program as is, never through a shell, so a file name cannot inject
+// commands.
+func postExportArgs(template, filePath string) ([]string, error) {
+	args, err := splitCommandTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+	// Keep a file name starting with a dash from being read as an option
+	if strings.HasPrefix(filePath, "-") {
+		filePath = "." + string(filepath.Separator) + filePath
+	}
+
+	placeholder := false
+	for i, arg := range args {
+		if strings.Contains(arg, postExportPlaceholder) {
+			args[i] = strings.ReplaceAll(arg, postExportPlaceholder, filePath)
+			placeholder = true
+		}
+	}
+	if !placeholder {
+		args = append(args, filePath)
+	}
+	return args, nil
+}
+
+// runPostExportHook runs the command in SATINTEL_POST_EXPORT for a file that was just exported.
+// Nothing runs when it is unset. A failing command is reported but does not fail the export.
+func runPostExportHook(filePath string) {
+	template := strings.TrimSpace(os.Getenv(postExportEnv))
+	if template == "" {
+		return
+	}
+	args, err := postExportArgs(template, filePath)
+	if err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Post-export command not run: "+err.Error()))
+		return
+	}
+	if len(args) == 0 {
+		return
+	}
+
+	fmt.Println(Colorize(RoleInfo, "  [*] Running post-export command: "+strings.Join(args, " ")))
+	if err := runPostExportCommand(args[0], args[1:]...); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Post-export command failed: "+err.Error()))
+	}
+}
+
+// hookedExportFile runs the post-export hook once the export file is closed, unless writing or
+// closing it failed. Closing it again does nothing, so exporters can defer Close for their error
+// paths and still check the result of the final Close.
+type hookedExportFile struct {
+	io.WriteCloser
+	path   string
+	failed bool
+	closed bool
+}
+
+// Write writes to the file, remembering a failure so the hook is skipped.
+func (f *hookedExportFile) Write(p []byte) (int, error) {
+	n, err := f.WriteCloser.Write(p)
+	if err != nil {
+		f.failed = true
+	}
+	return n, err
+}
+
+// Close closes the file and then runs the post-export hook for it.
+func (f *hookedExportFile) Close() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	if err := f.WriteCloser.Close(); err != nil || f.failed {
+		return err
+	}
+	runPostExportHook(f.path)
+	return nil
+}
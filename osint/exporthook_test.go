@@ -0,0 +1,83 @@
+package osint
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// recordPostExportCommands replaces the command runner with one that records each command.
+func recordPostExportCommands(t *testing.T) *[][]string {
+	t.Helper()
+	var calls [][]string
+	original := runPostExportCommand
+	runPostExportCommand = func(name string, args ...string) error {
+		calls = append(calls, append([]string{name}, args...))
+		return nil
+	}
+	t.Cleanup(func() { runPostExportCommand = original })
+	return &calls
+}
+
+func TestPostExportHook_RunsWithExportedFile(t *testing.T) {
+	calls := recordPostExportCommands(t)
+	t.Setenv(postExportEnv, `scp {file} "backup host:exports/"`)
+
+	// A hostile file name stays a single argument
+	filePath := filepath.Join(t.TempDir(), "iss; rm -rf ~.tle")
+	if err := ExportRawTLE("ISS (ZARYA)", testTLELine1, testTLELine2, filePath); err != nil {
+		t.Fatalf("ExportRawTLE() failed: %v", err)
+	}
+
+	want := [][]string{{"scp", filePath, "backup host:exports/"}}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("Post-export commands = %q, want %q", *calls, want)
+	}
+}
+
+func TestPostExportHook_RunsOnceForCSV(t *testing.T) {
+	calls := recordPostExportCommands(t)
+	t.Setenv(postExportEnv, "touch")
+
+	// CSV exports close the file explicitly and again in a defer
+	filePath := filepath.Join(t.TempDir(), "positions.csv")
+	if err := exportSatellitePositionCSV(createTestResponse(), filePath); err != nil {
+		t.Fatalf("exportSatellitePositionCSV() failed: %v", err)
+	}
+	if len(*calls) != 1 {
+		t.Errorf("Expected one post-export command, got %q", *calls)
+	}
+}
+
+func TestPostExportHook_OptIn(t *testing.T) {
+	calls := recordPostExportCommands(t)
+	t.Setenv(postExportEnv, "")
+
+	if err := writeExportFile(filepath.Join(t.TempDir(), "track.csv"), []byte("a,b\n")); err != nil {
+		t.Fatalf("writeExportFile() failed: %v", err)
+	}
+	if len(*calls) != 0 {
+		t.Errorf("Expected no post-export command without %s, got %q", postExportEnv, *calls)
+	}
+}
+
+func TestPostExportArgs(t *testing.T) {
+	tests := []struct {
+		template string
+		file     string
+		want     []string
+		wantErr  bool
+	}{
+		{"upload.sh", "out.csv", []string{"upload.sh", "out.csv"}, false},
+		{"cp {file} /mnt/share/{file}.bak", "out.csv", []string{"cp", "out.csv", "/mnt/share/out.csv.bak"}, false},
+		{"rclone copy {file} 'remote:My Folder'", "out.csv", []string{"rclone", "copy", "out.csv", "remote:My Folder"}, false},
+		{"upload.sh", "-rf", []string{"upload.sh", "." + string(filepath.Separator) + "-rf"}, false},
+		{"upload.sh 'unterminated", "out.csv", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := postExportArgs(tt.template, tt.file)
+		if (err != nil) != tt.wantErr || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("postExportArgs(%q, %q) = %q, %v; want %q", tt.template, tt.file, got, err, tt.want)
+		}
+	}
+}
@@ -1,14 +1,14 @@
 package osint
 
 import (
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/TwiN/go-color"
 	"github.com/manifoldco/promptui"
 )
 
@@ -21,6 +21,7 @@ type FavoriteSatellite struct {
 	Country       string `json:"country,omitempty"`
 	ObjectType    string `json:"object_type,omitempty"`
 	AddedDate    string `json:"added_date"`
+	Notes         string `json:"notes,omitempty"`
 }
 
 // FavoritesList represents the collection of favorite satellites.
@@ -28,16 +29,9 @@ type FavoritesList struct {
 	Favorites []FavoriteSatellite `json:"favorites"`
 }
 
-// getFavoritesPath returns the full path to the favorites file.
+// getFavoritesPath returns the full path to the favorites file in the data directory.
 func getFavoritesPath() string {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		// Fallback to current directory
-		return favoritesFile
-	}
-	favoritesDir := filepath.Join(homeDir, ".satintel")
-	os.MkdirAll(favoritesDir, 0755)
-	return filepath.Join(favoritesDir, favoritesFile)
+	return getAppDataPath(favoritesFile)
 }
 
 // LoadFavorites reads the favorites list from the JSON file.
@@ -131,6 +125,22 @@ func RemoveFavorite(noradID string) error {
 	return SaveFavorites(updatedFavorites)
 }
 
+// SetFavoriteNotes replaces the notes of a favorite satellite.
+func SetFavoriteNotes(noradID, notes string) error {
+	favorites, err := LoadFavorites()
+	if err != nil {
+		return err
+	}
+
+	for i := range favorites {
+		if favorites[i].NORADID == noradID {
+			favorites[i].Notes = strings.TrimSpace(notes)
+			return SaveFavorites(favorites)
+		}
+	}
+	return fmt.Errorf("satellite with NORAD ID %s not found in favorites", noradID)
+}
+
 // IsFavorite checks if a satellite is in the favorites list.
 func IsFavorite(noradID string) (bool, error) {
 	favorites, err := LoadFavorites()
@@ -147,48 +157,271 @@ func IsFavorite(noradID string) (bool, error) {
 	return false, nil
 }
 
+// readFavoritesFile reads favorites from a file written by ExportFavorites in JSON, or from a
+// favorites.json copied from another machine; a bare JSON array is accepted too. Files ending in
+// .gz are decompressed. Records that cannot be decoded, have no NORAD ID or repeat an earlier
+// NORAD ID are left out and described in the returned list of skipped records.
+func readFavoritesFile(filePath string) ([]FavoriteSatellite, []string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, NewAppErrorWithContext(ErrCodeFileNotFound, "Favorites file not found", "Path: "+filePath)
+		}
+		return nil, nil, NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed to open favorites file", err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(strings.ToLower(filePath), ".gz") {
+		zr, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, nil, NewAppErrorWithContext(ErrCodeFileReadFailed, "Favorites file is not valid gzip", "Path: "+filePath)
+		}
+		defer zr.Close()
+		reader = zr
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed to read favorites file", err)
+	}
+
+	var records []json.RawMessage
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(data, &records)
+	} else {
+		var envelope struct {
+			Favorites []json.RawMessage `json:"favorites"`
+		}
+		err = json.Unmarshal(data, &envelope)
+		records = envelope.Favorites
+	}
+	if err != nil {
+		return nil, nil, NewAppErrorWithContext(ErrCodeInputFormat, "Favorites file is not valid JSON", "Path: "+filePath)
+	}
+
+	var favorites []FavoriteSatellite
+	var skipped []string
+	seen := make(map[string]bool)
+	for i, record := range records {
+		var fav FavoriteSatellite
+		if err := json.Unmarshal(record, &fav); err != nil {
+			skipped = append(skipped, fmt.Sprintf("record %d: not a favorite entry", i+1))
+			continue
+		}
+		fav.NORADID = strings.TrimSpace(fav.NORADID)
+		switch {
+		case fav.NORADID == "":
+			skipped = append(skipped, fmt.Sprintf("record %d: missing NORAD ID", i+1))
+			continue
+		case seen[fav.NORADID]:
+			skipped = append(skipped, fmt.Sprintf("record %d: duplicate NORAD ID %s", i+1, fav.NORADID))
+			continue
+		}
+		seen[fav.NORADID] = true
+		if fav.AddedDate == "" {
+			fav.AddedDate = time.Now().Format("2006-01-02 15:04:05")
+		}
+		favorites = append(favorites, fav)
+	}
+	return favorites, skipped, nil
+}
+
+// ExportFavorites writes the current favorites to filePath as pretty JSON, so ImportFavorites can
+// load them on another machine.
+func ExportFavorites(filePath string) error {
+	favorites, err := LoadFavorites()
+	if err != nil {
+		return err
+	}
+	return exportFavoritesJSON(favorites, filePath)
+}
+
+// ImportFavorites loads favorites from a file written by ExportFavorites. With merge set,
+// satellites not yet in favorites are added and existing entries are kept as they are; otherwise
+// the file replaces the favorites list. Malformed records are skipped and reported rather than
+// failing the import.
+func ImportFavorites(filePath string, merge bool) error {
+	imported, skipped, err := readFavoritesFile(filePath)
+	if err != nil {
+		return err
+	}
+	for _, reason := range skipped {
+		fmt.Println(Colorize(RoleWarning, "  [!] Skipped "+reason))
+	}
+	if len(imported) == 0 {
+		// Leave the current list alone rather than replacing it with nothing
+		return NewAppErrorWithContext(ErrCodeInputFormat, "Favorites file contains no valid favorites", "Path: "+filePath)
+	}
+
+	favorites := imported
+	added := len(imported)
+	if merge {
+		if favorites, err = LoadFavorites(); err != nil {
+			return err
+		}
+		existing := make(map[string]bool, len(favorites))
+		for _, fav := range favorites {
+			existing[fav.NORADID] = true
+		}
+		added = 0
+		for _, fav := range imported {
+			if !existing[fav.NORADID] {
+				favorites = append(favorites, fav)
+				added++
+			}
+		}
+	}
+	if err := SaveFavorites(favorites); err != nil {
+		return err
+	}
+
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Imported %d favorites (%d in list)", added, len(favorites))))
+	return nil
+}
+
+// promptImportFavorites asks for a file and whether to merge it, then imports favorites from it.
+func promptImportFavorites() {
+	filePath := scanWithDefault("ENTER FILE PATH TO IMPORT (default: favorites.json)", "favorites.json")
+	prompt := promptui.Select{
+		Label: "Import Mode",
+		Items: []string{"Merge with current favorites", "Replace current favorites"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return
+	}
+	if idx == 1 && !confirm("Replace all current favorites with the imported ones?", false) {
+		return
+	}
+	if err := ImportFavorites(filePath, idx == 0); err != nil {
+		HandleError(err, ErrCodeFileReadFailed, "Failed to import favorites")
+	}
+}
+
+// favoritesSearchThreshold is the number of favorites above which the selection opens in search mode.
+const favoritesSearchThreshold = 15
+
+// favoriteTagPrefix marks a filter term that matches a favorite's country or object type
+// instead of its name or NORAD ID.
+const favoriteTagPrefix = "tag:"
+
+// favoriteMatches reports whether fav matches a favorites filter. Each space-separated term must
+// match: plain terms are case-insensitive substrings of the name or NORAD ID, and "tag:" terms
+// match the country or object type.
+func favoriteMatches(fav FavoriteSatellite, query string) bool {
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		if tag, ok := strings.CutPrefix(term, favoriteTagPrefix); ok {
+			if tag != "" && !strings.Contains(strings.ToLower(fav.Country), tag) &&
+				!strings.Contains(strings.ToLower(fav.ObjectType), tag) {
+				return false
+			}
+			continue
+		}
+		if !strings.Contains(strings.ToLower(fav.SatelliteName), term) && !strings.Contains(fav.NORADID, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// PromptToSaveFavorites asks whether to save a satellite picked from the catalog that is not a
+// favorite yet. When it is off, the catalog list offers a "Save to Favorites" action instead.
+var PromptToSaveFavorites = true
+
+// offerToSaveFavorite asks to save a satellite picked from the catalog, unless it is already a
+// favorite or PromptToSaveFavorites is off.
+func offerToSaveFavorite(sat Satellite) {
+	if !PromptToSaveFavorites {
+		return
+	}
+	if isFav, _ := IsFavorite(sat.NORAD_CAT_ID); isFav {
+		return
+	}
+	if confirm(fmt.Sprintf("Save %s to favorites?", sat.SATNAME), false) {
+		saveSatelliteFavorite(sat)
+	}
+}
+
+// saveFavoriteFromPage lets the user pick one of the listed satellites and saves it as a favorite.
+func saveFavoriteFromPage(sats []Satellite, labels []string) {
+	prompt := promptui.Select{
+		Label: "Save to Favorites ⭐",
+		Items: append(append([]string{}, labels...), "Cancel"),
+		Size:  15,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx >= len(sats) {
+		return
+	}
+	saveSatelliteFavorite(sats[idx])
+}
+
+// saveSatelliteFavorite adds a catalog entry to favorites and reports the outcome.
+func saveSatelliteFavorite(sat Satellite) {
+	if err := AddFavorite(sat.SATNAME, sat.NORAD_CAT_ID, sat.COUNTRY, sat.OBJECT_TYPE); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] "+err.Error()))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Saved %s to favorites", sat.SATNAME)))
+}
+
 // SelectFromFavorites displays a menu to select from saved favorites.
 func SelectFromFavorites() string {
 	favorites, err := LoadFavorites()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to load favorites: "+err.Error()))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to load favorites: "+err.Error()))
 		return ""
 	}
 
 	if len(favorites) == 0 {
-		fmt.Println(color.Ize(color.Yellow, "  [!] No favorites saved yet"))
-		fmt.Println(color.Ize(color.Cyan, "  [*] Add favorites by selecting 'Save to Favorites' after choosing a satellite"))
+		fmt.Println(Colorize(RoleWarning, "  [!] No favorites saved yet"))
+		fmt.Println(Colorize(RoleInfo, "  [*] Add favorites by saving a satellite when browsing the catalog"))
+		if confirm("Import favorites from a file?", false) {
+			promptImportFavorites()
+		}
 		return ""
 	}
 
 	var menuItems []string
 	for _, fav := range favorites {
-		info := fmt.Sprintf("%s (%s)", fav.SatelliteName, fav.NORADID)
+		suffix := ""
 		if fav.Country != "" {
-			info += fmt.Sprintf(" - %s", fav.Country)
+			suffix += fmt.Sprintf(" - %s", fav.Country)
 		}
 		if fav.ObjectType != "" {
-			info += fmt.Sprintf(" [%s]", fav.ObjectType)
+			suffix += fmt.Sprintf(" [%s]", fav.ObjectType)
 		}
-		info += fmt.Sprintf(" (Added: %s)", fav.AddedDate)
-		menuItems = append(menuItems, info)
+		suffix += fmt.Sprintf(" (Added: %s)", fav.AddedDate)
+		if fav.Notes != "" {
+			suffix += fmt.Sprintf(" - %s", fav.Notes)
+		}
+		menuItems = append(menuItems, menuLabel(fav.SatelliteName, fav.NORADID, suffix, terminalWidth()-menuCursorWidth))
 	}
 
-	menuItems = append(menuItems, "❌ Cancel")
+	menuItems = append(menuItems, "✏ Manage Favorites", "❌ Cancel")
 
 	prompt := promptui.Select{
-		Label: fmt.Sprintf("Select from Favorites ⭐ (%d saved)", len(favorites)),
+		Label: fmt.Sprintf("Select from Favorites ⭐ (%d saved, / to filter by name, NORAD or tag:)", len(favorites)),
 		Items: menuItems,
 		Size:  15,
+		Searcher: func(input string, index int) bool {
+			// Keep the manage and cancel entries reachable whatever the filter.
+			return index >= len(favorites) || favoriteMatches(favorites[index], input)
+		},
+		StartInSearchMode: len(favorites) > favoritesSearchThreshold,
 	}
 
 	idx, _, err := prompt.Run()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] PROMPT FAILED"))
+		fmt.Println(Colorize(RoleError, "  [!] PROMPT FAILED"))
 		return ""
 	}
 
-	if idx >= len(favorites) {
+	if idx == len(favorites) {
+		ManageFavorites()
+		return ""
+	}
+	if idx > len(favorites) {
 		// Cancel
 		return ""
 	}
@@ -201,18 +434,24 @@ func SelectFromFavorites() string {
 func ManageFavorites() {
 	favorites, err := LoadFavorites()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to load favorites: "+err.Error()))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to load favorites: "+err.Error()))
 		return
 	}
 
 	if len(favorites) == 0 {
-		fmt.Println(color.Ize(color.Yellow, "  [!] No favorites saved yet"))
+		fmt.Println(Colorize(RoleWarning, "  [!] No favorites saved yet"))
+		if confirm("Import favorites from a file?", false) {
+			promptImportFavorites()
+		}
 		return
 	}
 
 	menuItems := []string{
 		"View All Favorites",
+		"Edit Notes",
 		"Remove Favorite",
+		"Export Favorites",
+		"Import Favorites",
 		"Clear All Favorites",
 		"Back",
 	}
@@ -229,7 +468,7 @@ func ManageFavorites() {
 
 	switch idx {
 	case 0: // View All Favorites
-		fmt.Println(color.Ize(color.Cyan, "\n  Your Favorites:"))
+		fmt.Println(Colorize(RoleInfo, "\n  Your Favorites:"))
 		fmt.Println(strings.Repeat("-", 70))
 		for i, fav := range favorites {
 			fmt.Printf("%d. %s (%s)\n", i+1, fav.SatelliteName, fav.NORADID)
@@ -240,13 +479,50 @@ func ManageFavorites() {
 				fmt.Printf("   Type: %s\n", fav.ObjectType)
 			}
 			fmt.Printf("   Added: %s\n", fav.AddedDate)
+			if fav.Notes != "" {
+				fmt.Printf("   Notes: %s\n", fav.Notes)
+			}
 			if i < len(favorites)-1 {
 				fmt.Println()
 			}
 		}
 		fmt.Println(strings.Repeat("-", 70))
 
-	case 1: // Remove Favorite
+	case 1: // Edit Notes
+		var noteItems []string
+		for _, fav := range favorites {
+			noteItems = append(noteItems, fmt.Sprintf("%s (%s)", fav.SatelliteName, fav.NORADID))
+		}
+		noteItems = append(noteItems, "Cancel")
+
+		notePrompt := promptui.Select{
+			Label: "Select Favorite to Annotate",
+			Items: noteItems,
+		}
+
+		noteIdx, _, err := notePrompt.Run()
+		if err != nil || noteIdx >= len(favorites) {
+			return
+		}
+
+		selected := favorites[noteIdx]
+		textPrompt := promptui.Prompt{
+			Label:     "Notes (e.g. downlink 437.800 MHz FM)",
+			Default:   selected.Notes,
+			AllowEdit: true,
+		}
+		notes, err := textPrompt.Run()
+		if err != nil {
+			return
+		}
+
+		if err := SetFavoriteNotes(selected.NORADID, notes); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		} else {
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Updated notes for %s", selected.SatelliteName)))
+		}
+
+	case 2: // Remove Favorite
 		var removeItems []string
 		for _, fav := range favorites {
 			removeItems = append(removeItems, fmt.Sprintf("%s (%s)", fav.SatelliteName, fav.NORADID))
@@ -265,28 +541,32 @@ func ManageFavorites() {
 
 		selected := favorites[removeIdx]
 		if err := RemoveFavorite(selected.NORADID); err != nil {
-			fmt.Println(color.Ize(color.Red, "  [!] ERROR: "+err.Error()))
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
 		} else {
-			fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Removed %s from favorites", selected.SatelliteName)))
-		}
-
-	case 2: // Clear All Favorites
-		confirmPrompt := promptui.Prompt{
-			Label:     "Are you sure you want to clear all favorites? (yes/no)",
-			Default:   "no",
-			AllowEdit: true,
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Removed %s from favorites", selected.SatelliteName)))
 		}
 
-		confirm, err := confirmPrompt.Run()
+	case 3: // Export Favorites
+		defaultFilename := fmt.Sprintf("favorites_%s", time.Now().Format("20060102_150405"))
+		format, filePath, err := showExportMenuWithFormats(defaultFilename, FormatCSV, FormatJSON)
 		if err != nil {
 			return
 		}
+		if err := ExportFavoritesAs(favorites, format, filePath); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+		} else {
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+		}
+
+	case 4: // Import Favorites
+		promptImportFavorites()
 
-		if strings.ToLower(strings.TrimSpace(confirm)) == "yes" {
+	case 5: // Clear All Favorites
+		if confirm("Are you sure you want to clear all favorites?", false) {
 			if err := SaveFavorites([]FavoriteSatellite{}); err != nil {
-				fmt.Println(color.Ize(color.Red, "  [!] ERROR: "+err.Error()))
+				fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
 			} else {
-				fmt.Println(color.Ize(color.Green, "  [+] All favorites cleared"))
+				fmt.Println(Colorize(RoleSuccess, "  [+] All favorites cleared"))
 			}
 		}
 	}
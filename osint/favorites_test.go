@@ -2,8 +2,10 @@ package osint
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -255,10 +257,13 @@ func TestGetFavoritesPath(t *testing.T) {
 	// doesn't use HOME env var, so we just verify it returns a valid path
 	path := getFavoritesPath()
 	
-	// Verify path ends with expected filename
-	if !strings.HasSuffix(path, filepath.Join(".satintel", "favorites.json")) && 
-	   !strings.HasSuffix(path, filepath.Join(".satintel", "favorites.json")) {
-		t.Errorf("getFavoritesPath() should end with .satintel/favorites.json, got: %q", path)
+	// Verify path is the favorites file in the data directory
+	dataDir, err := DataDir()
+	if err != nil {
+		t.Fatalf("DataDir() error: %v", err)
+	}
+	if path != filepath.Join(dataDir, "favorites.json") {
+		t.Errorf("getFavoritesPath() should be favorites.json in %q, got: %q", dataDir, path)
 	}
 	
 	// Verify directory exists or can be created
@@ -285,6 +290,110 @@ func TestFavoriteSatelliteStruct(t *testing.T) {
 	}
 }
 
+func TestSetFavoriteNotes(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := AddFavorite("ISS (ZARYA)", "25544", "US", "PAYLOAD"); err != nil {
+		t.Fatalf("AddFavorite() failed: %v", err)
+	}
+	if err := SetFavoriteNotes("25544", "  downlink 437.800 MHz FM  "); err != nil {
+		t.Fatalf("SetFavoriteNotes() failed: %v", err)
+	}
+
+	favorites, err := LoadFavorites()
+	if err != nil {
+		t.Fatalf("LoadFavorites() failed: %v", err)
+	}
+	if len(favorites) != 1 || favorites[0].Notes != "downlink 437.800 MHz FM" {
+		t.Errorf("Notes not persisted: %+v", favorites)
+	}
+
+	if err := SetFavoriteNotes("99999", "missing"); err == nil {
+		t.Error("SetFavoriteNotes() should fail for a satellite that is not a favorite")
+	}
+}
+
+func TestLoadFavorites_LegacyFileWithoutNotes(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	legacy := `{"favorites":[{"satellite_name":"ISS (ZARYA)","norad_id":"25544","added_date":"2024-01-01 12:00:00"}]}`
+	if err := os.WriteFile(getFavoritesPath(), []byte(legacy), 0644); err != nil {
+		t.Fatalf("Failed to write legacy favorites: %v", err)
+	}
+
+	favorites, err := LoadFavorites()
+	if err != nil {
+		t.Fatalf("LoadFavorites() failed on legacy file: %v", err)
+	}
+	if len(favorites) != 1 || favorites[0].NORADID != "25544" || favorites[0].Notes != "" {
+		t.Errorf("Unexpected favorites from legacy file: %+v", favorites)
+	}
+
+	// Favorites without notes are still written without the field
+	if err := SaveFavorites(favorites); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+	data, _ := os.ReadFile(getFavoritesPath())
+	if strings.Contains(string(data), "notes") {
+		t.Errorf("Empty notes should be omitted, got:\n%s", data)
+	}
+}
+
+func TestExportFavoritesAs_IncludesNotes(t *testing.T) {
+	favorites := []FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544", Notes: "downlink 437.800 MHz FM"},
+		{SatelliteName: "HST", NORADID: "20580"},
+	}
+	dir := t.TempDir()
+
+	csvPath := filepath.Join(dir, "favorites.csv")
+	if err := ExportFavoritesAs(favorites, FormatCSV, csvPath); err != nil {
+		t.Fatalf("ExportFavoritesAs(CSV) failed: %v", err)
+	}
+	content, _ := os.ReadFile(csvPath)
+	if !strings.Contains(string(content), "Notes") || !strings.Contains(string(content), "downlink 437.800 MHz FM") {
+		t.Errorf("CSV should include notes, got:\n%s", content)
+	}
+
+	jsonPath := filepath.Join(dir, "favorites.json")
+	if err := ExportFavoritesAs(favorites, FormatJSON, jsonPath); err != nil {
+		t.Fatalf("ExportFavoritesAs(JSON) failed: %v", err)
+	}
+	content, _ = os.ReadFile(jsonPath)
+	if !strings.Contains(string(content), `"notes": "downlink 437.800 MHz FM"`) {
+		t.Errorf("JSON should include notes, got:\n%s", content)
+	}
+
+	if err := ExportFavoritesAs(favorites, FormatText, filepath.Join(dir, "favorites.txt")); err == nil {
+		t.Error("ExportFavoritesAs should reject unsupported formats")
+	}
+}
+
+func TestOfferToSaveFavorite(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+	sat := Satellite{SATNAME: "ISS (ZARYA)", NORAD_CAT_ID: "25544", COUNTRY: "US", OBJECT_TYPE: "PAYLOAD"}
+
+	// With the prompt disabled nothing is read and nothing is saved
+	PromptToSaveFavorites = false
+	t.Cleanup(func() { PromptToSaveFavorites = true })
+	withConfirmInput(t, failingReader{t})
+	offerToSaveFavorite(sat)
+	if isFav, _ := IsFavorite("25544"); isFav {
+		t.Error("Satellite was saved although the prompt is disabled")
+	}
+
+	PromptToSaveFavorites = true
+	withConfirmInput(t, io.NopCloser(strings.NewReader("y\n")))
+	offerToSaveFavorite(sat)
+	if isFav, _ := IsFavorite("25544"); !isFav {
+		t.Error("Satellite was not saved after answering yes")
+	}
+
+	// Existing favorites are not asked about again
+	withConfirmInput(t, failingReader{t})
+	offerToSaveFavorite(sat)
+}
+
 // Benchmark tests
 func BenchmarkLoadFavorites(b *testing.B) {
 	tempDir := b.TempDir()
@@ -343,3 +452,131 @@ func BenchmarkSaveFavorites(b *testing.B) {
 	}
 }
 
+func TestFavoriteMatches(t *testing.T) {
+	iss := FavoriteSatellite{SatelliteName: "ISS (ZARYA)", NORADID: "25544", Country: "ISS", ObjectType: "PAYLOAD"}
+	debris := FavoriteSatellite{SatelliteName: "COSMOS 2251 DEB", NORADID: "34427", Country: "CIS", ObjectType: "DEBRIS"}
+
+	tests := []struct {
+		query      string
+		wantISS    bool
+		wantDebris bool
+	}{
+		{"", true, true},
+		{"zarya", true, false},
+		{"COSMOS", false, true},
+		{"255", true, false},
+		{"344", false, true},
+		{"4", true, true},
+		{"tag:debris", false, true},
+		{"tag:cis", false, true},
+		{"cosmos tag:payload", false, false},
+		{"iss tag:payload", true, false},
+		{"hubble", false, false},
+	}
+	for _, tt := range tests {
+		if got := favoriteMatches(iss, tt.query); got != tt.wantISS {
+			t.Errorf("favoriteMatches(ISS, %q) = %v, want %v", tt.query, got, tt.wantISS)
+		}
+		if got := favoriteMatches(debris, tt.query); got != tt.wantDebris {
+			t.Errorf("favoriteMatches(debris, %q) = %v, want %v", tt.query, got, tt.wantDebris)
+		}
+	}
+}
+
+func TestImportFavorites(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+	dir := t.TempDir()
+
+	current := []FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544", Notes: "keep me"},
+		{SatelliteName: "NOAA 19", NORADID: "33591"},
+	}
+	if err := SaveFavorites(current); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+
+	exported := []FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544", Notes: "from the other machine"},
+		{SatelliteName: "HST", NORADID: "20580"},
+	}
+	exportPath := filepath.Join(dir, "favorites.json")
+	if err := ExportFavoritesAs(exported, FormatJSON, exportPath); err != nil {
+		t.Fatalf("ExportFavoritesAs() failed: %v", err)
+	}
+
+	if err := ImportFavorites(exportPath, true); err != nil {
+		t.Fatalf("ImportFavorites(merge) failed: %v", err)
+	}
+	favorites, _ := LoadFavorites()
+	if len(favorites) != 3 || favorites[0].Notes != "keep me" || favorites[2].NORADID != "20580" {
+		t.Errorf("merged favorites = %+v, want the existing two plus HST", favorites)
+	}
+
+	if err := ImportFavorites(exportPath, false); err != nil {
+		t.Fatalf("ImportFavorites(replace) failed: %v", err)
+	}
+	favorites, _ = LoadFavorites()
+	if len(favorites) != 2 || favorites[0].Notes != "from the other machine" {
+		t.Errorf("replaced favorites = %+v, want the exported list", favorites)
+	}
+}
+
+func TestExportFavorites_RoundTrip(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+	saved := []FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544", Notes: "downlink 437.800 MHz FM", AddedDate: "2024-01-01 00:00:00"},
+		{SatelliteName: "HST", NORADID: "20580", AddedDate: "2024-01-02 00:00:00"},
+	}
+	if err := SaveFavorites(saved); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "favorites.json")
+	if err := ExportFavorites(filePath); err != nil {
+		t.Fatalf("ExportFavorites() failed: %v", err)
+	}
+	if err := SaveFavorites([]FavoriteSatellite{}); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+	if err := ImportFavorites(filePath, false); err != nil {
+		t.Fatalf("ImportFavorites() failed: %v", err)
+	}
+
+	favorites, _ := LoadFavorites()
+	if !reflect.DeepEqual(favorites, saved) {
+		t.Errorf("round-tripped favorites = %+v, want %+v", favorites, saved)
+	}
+}
+
+func TestImportFavorites_SkipsMalformedRecords(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+	filePath := filepath.Join(t.TempDir(), "favorites.json")
+	content := `[
+		{"satellite_name": "ISS (ZARYA)", "norad_id": "25544"},
+		{"satellite_name": "No ID"},
+		{"satellite_name": "Bad ID", "norad_id": 20580},
+		{"satellite_name": "ISS again", "norad_id": "25544"},
+		{"satellite_name": "HST", "norad_id": " 20580 "}
+	]`
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, skipped, err := readFavoritesFile(filePath)
+	if err != nil {
+		t.Fatalf("readFavoritesFile() failed: %v", err)
+	}
+	if len(imported) != 2 || imported[1].NORADID != "20580" || imported[0].AddedDate == "" {
+		t.Errorf("imported = %+v, want ISS and HST with an added date", imported)
+	}
+	if len(skipped) != 3 || !strings.Contains(skipped[0], "missing NORAD ID") || !strings.Contains(skipped[2], "duplicate") {
+		t.Errorf("skipped = %v, want the missing, malformed and duplicate records", skipped)
+	}
+
+	if err := os.WriteFile(filePath, []byte(`{"favorites": [{"satellite_name": "No ID"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ImportFavorites(filePath, false); err == nil {
+		t.Error("ImportFavorites() with no valid records should fail instead of clearing favorites")
+	}
+}
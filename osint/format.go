@@ -0,0 +1,98 @@
+package osint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Quantity identifies a kind of numeric value so it can be displayed with a consistent precision.
+type Quantity int
+
+const (
+	QuantityCoordinate Quantity = iota // Latitude and longitude in degrees
+	QuantityAltitude                   // Altitude in kilometers
+	QuantityVelocity                   // Velocity in km/s
+	QuantityAngle                      // Azimuth, elevation and declination in degrees
+)
+
+// maxPrecision caps the number of decimal places, beyond which float64 output is noise.
+const maxPrecision = 12
+
+// quantityNames maps the names used in the config file to quantities.
+var quantityNames = map[string]Quantity{
+	"coordinate": QuantityCoordinate,
+	"altitude":   QuantityAltitude,
+	"velocity":   QuantityVelocity,
+	"angle":      QuantityAngle,
+}
+
+// defaultPrecision holds the decimal places used for each quantity unless configured otherwise.
+var defaultPrecision = map[Quantity]int{
+	QuantityCoordinate: 6,
+	QuantityAltitude:   2,
+	QuantityVelocity:   4,
+	QuantityAngle:      2,
+}
+
+// precision holds the decimal places currently in effect for each quantity.
+var precision = copyPrecision(defaultPrecision)
+
+func copyPrecision(src map[Quantity]int) map[Quantity]int {
+	dst := make(map[Quantity]int, len(src))
+	for q, digits := range src {
+		dst[q] = digits
+	}
+	return dst
+}
+
+// SetPrecision sets the number of decimal places used when displaying or exporting a quantity.
+func SetPrecision(q Quantity, digits int) error {
+	if _, ok := defaultPrecision[q]; !ok {
+		return NewAppError(ErrCodeInputInvalid, fmt.Sprintf("Unknown quantity: %d", q))
+	}
+	if digits < 0 || digits > maxPrecision {
+		return NewAppErrorWithContext(
+			ErrCodeInputOutOfRange,
+			fmt.Sprintf("Precision must be between 0 and %d", maxPrecision),
+			fmt.Sprintf("Value: %d", digits),
+		)
+	}
+	precision[q] = digits
+	return nil
+}
+
+// SetPrecisionByName sets the precision for a quantity named as in the config file
+// ("coordinate", "altitude", "velocity" or "angle").
+func SetPrecisionByName(name string, digits int) error {
+	q, ok := quantityNames[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		names := make([]string, 0, len(quantityNames))
+		for n := range quantityNames {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return NewAppErrorWithContext(
+			ErrCodeInputInvalid,
+			fmt.Sprintf("Unknown precision setting: %s", name),
+			fmt.Sprintf("Available settings: %s", strings.Join(names, ", ")),
+		)
+	}
+	return SetPrecision(q, digits)
+}
+
+// Precision returns the number of decimal places in effect for a quantity.
+func Precision(q Quantity) int {
+	return precision[q]
+}
+
+// ResetPrecision restores the default precision for every quantity.
+func ResetPrecision() {
+	precision = copyPrecision(defaultPrecision)
+}
+
+// FormatQuantity formats a value with the precision configured for its quantity.
+func FormatQuantity(q Quantity, value float64) string {
+	return strconv.FormatFloat(value, 'f', Precision(q), 64)
+}
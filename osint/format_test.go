@@ -0,0 +1,102 @@
+package osint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFormatQuantity_Defaults(t *testing.T) {
+	ResetPrecision()
+	t.Cleanup(ResetPrecision)
+
+	tests := []struct {
+		name     string
+		quantity Quantity
+		value    float64
+		want     string
+	}{
+		{"coordinate", QuantityCoordinate, 40.7128, "40.712800"},
+		{"altitude", QuantityAltitude, 408.123456, "408.12"},
+		{"velocity", QuantityVelocity, 7.6612345, "7.6612"},
+		{"angle", QuantityAngle, 123.456, "123.46"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatQuantity(tt.quantity, tt.value); got != tt.want {
+				t.Errorf("FormatQuantity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity_Configured(t *testing.T) {
+	t.Cleanup(ResetPrecision)
+
+	settings := map[string]int{"coordinate": 3, "altitude": 0, "velocity": 6, "angle": 1}
+	for name, digits := range settings {
+		if err := SetPrecisionByName(name, digits); err != nil {
+			t.Fatalf("SetPrecisionByName(%q, %d) failed: %v", name, digits, err)
+		}
+	}
+
+	tests := []struct {
+		quantity Quantity
+		value    float64
+		want     string
+	}{
+		{QuantityCoordinate, 40.7128, "40.713"},
+		{QuantityAltitude, 408.6, "409"},
+		{QuantityVelocity, 7.66, "7.660000"},
+		{QuantityAngle, 123.456, "123.5"},
+	}
+	for _, tt := range tests {
+		if got := FormatQuantity(tt.quantity, tt.value); got != tt.want {
+			t.Errorf("FormatQuantity(%d, %v) = %q, want %q", tt.quantity, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSetPrecision_Invalid(t *testing.T) {
+	t.Cleanup(ResetPrecision)
+
+	if err := SetPrecision(QuantityAltitude, -1); err == nil {
+		t.Error("Expected error for negative precision")
+	}
+	if err := SetPrecision(QuantityAltitude, maxPrecision+1); err == nil {
+		t.Error("Expected error for excessive precision")
+	}
+	if err := SetPrecision(Quantity(99), 2); err == nil {
+		t.Error("Expected error for unknown quantity")
+	}
+	if err := SetPrecisionByName("speed", 2); err == nil {
+		t.Error("Expected error for unknown setting name")
+	}
+	if Precision(QuantityAltitude) != 2 {
+		t.Errorf("Invalid settings should not change precision, got %d", Precision(QuantityAltitude))
+	}
+}
+
+func TestExportSatellitePositionCSV_UsesPrecision(t *testing.T) {
+	t.Cleanup(ResetPrecision)
+	SetPrecision(QuantityCoordinate, 2)
+	SetPrecision(QuantityAltitude, 1)
+
+	data := Response{
+		SatelliteInfo: SatelliteInfo{Satname: "Test Satellite", Satid: 12345},
+		Positions:     []Position{{Satlatitude: 40.7128, Satlongitude: -74.0060, Sataltitude: 408.26}},
+	}
+	tempFile := filepath.Join(t.TempDir(), "positions.csv")
+	if err := exportSatellitePositionCSV(data, tempFile); err != nil {
+		t.Fatalf("exportSatellitePositionCSV() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(tempFile)
+	if err != nil {
+		t.Fatalf("Failed to read CSV file: %v", err)
+	}
+	if !strings.Contains(string(content), "40.71,-74.01,408.3") {
+		t.Errorf("CSV should use configured precision, got:\n%s", content)
+	}
+}
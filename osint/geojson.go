@@ -0,0 +1,39 @@
+package osint
+
+// geoJSONGeometry is a GeoJSON geometry object. Coordinates are [longitude, latitude] pairs,
+// or a list of them for a LineString.
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// geoJSONFeature is a GeoJSON feature with free-form properties.
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoJSONFeatureCollection is the top-level GeoJSON document written by the exporters.
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// newGeoJSONPoint returns a Point feature at the given coordinates.
+func newGeoJSONPoint(latitude, longitude float64, properties map[string]interface{}) geoJSONFeature {
+	return geoJSONFeature{
+		Type:       "Feature",
+		Geometry:   geoJSONGeometry{Type: "Point", Coordinates: []float64{longitude, latitude}},
+		Properties: properties,
+	}
+}
+
+// newGeoJSONLineString returns a LineString feature through the given [longitude, latitude] points.
+func newGeoJSONLineString(coordinates [][]float64, properties map[string]interface{}) geoJSONFeature {
+	return geoJSONFeature{
+		Type:       "Feature",
+		Geometry:   geoJSONGeometry{Type: "LineString", Coordinates: coordinates},
+		Properties: properties,
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/TwiN/go-color"
 )
 
 // LocationData represents the user's geographic location
@@ -22,74 +20,148 @@ type LocationData struct {
 	Timezone  string
 }
 
-// GetUserLocation automatically detects the user's location using IP geolocation.
-// Returns latitude, longitude, and location info, or an error if detection fails.
-func GetUserLocation() (*LocationData, error) {
-	fmt.Println(color.Ize(color.Cyan, "  [*] Detecting your location..."))
-
-	// Try multiple free geolocation APIs for reliability
-	apis := []struct {
-		name string
-		url  string
-		parse func([]byte) (*LocationData, error)
-	}{
-		{
-			name: "ip-api.com",
-			url:  "http://ip-api.com/json/?fields=status,lat,lon,city,country,regionName,timezone",
-			parse: parseIPAPIResponse,
-		},
-		{
-			name: "ipapi.co",
-			url:  "https://ipapi.co/json/",
-			parse: parseIPAPICoResponse,
-		},
-		{
-			name: "ip-api.com (backup)",
-			url:  "https://ip-api.com/json/?fields=status,lat,lon,city,country,regionName,timezone",
-			parse: parseIPAPIResponse,
-		},
-	}
+// LocationProvider detects the user's location. Implementations can be swapped in through
+// DefaultLocationProvider, for example to use a fixed location or a stub in tests.
+type LocationProvider interface {
+	// Name describes the provider in messages.
+	Name() string
+	// Locate returns the detected location or an error when it cannot be determined.
+	Locate() (*LocationData, error)
+}
 
-	var lastErr error
-	for _, api := range apis {
-		client := &http.Client{
-			Timeout: 5 * time.Second,
-		}
+// DefaultLocationProvider is used by GetUserLocation and GetLocationWithPrompt.
+var DefaultLocationProvider LocationProvider = NewIPLocationProvider()
 
-		resp, err := client.Get(api.url)
-		if err != nil {
-			lastErr = fmt.Errorf("failed to connect to %s: %w", api.name, err)
-			continue
-		}
-		defer resp.Body.Close()
+// ipLocationService is one IP geolocation API and the parser for its response.
+type ipLocationService struct {
+	name  string
+	url   string
+	parse func([]byte) (*LocationData, error)
+}
 
-		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("%s returned status %d", api.name, resp.StatusCode)
-			continue
-		}
+// IPLocationProvider detects the location from the public IP address, trying several free
+// geolocation APIs in turn for reliability.
+type IPLocationProvider struct {
+	Client   *http.Client
+	services []ipLocationService
+}
 
-		// Read response body
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			lastErr = fmt.Errorf("failed to read response from %s: %w", api.name, err)
-			continue
-		}
+// NewIPLocationProvider returns an IPLocationProvider using ip-api.com and ipapi.co.
+func NewIPLocationProvider() *IPLocationProvider {
+	return &IPLocationProvider{
+		Client: NewHTTPClient(5 * time.Second),
+		services: []ipLocationService{
+			{
+				name:  "ip-api.com",
+				url:   "http://ip-api.com/json/?fields=status,lat,lon,city,country,regionName,timezone",
+				parse: parseIPAPIResponse,
+			},
+			{
+				name:  "ipapi.co",
+				url:   "https://ipapi.co/json/",
+				parse: parseIPAPICoResponse,
+			},
+			{
+				name:  "ip-api.com (backup)",
+				url:   "https://ip-api.com/json/?fields=status,lat,lon,city,country,regionName,timezone",
+				parse: parseIPAPIResponse,
+			},
+		},
+	}
+}
+
+// Name implements LocationProvider.
+func (p *IPLocationProvider) Name() string {
+	return "IP geolocation"
+}
 
-		location, err := api.parse(body)
+// Locate implements LocationProvider.
+func (p *IPLocationProvider) Locate() (*LocationData, error) {
+	var lastErr error
+	for _, service := range p.services {
+		location, err := p.query(service)
 		if err != nil {
-			lastErr = fmt.Errorf("failed to parse %s response: %w", api.name, err)
+			lastErr = err
 			continue
 		}
-
 		if location != nil && location.Latitude != 0 && location.Longitude != 0 {
-			fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Location detected: %s, %s", location.City, location.Country)))
 			return location, nil
 		}
 	}
-
 	return nil, fmt.Errorf("failed to detect location from all APIs: %w", lastErr)
 }
 
+// query fetches and parses one service's response.
+func (p *IPLocationProvider) query(service ipLocationService) (*LocationData, error) {
+	resp, err := p.Client.Get(service.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", service.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", service.name, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", service.name, err)
+	}
+
+	location, err := service.parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s response: %w", service.name, err)
+	}
+	return location, nil
+}
+
+// FixedLocationProvider always returns the same location, such as one set in the config file.
+type FixedLocationProvider struct {
+	Location LocationData
+}
+
+// Name implements LocationProvider.
+func (p FixedLocationProvider) Name() string {
+	return "configured location"
+}
+
+// Locate implements LocationProvider.
+func (p FixedLocationProvider) Locate() (*LocationData, error) {
+	location := p.Location
+	return &location, nil
+}
+
+// GetUserLocation detects the user's location with DefaultLocationProvider.
+// Returns latitude, longitude, and location info, or an error if detection fails.
+func GetUserLocation() (*LocationData, error) {
+	return locateWith(DefaultLocationProvider)
+}
+
+// locateWith runs provider and reports progress.
+func locateWith(provider LocationProvider) (*LocationData, error) {
+	fmt.Println(Colorize(RoleInfo, "  [*] Detecting your location ("+provider.Name()+")..."))
+	location, err := provider.Locate()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Location detected: "+location.label()))
+	return location, nil
+}
+
+// label names the location by city and country, or by coordinates when neither is known.
+func (l *LocationData) label() string {
+	var parts []string
+	for _, part := range []string{l.City, l.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return FormatQuantity(QuantityCoordinate, l.Latitude) + ", " + FormatQuantity(QuantityCoordinate, l.Longitude)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // parseIPAPIResponse parses the response from ip-api.com
 func parseIPAPIResponse(body []byte) (*LocationData, error) {
 	var response struct {
@@ -161,75 +233,141 @@ func parseIPAPICoResponse(body []byte) (*LocationData, error) {
 // GetLocationWithPrompt attempts to get location automatically, with manual fallback option.
 // Returns latitude, longitude as strings, and a boolean indicating if location was auto-detected.
 func GetLocationWithPrompt() (string, string, bool) {
-	// Try to auto-detect location
-	location, err := GetUserLocation()
+	return GetLocationWithProvider(DefaultLocationProvider)
+}
+
+// GetLocationWithProvider detects the location with provider and asks the user to confirm it.
+// When the provider fails or the user declines, it falls back to manual entry.
+func GetLocationWithProvider(provider LocationProvider) (string, string, bool) {
+	return locationWithFallback(provider, getManualLocation)
+}
+
+// locationWithFallback is GetLocationWithProvider with manualLocation as the manual entry.
+func locationWithFallback(provider LocationProvider, manualLocation func() (string, string, bool)) (string, string, bool) {
+	location, err := locateWith(provider)
 	if err != nil {
-		fmt.Println(color.Ize(color.Yellow, fmt.Sprintf("  [!] Auto-detection failed: %s", err.Error())))
-		fmt.Println(color.Ize(color.Cyan, "  [*] Please enter your location manually:"))
-		return getManualLocation()
+		fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] Auto-detection failed: %s", err.Error())))
+		fmt.Println(Colorize(RoleInfo, "  [*] Please enter your location manually:"))
+		return manualLocation()
 	}
 
 	// Show detected location and ask for confirmation
-	fmt.Println(color.Ize(color.Cyan, fmt.Sprintf("\n  Detected Location:")))
-	fmt.Println(color.Ize(color.White, fmt.Sprintf("    City: %s", location.City)))
-	fmt.Println(color.Ize(color.White, fmt.Sprintf("    Region: %s", location.Region)))
-	fmt.Println(color.Ize(color.White, fmt.Sprintf("    Country: %s", location.Country)))
-	fmt.Println(color.Ize(color.White, fmt.Sprintf("    Coordinates: %.6f, %.6f", location.Latitude, location.Longitude)))
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("\n  Detected Location:")))
+	fmt.Println(Colorize(RoleText, fmt.Sprintf("    City: %s", location.City)))
+	fmt.Println(Colorize(RoleText, fmt.Sprintf("    Region: %s", location.Region)))
+	fmt.Println(Colorize(RoleText, fmt.Sprintf("    Country: %s", location.Country)))
+	fmt.Println(Colorize(RoleText, "    Coordinates: "+FormatQuantity(QuantityCoordinate, location.Latitude)+", "+FormatQuantity(QuantityCoordinate, location.Longitude)))
 	
-	fmt.Print(color.Ize(color.Cyan, "\n  Use this location? (y/n, default: y) > "))
-	var confirm string
-	fmt.Scanln(&confirm)
-	confirm = strings.ToLower(strings.TrimSpace(confirm))
-
-	if confirm == "" || confirm == "y" || confirm == "yes" {
+	if confirm("Use this location?", true) {
 		return fmt.Sprintf("%.6f", location.Latitude), fmt.Sprintf("%.6f", location.Longitude), true
 	}
 
 	// User wants to enter manually
-	fmt.Println(color.Ize(color.Cyan, "  [*] Please enter your location manually:"))
-	return getManualLocation()
+	fmt.Println(Colorize(RoleInfo, "  [*] Please enter your location manually:"))
+	return manualLocation()
+}
+
+// normalizeDecimalComma rewrites a number typed with a comma decimal separator, such as
+// "40,7128", to use a dot. Input with both separators, several commas or a comma without digits
+// on both sides is a list or uses thousands separators, and is rejected.
+func normalizeDecimalComma(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if !strings.Contains(input, ",") {
+		return input, nil
+	}
+	whole, fraction, _ := strings.Cut(input, ",")
+	digits := strings.TrimLeft(whole, "+-")
+	if strings.Count(input, ",") > 1 || strings.Contains(input, ".") || digits == "" || fraction == "" ||
+		strings.Trim(digits, "0123456789") != "" || strings.Trim(fraction, "0123456789") != "" {
+		return "", NewAppErrorWithContext(ErrCodeInputInvalid,
+			fmt.Sprintf("Ambiguous number %q: enter a single value with one decimal separator, such as 40.7128 or 40,7128", input),
+			"Value: "+input)
+	}
+	return whole + "." + fraction, nil
+}
+
+// normalizeNumberInput is normalizeDecimalComma for quantities that can reach the thousands,
+// where "1,200" may mean 1.2 or 1200 and is rejected as ambiguous.
+func normalizeNumberInput(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if _, fraction, found := strings.Cut(input, ","); found && len(fraction) == 3 {
+		return "", NewAppErrorWithContext(ErrCodeInputInvalid,
+			fmt.Sprintf("Ambiguous number %q: the comma may separate thousands or decimals, enter it with a dot or without the comma", input),
+			"Value: "+input)
+	}
+	return normalizeDecimalComma(input)
+}
+
+// ParseCoordinate parses a latitude or longitude typed with either a dot or a comma as the decimal
+// separator. A comma cannot be a thousands separator here, since coordinates never reach 1,000.
+func ParseCoordinate(input string) (float64, error) {
+	normalized, err := normalizeDecimalComma(strings.TrimSuffix(strings.TrimSpace(input), "°"))
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, NewAppErrorWithContext(ErrCodeInputInvalid, fmt.Sprintf("%q is not a coordinate", input), "Value: "+input)
+	}
+	return value, nil
 }
 
-// getManualLocation prompts the user to manually enter their location.
+// looksNumeric reports whether input contains only characters used to type numbers, so a failed
+// parse is reported as a bad number rather than looked up as a city.
+func looksNumeric(input string) bool {
+	return strings.Trim(input, "0123456789+-.,° ") == "" && strings.ContainsAny(input, "0123456789")
+}
+
+// getManualLocation prompts the user to manually enter their location, either as coordinates
+// or as the name of a major city.
 func getManualLocation() (string, string, bool) {
-	fmt.Print("\n ENTER LATITUDE > ")
-	var latitude string
-	fmt.Scanln(&latitude)
-	if strings.TrimSpace(latitude) == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Latitude cannot be empty"))
+	fmt.Print("\n ENTER LATITUDE OR CITY NAME > ")
+	latitude := strings.TrimSpace(scanLine())
+	if latitude == "" {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Latitude cannot be empty"))
 		return "", "", false
 	}
 
-	// Validate latitude
-	lat, err := strconv.ParseFloat(latitude, 64)
+	// Validate latitude, or look it up as a city when it isn't a number
+	lat, err := ParseCoordinate(latitude)
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Invalid latitude format"))
-		return "", "", false
+		if looksNumeric(latitude) {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.(*AppError).Message))
+			return "", "", false
+		}
+		c, found := findCity(latitude)
+		if !found {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: Invalid latitude format or unknown city: "+latitude))
+			return "", "", false
+		}
+		fmt.Println(Colorize(RoleSuccess, "  [+] Using "+c.Name+", "+c.Country+": "+
+			FormatQuantity(QuantityCoordinate, c.Latitude)+", "+FormatQuantity(QuantityCoordinate, c.Longitude)))
+		return fmt.Sprintf("%.6f", c.Latitude), fmt.Sprintf("%.6f", c.Longitude), false
 	}
 	if lat < -90 || lat > 90 {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Latitude must be between -90 and 90"))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Latitude must be between -90 and 90"))
 		return "", "", false
 	}
 
 	fmt.Print("\n ENTER LONGITUDE > ")
 	var longitude string
-	fmt.Scanln(&longitude)
+	fmt.Fscanln(scanInput, &longitude)
 	if strings.TrimSpace(longitude) == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Longitude cannot be empty"))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Longitude cannot be empty"))
 		return "", "", false
 	}
 
 	// Validate longitude
-	lon, err := strconv.ParseFloat(longitude, 64)
+	lon, err := ParseCoordinate(longitude)
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Invalid longitude format"))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.(*AppError).Message))
 		return "", "", false
 	}
 	if lon < -180 || lon > 180 {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Longitude must be between -180 and 180"))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Longitude must be between -180 and 180"))
 		return "", "", false
 	}
 
-	return latitude, longitude, false
+	return strconv.FormatFloat(lat, 'f', -1, 64), strconv.FormatFloat(lon, 'f', -1, 64), false
 }
 
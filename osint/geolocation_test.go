@@ -0,0 +1,153 @@
+package osint
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// stubLocationProvider returns a fixed location or error.
+type stubLocationProvider struct {
+	location *LocationData
+	err      error
+}
+
+func (p stubLocationProvider) Name() string { return "stub" }
+
+func (p stubLocationProvider) Locate() (*LocationData, error) { return p.location, p.err }
+
+// countingManualLocation returns manual entry with fixed answers and counts how often it was used.
+func countingManualLocation(latitude, longitude string) (func() (string, string, bool), *int) {
+	calls := 0
+	return func() (string, string, bool) {
+		calls++
+		return latitude, longitude, false
+	}, &calls
+}
+
+func TestGetLocationWithProvider(t *testing.T) {
+	NonInteractive = true
+	t.Cleanup(func() { NonInteractive = false })
+	manual, manualCalls := countingManualLocation("10", "20")
+
+	provider := stubLocationProvider{location: &LocationData{Latitude: 51.5074, Longitude: -0.1278, City: "London", Country: "United Kingdom"}}
+	lat, lon, detected := locationWithFallback(provider, manual)
+	if lat != "51.507400" || lon != "-0.127800" || !detected {
+		t.Errorf("GetLocationWithProvider() = %q, %q, %v; want the stub coordinates, auto-detected", lat, lon, detected)
+	}
+	if *manualCalls != 0 {
+		t.Errorf("Manual entry should not be used when the provider succeeds")
+	}
+}
+
+func TestGetLocationWithProvider_FallsBackToManual(t *testing.T) {
+	manual, manualCalls := countingManualLocation("10", "20")
+
+	lat, lon, detected := locationWithFallback(stubLocationProvider{err: errors.New("no network")}, manual)
+	if lat != "10" || lon != "20" || detected {
+		t.Errorf("GetLocationWithProvider() = %q, %q, %v; want manual entry", lat, lon, detected)
+	}
+	if *manualCalls != 1 {
+		t.Errorf("Manual entry used %d times, want 1", *manualCalls)
+	}
+}
+
+func TestGetUserLocation_UsesDefaultProvider(t *testing.T) {
+	original := DefaultLocationProvider
+	DefaultLocationProvider = FixedLocationProvider{Location: LocationData{Latitude: -33.8688, Longitude: 151.2093}}
+	t.Cleanup(func() { DefaultLocationProvider = original })
+
+	location, err := GetUserLocation()
+	if err != nil {
+		t.Fatalf("GetUserLocation() failed: %v", err)
+	}
+	if location.Latitude != -33.8688 || location.Longitude != 151.2093 {
+		t.Errorf("GetUserLocation() = %+v, want the fixed location", location)
+	}
+}
+
+func TestIPLocationProvider_FallsBackAcrossServices(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","lat":48.8566,"lon":2.3522,"city":"Paris","country":"France"}`))
+	}))
+	defer working.Close()
+
+	provider := &IPLocationProvider{
+		Client: http.DefaultClient,
+		services: []ipLocationService{
+			{name: "failing", url: failing.URL, parse: parseIPAPIResponse},
+			{name: "working", url: working.URL, parse: parseIPAPIResponse},
+		},
+	}
+	location, err := provider.Locate()
+	if err != nil {
+		t.Fatalf("Locate() failed: %v", err)
+	}
+	if location.City != "Paris" || location.Latitude != 48.8566 {
+		t.Errorf("Locate() = %+v, want Paris", location)
+	}
+
+	provider.services = provider.services[:1]
+	if _, err := provider.Locate(); err == nil {
+		t.Error("Expected an error when every service fails")
+	}
+}
+
+func TestParseCoordinate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+		ok       bool
+	}{
+		{"40.7128", 40.7128, true},
+		{"40,7128", 40.7128, true},
+		{"-74,0060", -74.006, true},
+		{" 51,5° ", 51.5, true},
+		{"40,7128, -74,0060", 0, false},
+		{"1,234.5", 0, false},
+		{"40,", 0, false},
+		{"north", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := ParseCoordinate(tt.input)
+		if (err == nil) != tt.ok || got != tt.expected {
+			t.Errorf("ParseCoordinate(%q) = %v, %v; want %v, ok %v", tt.input, got, err, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestGetManualLocation_CommaDecimal(t *testing.T) {
+	withScanInput(t, "40,7128\n-74,006\n")
+
+	lat, lon, _ := getManualLocation()
+	if lat != "40.7128" || lon != "-74.006" {
+		t.Errorf("getManualLocation() = %q, %q; want 40.7128, -74.006", lat, lon)
+	}
+}
+
+func TestGetManualLocation_AmbiguousNumber(t *testing.T) {
+	withScanInput(t, "40,7128, -74,0060\n")
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	lat, lon, _ := getManualLocation()
+	w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	if lat != "" || lon != "" {
+		t.Errorf("getManualLocation() = %q, %q; want the input rejected", lat, lon)
+	}
+	if !strings.Contains(string(out), "Ambiguous number") {
+		t.Errorf("Expected an ambiguous number message, got %q", out)
+	}
+}
@@ -0,0 +1,90 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// groundTrackStep is the spacing of the SGP4 positions drawn as the ground track on the ASCII map.
+const groundTrackStep = time.Minute
+
+// groundTrackRune draws the predicted ground track on the ASCII map.
+const groundTrackRune = '∙'
+
+// PredictGroundTrack propagates a TLE with SGP4 for one orbital period from start, at one-minute
+// steps.
+func PredictGroundTrack(line1, line2 string, start time.Time) ([]SGPPosition, error) {
+	tle := ConstructTLE("", line1, line2)
+	if tle.MeanMotion <= 0 {
+		return nil, NewAppError(ErrCodeTLEInsufficientData, "TLE has no mean motion to derive the orbital period from")
+	}
+	return CalculateSGP4Positions(line1, line2, start, start.Add(orbitalPeriod(tle)), groundTrackStep)
+}
+
+// offerGroundTrack asks whether to add the predicted ground track of the mapped satellite and
+// returns it, or nil when declined or unavailable.
+func offerGroundTrack(data Response) []SGPPosition {
+	if data.SatelliteInfo.Satid == 0 || !confirm("Overlay the predicted ground track for one orbit?", false) {
+		return nil
+	}
+	line1, line2, err := cachedTLE(strconv.Itoa(data.SatelliteInfo.Satid))
+	if err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Ground track unavailable: no TLE for this satellite"))
+		return nil
+	}
+	start := time.Now().UTC()
+	if len(data.Positions) > 0 {
+		start = time.Unix(data.Positions[0].Timestamp, 0).UTC()
+	}
+	track, err := PredictGroundTrack(line1, line2, start)
+	if err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Ground track unavailable: "+err.Error()))
+		return nil
+	}
+	return track
+}
+
+// drawGroundTrack joins consecutive track positions with groundTrackRune on blank cells of grid, so
+// land and markers drawn later stay visible. A step across the ±180° meridian is split at the map
+// edge instead of being drawn across the whole map.
+func drawGroundTrack(grid [][]rune, track []SGPPosition) {
+	if len(grid) == 0 || len(track) == 0 {
+		return
+	}
+	positions := make([]Position, len(track))
+	for i, pos := range track {
+		positions[i] = Position{Satlatitude: pos.Latitude, Satlongitude: pos.Longitude}
+	}
+	for _, segment := range splitAtAntimeridian(positions) {
+		drawMapSegment(grid, segment[0].Satlatitude, segment[0].Satlongitude, segment[0].Satlatitude, segment[0].Satlongitude)
+		for i := 1; i < len(segment); i++ {
+			from, to := segment[i-1], segment[i]
+			drawMapSegment(grid, from.Satlatitude, from.Satlongitude, to.Satlatitude, to.Satlongitude)
+		}
+	}
+}
+
+// drawMapSegment draws a straight line of groundTrackRune between two positions on blank cells.
+func drawMapSegment(grid [][]rune, lat1, lon1, lat2, lon2 float64) {
+	height, width := len(grid), len(grid[0])
+	row1, col1 := mapCell(lat1, lon1, width, height)
+	row2, col2 := mapCell(lat2, lon2, width, height)
+	steps := max(absInt(row2-row1), absInt(col2-col1), 1)
+	for step := 0; step <= steps; step++ {
+		row := row1 + int(math.Round(float64((row2-row1)*step)/float64(steps)))
+		col := col1 + int(math.Round(float64((col2-col1)*step)/float64(steps)))
+		if grid[row][col] == ' ' {
+			grid[row][col] = groundTrackRune
+		}
+	}
+}
+
+// absInt returns the absolute value of an int.
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
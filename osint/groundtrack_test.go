@@ -0,0 +1,77 @@
+package osint
+
+import (
+	"testing"
+	"time"
+)
+
+// blankGrid returns a height by width grid of spaces.
+func blankGrid(width, height int) [][]rune {
+	grid := make([][]rune, height)
+	for i := range grid {
+		grid[i] = make([]rune, width)
+		for j := range grid[i] {
+			grid[i][j] = ' '
+		}
+	}
+	return grid
+}
+
+func TestPredictGroundTrack(t *testing.T) {
+	start := time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC)
+	track, err := PredictGroundTrack(testTLELine1, testTLELine2, start)
+	if err != nil {
+		t.Fatalf("PredictGroundTrack() failed: %v", err)
+	}
+	// The ISS takes about 92 minutes per orbit
+	if len(track) < 90 || len(track) > 95 {
+		t.Errorf("got %d positions, want about one per minute over one orbit", len(track))
+	}
+	if got := time.Unix(track[1].Timestamp, 0).Sub(time.Unix(track[0].Timestamp, 0)); got != groundTrackStep {
+		t.Errorf("step = %v, want %v", got, groundTrackStep)
+	}
+}
+
+func TestPredictGroundTrack_InvalidTLE(t *testing.T) {
+	if _, err := PredictGroundTrack("1 bad", "2 bad", time.Now()); err == nil {
+		t.Error("PredictGroundTrack() should fail for an invalid TLE")
+	}
+}
+
+func TestDrawGroundTrack_Continuous(t *testing.T) {
+	grid := blankGrid(80, 20)
+	drawGroundTrack(grid, []SGPPosition{{Latitude: 0, Longitude: -90}, {Latitude: 0, Longitude: 90}})
+
+	row, from := mapCell(0, -90, 80, 20)
+	_, to := mapCell(0, 90, 80, 20)
+	for col := from; col <= to; col++ {
+		if grid[row][col] != groundTrackRune {
+			t.Fatalf("cell (%d, %d) = %q, want the track drawn without gaps", row, col, grid[row][col])
+		}
+	}
+}
+
+func TestDrawGroundTrack_WrapsAtAntimeridian(t *testing.T) {
+	grid := blankGrid(80, 20)
+	drawGroundTrack(grid, []SGPPosition{{Latitude: 10, Longitude: 170}, {Latitude: 10, Longitude: -170}})
+
+	row, _ := mapCell(10, 0, 80, 20)
+	if grid[row][0] != groundTrackRune || grid[row][79] != groundTrackRune {
+		t.Error("the track should run to both edges of the map")
+	}
+	for col := 10; col < 70; col++ {
+		if grid[row][col] == groundTrackRune {
+			t.Fatalf("column %d is drawn, the track should not cross the whole map", col)
+		}
+	}
+}
+
+func TestDrawGroundTrack_KeepsLand(t *testing.T) {
+	grid := blankGrid(80, 20)
+	row, col := mapCell(0, 0, 80, 20)
+	grid[row][col] = '#'
+	drawGroundTrack(grid, []SGPPosition{{Latitude: 0, Longitude: -20}, {Latitude: 0, Longitude: 20}})
+	if grid[row][col] != '#' {
+		t.Errorf("cell = %q, the track should only fill blank cells", grid[row][col])
+	}
+}
@@ -0,0 +1,136 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// launchDateLayouts lists the date formats accepted for launch date queries.
+// Purely numeric day/month orders are avoided because they are ambiguous.
+var launchDateLayouts = []string{
+	"2006-01-02",
+	"2006/01/02",
+	"2006.01.02",
+	"20060102",
+	"Jan 2 2006",
+	"Jan 2, 2006",
+	"January 2 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// normalizeLaunchDate parses a user-supplied date and returns it in Space-Track's YYYY-MM-DD form.
+func normalizeLaunchDate(date string) (string, error) {
+	date = strings.Join(strings.Fields(date), " ")
+	if date == "" {
+		return "", NewAppError(ErrCodeInputEmpty, "Launch date is required")
+	}
+
+	for _, layout := range launchDateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t.Format("2006-01-02"), nil
+		}
+	}
+	return "", NewAppErrorWithContext(
+		ErrCodeInputFormat,
+		fmt.Sprintf("Unrecognized launch date: %s", date),
+		"Use YYYY-MM-DD, e.g. 1957-10-04",
+	)
+}
+
+// buildLaunchDateQuery constructs the satcat query for objects launched on a YYYY-MM-DD date.
+func buildLaunchDateQuery(date string) string {
+	return "/class/satcat/LAUNCH/" + date + "/orderby/NORAD_CAT_ID%20asc/emptyresult/show"
+}
+
+// SatellitesLaunchedOn returns the catalog entries whose launch date matches date.
+// An empty slice is returned when nothing launched that day.
+func SatellitesLaunchedOn(client *http.Client, date string) ([]Satellite, error) {
+	normalized, err := normalizeLaunchDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := QuerySpaceTrack(client, buildLaunchDateQuery(normalized))
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed to query launches", err)
+		appErr.Context = fmt.Sprintf("Launch date: %s", normalized)
+		return nil, appErr
+	}
+
+	sats := []Satellite{}
+	if strings.TrimSpace(data) == "" {
+		return sats, nil
+	}
+	if err := json.Unmarshal([]byte(data), &sats); err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIParseFailed, "Failed to parse satellite catalog data", err)
+		appErr.Context = fmt.Sprintf("Launch date: %s, Response length: %d bytes", normalized, len(data))
+		return nil, appErr
+	}
+	return sats, nil
+}
+
+// launchLines renders the satellites launched on a date as table rows.
+func launchLines(date string, sats []Satellite) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Launch Date", date)),
+		Colorize(RoleHeader, GenRowString("Objects", fmt.Sprintf("%d", len(sats)))),
+	}
+	for _, sat := range sats {
+		status := "In orbit"
+		if sat.DECAY != nil && *sat.DECAY != "" {
+			status = "Decayed " + *sat.DECAY
+		}
+		lines = append(lines,
+			Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+			Colorize(RoleHeader, GenRowString("Name", sat.SATNAME)),
+			Colorize(RoleHeader, GenRowString("NORAD ID", sat.NORAD_CAT_ID)),
+			Colorize(RoleHeader, GenRowString("International Designator", sat.INTLDES)),
+			Colorize(RoleHeader, GenRowString("Country", sat.COUNTRY)),
+			Colorize(RoleHeader, GenRowString("Object Type", sat.OBJECT_TYPE)),
+			Colorize(RoleHeader, GenRowString("Status", status)),
+		)
+	}
+	lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+	return lines
+}
+
+// LaunchesOnDate prompts for a date and lists the satellites launched on it.
+func LaunchesOnDate() {
+	datePrompt := promptui.Prompt{
+		Label: "Launch date (YYYY-MM-DD)",
+		Validate: func(input string) error {
+			_, err := normalizeLaunchDate(input)
+			return err
+		},
+	}
+	input, err := datePrompt.Run()
+	if err != nil {
+		return
+	}
+	date, _ := normalizeLaunchDate(input)
+
+	client, err := Login()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+
+	sats, err := SatellitesLaunchedOn(client, date)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	if len(sats) == 0 {
+		fmt.Println(Colorize(RoleInfo, "  [*] No cataloged objects were launched on "+date))
+		return
+	}
+	printPaged(launchLines(date, sats))
+}
@@ -0,0 +1,114 @@
+package osint
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// withSpaceTrackServer points Space-Track queries at a test server and returns a client for it.
+func withSpaceTrackServer(t *testing.T, handler http.HandlerFunc) *http.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	original := queryBaseURL
+	queryBaseURL = server.URL
+	t.Cleanup(func() {
+		queryBaseURL = original
+		server.Close()
+	})
+	return server.Client()
+}
+
+func TestNormalizeLaunchDate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"1957-10-04", "1957-10-04", false},
+		{"1957/10/04", "1957-10-04", false},
+		{"19571004", "1957-10-04", false},
+		{"Oct 4 1957", "1957-10-04", false},
+		{"October 4, 1957", "1957-10-04", false},
+		{"4 October 1957", "1957-10-04", false},
+		{"  1957-10-04  ", "1957-10-04", false},
+		{"", "", true},
+		{"10/04/1957", "", true},
+		{"1957-13-40", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := normalizeLaunchDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeLaunchDate(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeLaunchDate(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSatellitesLaunchedOn(t *testing.T) {
+	var requestedPath string
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Write([]byte(`[
+			{"INTLDES":"1957-001A","NORAD_CAT_ID":"1","OBJECT_TYPE":"ROCKET BODY","SATNAME":"SL-1 R/B","COUNTRY":"CIS","LAUNCH":"1957-10-04","DECAY":"1957-12-01","PERIOD":"96.19","INCLINATION":"65.10","APOGEE":"938","PERIGEE":"214"},
+			{"INTLDES":"1957-001B","NORAD_CAT_ID":"2","OBJECT_TYPE":"PAYLOAD","SATNAME":"SPUTNIK 1","COUNTRY":"CIS","LAUNCH":"1957-10-04","DECAY":"1958-01-03","PERIOD":"96.10","INCLINATION":"65.00","APOGEE":"1080","PERIGEE":"64"}
+		]`))
+	})
+
+	sats, err := SatellitesLaunchedOn(client, "October 4, 1957")
+	if err != nil {
+		t.Fatalf("SatellitesLaunchedOn() failed: %v", err)
+	}
+
+	wantPath := "/class/satcat/LAUNCH/1957-10-04/orderby/NORAD_CAT_ID asc/emptyresult/show"
+	if requestedPath != wantPath {
+		t.Errorf("Requested path = %q, want %q", requestedPath, wantPath)
+	}
+	if len(sats) != 2 {
+		t.Fatalf("Expected 2 satellites, got %d", len(sats))
+	}
+	if sats[1].SATNAME != "SPUTNIK 1" || sats[1].NORAD_CAT_ID != "2" {
+		t.Errorf("Unexpected second satellite: %+v", sats[1])
+	}
+	if sats[0].LAUNCH != "1957-10-04" {
+		t.Errorf("LAUNCH = %q, want 1957-10-04", sats[0].LAUNCH)
+	}
+}
+
+func TestSatellitesLaunchedOn_NoResults(t *testing.T) {
+	for _, body := range []string{"[]", ""} {
+		client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+
+		sats, err := SatellitesLaunchedOn(client, "1900-01-01")
+		if err != nil {
+			t.Fatalf("Expected no error for body %q, got %v", body, err)
+		}
+		if sats == nil || len(sats) != 0 {
+			t.Errorf("Expected empty non-nil slice for body %q, got %v", body, sats)
+		}
+	}
+}
+
+func TestSatellitesLaunchedOn_Errors(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("not json"))
+	})
+
+	_, err := SatellitesLaunchedOn(client, "1957-10-04")
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAPIParseFailed {
+		t.Errorf("Expected %s error, got %v", ErrCodeAPIParseFailed, err)
+	}
+
+	_, err = SatellitesLaunchedOn(client, "not a date")
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeInputFormat {
+		t.Errorf("Expected %s error, got %v", ErrCodeInputFormat, err)
+	}
+}
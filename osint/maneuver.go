@@ -0,0 +1,127 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	// defaultManeuverThresholdKm is the semi-major axis change between consecutive element sets
+	// treated as a maneuver. Drag decay in low orbit is well under this per day.
+	defaultManeuverThresholdKm = 1.0
+	// maneuverHistoryLimit is how many historical element sets are checked for maneuvers.
+	maneuverHistoryLimit = 30
+)
+
+// ManeuverEvent is a semi-major axis jump between two consecutive element sets.
+type ManeuverEvent struct {
+	Before    time.Time // epoch of the element set before the jump
+	After     time.Time // epoch of the element set after the jump
+	FromKm    float64   // semi-major axis before, km
+	ToKm      float64   // semi-major axis after, km
+	DeltaKm   float64   // change in semi-major axis, km
+	DeltaV    float64   // estimated tangential delta-v, m/s (near-circular approximation)
+	Direction string    // "raise" or "lower"
+}
+
+// semiMajorAxisKm derives the semi-major axis from the mean motion in revolutions per day.
+func semiMajorAxisKm(tle TLE) float64 {
+	if tle.MeanMotion <= 0 {
+		return 0
+	}
+	meanMotion := tle.MeanMotion * 2 * math.Pi / secondsPerDay // rad/s
+	return math.Cbrt(earthMuKm3s2 / (meanMotion * meanMotion))
+}
+
+// DetectManeuvers flags consecutive element sets whose semi-major axes differ by more than
+// thresholdKm. The input may be in any order; events are returned by epoch.
+func DetectManeuvers(tles []TLE, thresholdKm float64) []ManeuverEvent {
+	sorted := make([]TLE, 0, len(tles))
+	for _, tle := range tles {
+		if tle.MeanMotion > 0 && tle.ElementSetEpoch > 0 {
+			sorted = append(sorted, tle)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EpochTime().Before(sorted[j].EpochTime())
+	})
+
+	var events []ManeuverEvent
+	for i := 1; i < len(sorted); i++ {
+		from := semiMajorAxisKm(sorted[i-1])
+		to := semiMajorAxisKm(sorted[i])
+		delta := to - from
+		if math.Abs(delta) <= thresholdKm {
+			continue
+		}
+
+		direction := "raise"
+		if delta < 0 {
+			direction = "lower"
+		}
+		// For a near-circular orbit, da/dv = 2a/v, so dv = v*da/(2a).
+		velocity := math.Sqrt(earthMuKm3s2 / from)
+		events = append(events, ManeuverEvent{
+			Before:    sorted[i-1].EpochTime(),
+			After:     sorted[i].EpochTime(),
+			FromKm:    from,
+			ToKm:      to,
+			DeltaKm:   delta,
+			DeltaV:    math.Abs(velocity*delta/(2*from)) * 1000,
+			Direction: direction,
+		})
+	}
+	return events
+}
+
+// FetchRecentTLEs returns up to limit of the most recent element sets for a satellite from
+// Space-Track's gp_history class.
+func FetchRecentTLEs(client *http.Client, norad string, limit int) ([]TLE, error) {
+	endpoint := fmt.Sprintf("/class/gp_history/format/tle/NORAD_CAT_ID/%s/orderby/EPOCH%%20desc/limit/%d", norad, limit)
+	data, err := QuerySpaceTrack(client, endpoint)
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed to fetch TLE history", err)
+		appErr.Context = "NORAD ID: " + norad
+		return nil, appErr
+	}
+
+	tles := parseTLEText(norad, data)
+	if len(tles) == 0 {
+		return nil, NewAppErrorWithContext(ErrCodeTLEInsufficientData, "No historical TLEs returned", "NORAD ID: "+norad)
+	}
+	return tles, nil
+}
+
+// maneuverLines renders detected maneuvers as table rows.
+func maneuverLines(name string, checked int, events []ManeuverEvent) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Satellite", name)),
+		Colorize(RoleHeader, GenRowString("Element Sets Checked", fmt.Sprintf("%d", checked))),
+		Colorize(RoleHeader, GenRowString("Maneuvers Detected", fmt.Sprintf("%d", len(events)))),
+	}
+	for _, event := range events {
+		lines = append(lines,
+			Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+			Colorize(RoleHeader, GenRowString("Between", event.Before.Format("2006-01-02 15:04")+" and "+event.After.Format("2006-01-02 15:04")+" UTC")),
+			Colorize(RoleHeader, GenRowString("Semi-Major Axis (km)", fmt.Sprintf("%.2f -> %.2f (%+.2f)", event.FromKm, event.ToKm, event.DeltaKm))),
+			Colorize(RoleHeader, GenRowString("Orbit", event.Direction)),
+			Colorize(RoleHeader, GenRowString("Estimated Delta-V (m/s)", fmt.Sprintf("%.2f", event.DeltaV))),
+		)
+	}
+	lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+	return lines
+}
+
+// reportManeuvers fetches a satellite's recent element sets and lists any maneuvers in them.
+func reportManeuvers(client *http.Client, norad, name string) {
+	tles, err := FetchRecentTLEs(client, norad, maneuverHistoryLimit)
+	if err != nil {
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch TLE history")
+		return
+	}
+	printPaged(maneuverLines(name, len(tles), DetectManeuvers(tles, defaultManeuverThresholdKm)))
+}
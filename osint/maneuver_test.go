@@ -0,0 +1,71 @@
+package osint
+
+import (
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestDetectManeuvers(t *testing.T) {
+	// Daily element sets with slow drag decay and one orbit raise between days 5 and 6.
+	var tles []TLE
+	for day := 1; day <= 10; day++ {
+		meanMotion := 15.50 + 0.0001*float64(day)
+		if day >= 6 {
+			meanMotion -= 0.05
+		}
+		tles = append(tles, TLE{ElementSetEpoch: 24000 + float64(day), MeanMotion: meanMotion})
+	}
+	// Order should not matter.
+	tles[0], tles[9] = tles[9], tles[0]
+
+	events := DetectManeuvers(tles, defaultManeuverThresholdKm)
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 maneuver, got %d: %+v", len(events), events)
+	}
+
+	event := events[0]
+	if event.Direction != "raise" || event.DeltaKm <= 0 {
+		t.Errorf("Expected an orbit raise, got %+v", event)
+	}
+	if event.Before != TLEEpochTime(24005) || event.After != TLEEpochTime(24006) {
+		t.Errorf("Maneuver between %v and %v, want days 5 and 6", event.Before, event.After)
+	}
+	if math.Abs(event.DeltaKm-14.6) > 0.5 {
+		t.Errorf("DeltaKm = %.2f, want about 14.6", event.DeltaKm)
+	}
+	if math.Abs(event.DeltaV-8.2) > 0.5 {
+		t.Errorf("DeltaV = %.2f m/s, want about 8.2", event.DeltaV)
+	}
+
+	lowered := DetectManeuvers([]TLE{
+		{ElementSetEpoch: 24001, MeanMotion: 15.45},
+		{ElementSetEpoch: 24002, MeanMotion: 15.50},
+	}, defaultManeuverThresholdKm)
+	if len(lowered) != 1 || lowered[0].Direction != "lower" {
+		t.Errorf("Expected one orbit lowering, got %+v", lowered)
+	}
+
+	if events := DetectManeuvers(tles[:1], defaultManeuverThresholdKm); len(events) != 0 {
+		t.Errorf("A single element set should not produce maneuvers, got %+v", events)
+	}
+}
+
+func TestFetchRecentTLEs(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testTLELine1 + "\n" + testTLELine2 + "\n" + testTLELine1 + "\r\n" + testTLELine2 + "\n"))
+	})
+
+	tles, err := FetchRecentTLEs(client, "25544", 2)
+	if err != nil {
+		t.Fatalf("FetchRecentTLEs() failed: %v", err)
+	}
+	if len(tles) != 2 || tles[1].SatelliteCatalogNumber != 25544 || tles[1].MeanMotion == 0 {
+		t.Errorf("Unexpected history: %+v", tles)
+	}
+
+	empty := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {})
+	if _, err := FetchRecentTLEs(empty, "25544", 2); err == nil {
+		t.Error("Expected error for empty history")
+	}
+}
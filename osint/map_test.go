@@ -1,7 +1,9 @@
 package osint
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -173,6 +175,71 @@ func TestGenerateKMLContentSinglePosition(t *testing.T) {
 	}
 }
 
+func TestGenerateGeoJSONContent(t *testing.T) {
+	data := createTestResponse()
+
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal([]byte(generateGeoJSONContent(data)), &collection); err != nil {
+		t.Fatalf("GeoJSON content did not parse: %v", err)
+	}
+
+	if collection.Type != "FeatureCollection" {
+		t.Errorf("type = %q, want FeatureCollection", collection.Type)
+	}
+	// One LineString for the track plus one Point per position
+	if len(collection.Features) != len(data.Positions)+1 {
+		t.Fatalf("Expected %d features, got %d", len(data.Positions)+1, len(collection.Features))
+	}
+
+	track := collection.Features[0]
+	if track.Geometry.Type != "LineString" {
+		t.Errorf("First feature geometry = %q, want LineString", track.Geometry.Type)
+	}
+	var trackCoords [][]float64
+	if err := json.Unmarshal(track.Geometry.Coordinates, &trackCoords); err != nil || len(trackCoords) != len(data.Positions) {
+		t.Errorf("Track should have %d coordinates, got %v (err %v)", len(data.Positions), trackCoords, err)
+	}
+
+	point := collection.Features[1]
+	var pointCoords []float64
+	if err := json.Unmarshal(point.Geometry.Coordinates, &pointCoords); err != nil {
+		t.Fatalf("Point coordinates did not parse: %v", err)
+	}
+	// GeoJSON orders coordinates longitude first
+	if point.Geometry.Type != "Point" || pointCoords[0] != data.Positions[0].Satlongitude || pointCoords[1] != data.Positions[0].Satlatitude {
+		t.Errorf("Unexpected first point: %s %v", point.Geometry.Type, pointCoords)
+	}
+	if point.Properties["altitude_km"] != data.Positions[0].Sataltitude {
+		t.Errorf("altitude_km = %v, want %v", point.Properties["altitude_km"], data.Positions[0].Sataltitude)
+	}
+	if point.Properties["timestamp"] != float64(data.Positions[0].Timestamp) {
+		t.Errorf("timestamp = %v, want %d", point.Properties["timestamp"], data.Positions[0].Timestamp)
+	}
+}
+
+func TestGenerateGeoJSONContentSinglePosition(t *testing.T) {
+	data := createTestResponse()
+	data.Positions = data.Positions[:1]
+
+	var collection geoJSONFeatureCollection
+	if err := json.Unmarshal([]byte(generateGeoJSONContent(data)), &collection); err != nil {
+		t.Fatalf("GeoJSON content did not parse: %v", err)
+	}
+	if len(collection.Features) != 1 || collection.Features[0].Geometry.Type != "Point" {
+		t.Errorf("A single position should produce one Point feature, got %+v", collection.Features)
+	}
+}
+
 func TestGenerateHTMLMapContent(t *testing.T) {
 	data := createTestResponse()
 	htmlContent := generateHTMLMapContent(data)
@@ -601,3 +668,183 @@ func TestGenerateHTMLMapContentEdgeCases(t *testing.T) {
 	}
 }
 
+
+func TestMapCell_Observer(t *testing.T) {
+	const mapWidth = 80
+	const mapHeight = 24
+
+	testCases := []struct {
+		name        string
+		lat, lon    float64
+		expectedRow int
+		expectedCol int
+	}{
+		{"New York", 40.7128, -74.0060, 6, 23},
+		{"London", 51.5074, -0.1278, 4, 39},
+		{"Sydney", -33.8688, 151.2093, 15, 72},
+		{"Clamped north-east", 95.0, 200.0, 0, mapWidth - 1},
+		{"Clamped south-west", -95.0, -200.0, mapHeight - 1, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			row, col := mapCell(tc.lat, tc.lon, mapWidth, mapHeight)
+			if row != tc.expectedRow || col != tc.expectedCol {
+				t.Errorf("mapCell(%v, %v) = (%d, %d), want (%d, %d)", tc.lat, tc.lon, row, col, tc.expectedRow, tc.expectedCol)
+			}
+		})
+	}
+}
+
+func TestDisplayASCIIMapGenerated_ObserverMarker(t *testing.T) {
+	capture := func(observer *ObserverPosition) string {
+		r, w, _ := os.Pipe()
+		stdout := os.Stdout
+		os.Stdout = w
+		displayASCIIMapGenerated(createTestResponse(), observer, nil)
+		w.Close()
+		os.Stdout = stdout
+		out, _ := io.ReadAll(r)
+		return string(out)
+	}
+
+	withObserver := capture(&ObserverPosition{Latitude: -33.8688, Longitude: 151.2093})
+	if strings.Count(withObserver, string(observerMarker)) != 2 {
+		t.Errorf("Expected observer marker on the map and in the legend")
+	}
+	if !strings.Contains(withObserver, "Your Location") {
+		t.Error("Expected legend entry for the observer")
+	}
+
+	withoutObserver := capture(nil)
+	if strings.Contains(withoutObserver, string(observerMarker)) {
+		t.Error("Observer marker should not appear without an observer")
+	}
+}
+
+func TestRecencyColor(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
+	brightness := func(hex string) int {
+		var r, g, b int
+		if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+			t.Fatalf("recencyColor returned %q: %v", hex, err)
+		}
+		return r + g + b
+	}
+
+	newest := brightness(recencyColor(now, now))
+	older := brightness(recencyColor(now-int64(MapFadeWindow.Seconds()/2), now))
+	oldest := brightness(recencyColor(now-int64(MapFadeWindow.Seconds()), now))
+	if !(newest > older && older > oldest) {
+		t.Errorf("brightness newest %d, older %d, oldest %d; want older positions dimmer", newest, older, oldest)
+	}
+
+	if recencyColor(now-10*int64(MapFadeWindow.Seconds()), now) != recencyColor(now-int64(MapFadeWindow.Seconds()), now) {
+		t.Error("positions beyond the fade window should all get the dimmest color")
+	}
+	if recencyColor(now+60, now) != recencyColor(now, now) {
+		t.Error("positions after now should get the brightest color")
+	}
+}
+
+func TestGenerateHTMLMapContent_FadesOlderMarkers(t *testing.T) {
+	data := createTestResponse()
+	content := generateHTMLMapContent(data)
+
+	newest := data.Positions[len(data.Positions)-1].Timestamp
+	for _, pos := range data.Positions {
+		if !strings.Contains(content, recencyColor(pos.Timestamp, newest)) {
+			t.Errorf("HTML map is missing the recency color for timestamp %d", pos.Timestamp)
+		}
+	}
+	if !strings.Contains(content, "markerColors[index]") {
+		t.Error("HTML map markers should use the recency colors")
+	}
+}
+
+func TestDrawMapGridlines(t *testing.T) {
+	const mapWidth, mapHeight = 80, 24
+	grid := make([][]rune, mapHeight)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", mapWidth))
+	}
+	grid[11][20] = '·' // a position marker on the equator
+	grid[5][39] = '▓'  // land on the prime meridian
+
+	drawMapGridlines(grid, 30)
+
+	// Parallels at 60, 30, 0, -30 and -60 degrees; meridians every 30 degrees from -150 to 150
+	wantRows := map[int]bool{3: true, 7: true, 11: true, 15: true, 19: true}
+	wantCols := map[int]bool{6: true, 13: true, 19: true, 26: true, 32: true, 39: true, 46: true, 52: true, 59: true, 65: true, 72: true}
+	for i := range grid {
+		for j, cell := range grid[i] {
+			want := ' '
+			switch {
+			case i == 11 && j == 20:
+				want = '·'
+			case i == 5 && j == 39:
+				want = '▓'
+			case wantRows[i] && wantCols[j]:
+				want = gridIntersection
+			case wantRows[i]:
+				want = gridHorizontal
+			case wantCols[j]:
+				want = gridVertical
+			}
+			if cell != want {
+				t.Fatalf("grid[%d][%d] = %q, want %q", i, j, cell, want)
+			}
+		}
+	}
+}
+
+func TestSplitAtAntimeridian(t *testing.T) {
+	track := []Position{
+		{Satlatitude: 10, Satlongitude: 170, Timestamp: 0},
+		{Satlatitude: 20, Satlongitude: 178, Timestamp: 60},
+		{Satlatitude: 30, Satlongitude: -178, Timestamp: 120},
+		{Satlatitude: 40, Satlongitude: -170, Timestamp: 180},
+	}
+
+	segments := splitAtAntimeridian(track)
+	if len(segments) != 2 {
+		t.Fatalf("splitAtAntimeridian() gave %d segments, want 2", len(segments))
+	}
+	if len(segments[0]) != 3 || len(segments[1]) != 3 {
+		t.Fatalf("Segment lengths = %d, %d; want 3 and 3", len(segments[0]), len(segments[1]))
+	}
+
+	// Halfway between 178 and -178 east-about is the meridian itself
+	end, start := segments[0][2], segments[1][0]
+	if end.Satlongitude != 180 || start.Satlongitude != -180 {
+		t.Errorf("Crossing longitudes = %v, %v; want 180 and -180", end.Satlongitude, start.Satlongitude)
+	}
+	if end.Satlatitude != 25 || start.Satlatitude != 25 || end.Timestamp != 90 {
+		t.Errorf("Crossing = %+v, want latitude 25 at timestamp 90", end)
+	}
+
+	if got := splitAtAntimeridian(track[:2]); len(got) != 1 || len(got[0]) != 2 {
+		t.Errorf("Track without a crossing split into %v", got)
+	}
+	if got := splitAtAntimeridian(nil); got != nil {
+		t.Errorf("splitAtAntimeridian(nil) = %v, want nil", got)
+	}
+}
+
+func TestMapExports_WrapAtAntimeridian(t *testing.T) {
+	data := createTestResponse()
+	data.Positions = []Position{
+		{Satlatitude: 20, Satlongitude: 178, Sataltitude: 400, Timestamp: 60},
+		{Satlatitude: 30, Satlongitude: -178, Sataltitude: 400, Timestamp: 120},
+	}
+
+	kml := generateKMLContent(data)
+	if !strings.Contains(kml, "Path 1-2 (part 1)") || !strings.Contains(kml, "Path 1-2 (part 2)") {
+		t.Error("KML path across the antimeridian should be split in two parts")
+	}
+
+	html := generateHTMLMapContent(data)
+	if !strings.Contains(html, "var pathSegments = [[[20,178],[25,180]],[[25,-180],[30,-178]]]") {
+		t.Error("HTML map path across the antimeridian should be drawn as two segments")
+	}
+}
@@ -0,0 +1,147 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// n2yoBaseURL is the root of the N2YO satellite REST API that every N2YO request is built on.
+var n2yoBaseURL = "https://api.n2yo.com/rest/v1/satellite"
+
+// n2yoKeyPlaceholders are example values from the documentation that are never valid keys.
+var n2yoKeyPlaceholders = []string{"YOUR_API_KEY", "YOUR_N2YO_API_KEY"}
+
+// n2yoAPIKey returns the configured N2YO API key. An empty or placeholder key is reported as
+// missing, since N2YO would only reject it with an unhelpful response.
+func n2yoAPIKey() (string, error) {
+	key := strings.TrimSpace(os.Getenv("N2YO_API_KEY"))
+	for _, placeholder := range n2yoKeyPlaceholders {
+		if strings.EqualFold(key, placeholder) {
+			key = ""
+		}
+	}
+	if key == "" {
+		appErr := NewAppErrorWithContext(ErrCodeAuthCredentials, "N2YO API key is not set", "Environment variable: N2YO_API_KEY")
+		appErr.Suggestions = []string{
+			"Set N2YO_API_KEY in your environment or .env file, or n2yo_api_key in the config file",
+			"Get a free API key from your account page at n2yo.com",
+		}
+		return "", appErr
+	}
+	return key, nil
+}
+
+// fetchN2YO requests an N2YO endpoint built from path segments and decodes the JSON response into out.
+// description names the data for error messages, and context is attached to any returned AppError.
+func fetchN2YO(segments []string, out interface{}, description, context string) error {
+	key, err := n2yoAPIKey()
+	if err != nil {
+		return err
+	}
+	url := n2yoBaseURL + "/" + strings.Join(segments, "/") + "/&apiKey=" + key
+	waitForN2YO(segments[0])
+	countN2YOCall(segments[0])
+	resp, err := NewHTTPClient(0).Get(url)
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, fmt.Sprintf("Failed to fetch %s data from N2YO API", description), err)
+		appErr.Context = context
+		return appErr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return NewAppErrorWithContext(
+			ErrCodeAPIResponseFailed,
+			fmt.Sprintf("N2YO API returned %s", resp.Status),
+			context,
+		)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIParseFailed, fmt.Sprintf("Failed to parse %s response", description), err)
+		appErr.Context = context
+		return appErr
+	}
+	return nil
+}
+
+// FetchSatellitePositions returns the predicted positions of a satellite over the next
+// seconds seconds as seen from the given observer location (altitude in meters).
+func FetchSatellitePositions(norad, latitude, longitude, altitude string, seconds int) (Response, error) {
+	var data Response
+	context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", norad, latitude, longitude)
+	err := fetchN2YO([]string{"positions", norad, latitude, longitude, altitude, fmt.Sprintf("%d", seconds)}, &data, "satellite position", context)
+	if err != nil {
+		return data, err
+	}
+	RecordN2YOUsage("positions", data.SatelliteInfo.Transactionscount)
+	return data, verifySatelliteID(norad, data.SatelliteInfo)
+}
+
+// verifySatelliteID checks that N2YO answered for the requested NORAD ID, so a quirk in the
+// API never shows another object's data under the requested name.
+func verifySatelliteID(norad string, info SatelliteInfo) error {
+	requested, err := strconv.Atoi(strings.TrimSpace(norad))
+	if err != nil || info.Satid == requested {
+		return nil
+	}
+	if info.Satid == 0 {
+		return NewAppErrorWithContext(ErrCodeSatNotFound, "N2YO has no data for this satellite", fmt.Sprintf("NORAD ID: %d", requested))
+	}
+	return NewAppErrorWithContext(
+		ErrCodeAPIResponseFailed,
+		"N2YO returned data for a different satellite",
+		fmt.Sprintf("Requested NORAD ID: %d, received: %d (%s)", requested, info.Satid, info.Satname),
+	)
+}
+
+// FetchVisualPasses returns the optically visible passes of a satellite for an observer.
+func FetchVisualPasses(norad, latitude, longitude, altitude, days, minVisibility string) (VisualPassesResponse, error) {
+	var data VisualPassesResponse
+	context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", norad, latitude, longitude)
+	err := fetchN2YO([]string{"visualpasses", norad, latitude, longitude, altitude, days, minVisibility}, &data, "visual pass prediction", context)
+	if err == nil {
+		RecordN2YOUsage("visualpasses", data.Info.TransactionsCount)
+	}
+	return data, err
+}
+
+// FetchRadioPasses returns the radio passes of a satellite above a minimum elevation for an observer.
+func FetchRadioPasses(norad, latitude, longitude, altitude, days, minElevation string) (RadioPassResponse, error) {
+	var data RadioPassResponse
+	context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", norad, latitude, longitude)
+	err := fetchN2YO([]string{"radiopasses", norad, latitude, longitude, altitude, days, minElevation}, &data, "radio pass prediction", context)
+	if err == nil {
+		RecordN2YOUsage("radiopasses", data.Info.TransactionsCount)
+	}
+	return data, err
+}
+
+// N2YOTLEResponse is the N2YO tle endpoint payload. TLE holds both element lines separated by "\r\n".
+type N2YOTLEResponse struct {
+	Info SatelliteInfo `json:"info"`
+	TLE  string        `json:"tle"`
+}
+
+// Lines splits the combined TLE string into its two element lines.
+func (r N2YOTLEResponse) Lines() (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(strings.ReplaceAll(r.TLE, "\r\n", "\n")), "\n")
+	if len(parts) != 2 {
+		return "", "", NewAppErrorWithContext(ErrCodeTLEInsufficientData, "N2YO returned no usable TLE", fmt.Sprintf("NORAD ID: %d", r.Info.Satid))
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
+
+// FetchTLE returns the latest two-line element set N2YO holds for a satellite.
+func FetchTLE(norad string) (N2YOTLEResponse, error) {
+	var data N2YOTLEResponse
+	err := fetchN2YO([]string{"tle", norad}, &data, "TLE", fmt.Sprintf("NORAD ID: %s", norad))
+	if err == nil {
+		RecordN2YOUsage("tle", data.Info.Transactionscount)
+	}
+	return data, err
+}
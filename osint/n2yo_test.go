@@ -0,0 +1,167 @@
+package osint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// withN2YOServer points N2YO requests at a test server for the duration of the test.
+func withN2YOServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir()) // keep usage tracking out of the real data directory
+	if os.Getenv("N2YO_API_KEY") == "" {
+		t.Setenv("N2YO_API_KEY", "TESTKEY")
+	}
+	server := httptest.NewServer(handler)
+	original := n2yoBaseURL
+	n2yoBaseURL = server.URL
+	t.Cleanup(func() {
+		n2yoBaseURL = original
+		server.Close()
+	})
+}
+
+func TestFetchSatellitePositions(t *testing.T) {
+	t.Setenv("N2YO_API_KEY", "TESTKEY")
+	expected := createTestResponse()
+
+	var requestedPath string
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		json.NewEncoder(w).Encode(expected)
+	})
+
+	data, err := FetchSatellitePositions("25544", "40.7128", "-74.0060", "10", 2)
+	if err != nil {
+		t.Fatalf("FetchSatellitePositions() failed: %v", err)
+	}
+
+	wantPath := "/positions/25544/40.7128/-74.0060/10/2/&apiKey=TESTKEY"
+	if requestedPath != wantPath {
+		t.Errorf("Requested path = %q, want %q", requestedPath, wantPath)
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("FetchSatellitePositions() = %+v, want %+v", data, expected)
+	}
+}
+
+func TestFetchVisualPasses(t *testing.T) {
+	expected := VisualPassesResponse{
+		Info:   Info{SatID: 25544, SatName: "SPACE STATION", PassesCount: 1},
+		Passes: []Pass{{StartAz: 310.5, StartAzCompass: "NW", MaxEl: 67.2, Duration: 540}},
+	}
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(expected)
+	})
+
+	data, err := FetchVisualPasses("25544", "40.7", "-74.0", "0", "5", "300")
+	if err != nil {
+		t.Fatalf("FetchVisualPasses() failed: %v", err)
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("FetchVisualPasses() = %+v, want %+v", data, expected)
+	}
+}
+
+func TestFetchN2YO_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		wantCode ErrorCode
+	}{
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "boom", http.StatusInternalServerError)
+			},
+			wantCode: ErrCodeAPIResponseFailed,
+		},
+		{
+			name: "invalid json",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("not json"))
+			},
+			wantCode: ErrCodeAPIParseFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withN2YOServer(t, tt.handler)
+
+			_, err := FetchRadioPasses("25544", "40.7", "-74.0", "0", "5", "10")
+			var appErr *AppError
+			if !errors.As(err, &appErr) {
+				t.Fatalf("Expected *AppError, got %v", err)
+			}
+			if appErr.Code != tt.wantCode {
+				t.Errorf("Error code = %s, want %s", appErr.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestFetchN2YO_MissingAPIKey(t *testing.T) {
+	for _, key := range []string{"", "  ", "YOUR_API_KEY"} {
+		t.Run(fmt.Sprintf("key %q", key), func(t *testing.T) {
+			withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("No request should be made without an API key, got %s", r.URL.Path)
+			})
+			t.Setenv("N2YO_API_KEY", key)
+
+			_, err := FetchSatellitePositions("25544", "40.7", "-74.0", "0", 2)
+			var appErr *AppError
+			if !errors.As(err, &appErr) {
+				t.Fatalf("Expected *AppError, got %v", err)
+			}
+			if appErr.Code != ErrCodeAuthCredentials || !strings.Contains(appErr.Context, "N2YO_API_KEY") {
+				t.Errorf("Expected %s naming N2YO_API_KEY, got %s (%s)", ErrCodeAuthCredentials, appErr.Code, appErr.Context)
+			}
+		})
+	}
+}
+
+func TestFetchSatellitePositions_SatelliteMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     SatelliteInfo
+		wantCode ErrorCode
+	}{
+		{"different satellite", SatelliteInfo{Satname: "HST", Satid: 20580}, ErrCodeAPIResponseFailed},
+		{"unknown satellite", SatelliteInfo{}, ErrCodeSatNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := createTestResponse()
+			response.SatelliteInfo = tt.info
+			withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(response)
+			})
+
+			_, err := FetchSatellitePositions("25544", "40.7", "-74.0", "0", 2)
+			var appErr *AppError
+			if !errors.As(err, &appErr) {
+				t.Fatalf("Expected *AppError, got %v", err)
+			}
+			if appErr.Code != tt.wantCode {
+				t.Errorf("Error code = %s, want %s", appErr.Code, tt.wantCode)
+			}
+		})
+	}
+
+	// Leading zeros in the requested ID still match
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(createTestResponse())
+	})
+	if _, err := FetchSatellitePositions("025544", "40.7", "-74.0", "0", 2); err != nil {
+		t.Errorf("Expected matching IDs to pass, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package osint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// noUpcomingPasses is the summary shown when every predicted pass has already started.
+const noUpcomingPasses = "No upcoming passes in prediction window"
+
+// SummarizeNextPass describes the next visual pass in one line, such as "Next visible pass in
+// 3h 12m, max elevation 47° at 21:43 local". Clock times are shown in loc, or the system time
+// zone when loc is nil.
+func SummarizeNextPass(data VisualPassesResponse, loc *time.Location) string {
+	return summarizeNextPass(data, time.Now(), loc)
+}
+
+func summarizeNextPass(data VisualPassesResponse, now time.Time, loc *time.Location) string {
+	if loc == nil {
+		loc = time.Local
+	}
+	var next *Pass
+	for i, pass := range data.Passes {
+		if int64(pass.StartUTC) > now.Unix() && (next == nil || pass.StartUTC < next.StartUTC) {
+			next = &data.Passes[i]
+		}
+	}
+	if next == nil {
+		return noUpcomingPasses
+	}
+
+	start := time.Unix(int64(next.StartUTC), 0)
+	peak := time.Unix(int64(next.MaxUTC), 0).In(loc)
+	clock := peak.Format("15:04")
+	if y, m, d := peak.Date(); y != now.In(loc).Year() || m != now.In(loc).Month() || d != now.In(loc).Day() {
+		clock = peak.Format("Mon Jan 2 15:04")
+	}
+	return fmt.Sprintf("Next visible pass in %s, max elevation %.0f° at %s local", formatTimeUntil(start.Sub(now)), next.MaxEl, clock)
+}
+
+// formatTimeUntil renders a wait as days, hours and minutes, such as "3h 12m" or "1d 0h 5m".
+func formatTimeUntil(d time.Duration) string {
+	if d < time.Minute {
+		return "less than a minute"
+	}
+	minutes := int(d / time.Minute)
+	days, hours := minutes/(24*60), minutes/60%24
+	minutes %= 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh %dm", days, hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+// nextPassBoxLines frames a next-pass summary in a box as wide as the other tables, or wider when
+// the summary needs it.
+func nextPassBoxLines(summary string) []string {
+	width := max(59, utf8.RuneCountInString(summary))
+	padding := strings.Repeat(" ", width-utf8.RuneCountInString(summary))
+	return []string{
+		"╔" + strings.Repeat("═", width+2) + "╗",
+		"║ " + summary + padding + " ║",
+		"╚" + strings.Repeat("═", width+2) + "╝",
+	}
+}
+
+// PrintNextPassSummary prints the next visual pass summary in a highlighted box.
+func PrintNextPassSummary(data VisualPassesResponse) {
+	summary := SummarizeNextPass(data, time.Local)
+	role := RoleSuccess
+	if summary == noUpcomingPasses {
+		role = RoleWarning
+	}
+	for _, line := range nextPassBoxLines(summary) {
+		fmt.Println(Colorize(role, line))
+	}
+	fmt.Println()
+}
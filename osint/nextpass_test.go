@@ -0,0 +1,73 @@
+package osint
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestSummarizeNextPass(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2024, 6, 1, 15, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int { return int(now.Add(d).Unix()) }
+
+	data := VisualPassesResponse{Passes: []Pass{
+		{StartUTC: at(-2 * time.Hour), MaxUTC: at(-2*time.Hour + 3*time.Minute), MaxEl: 80},
+		{StartUTC: at(26 * time.Hour), MaxUTC: at(26*time.Hour + 4*time.Minute), MaxEl: 30},
+		{StartUTC: at(3*time.Hour + 12*time.Minute), MaxUTC: at(3*time.Hour + 15*time.Minute), MaxEl: 47.4},
+	}}
+	want := "Next visible pass in 3h 12m, max elevation 47° at 20:15 local"
+	if got := summarizeNextPass(data, now, loc); got != want {
+		t.Errorf("summarizeNextPass() = %q, want %q", got, want)
+	}
+
+	// A pass on a later day names the day
+	data.Passes = data.Passes[:2]
+	if got := summarizeNextPass(data, now, loc); !strings.Contains(got, "in 1d 2h 0m") || !strings.Contains(got, "Sun Jun 2 19:04 local") {
+		t.Errorf("summarizeNextPass() = %q, want the wait in days and the date of the pass", got)
+	}
+}
+
+func TestSummarizeNextPass_NoUpcoming(t *testing.T) {
+	now := time.Date(2024, 6, 1, 15, 0, 0, 0, time.UTC)
+	data := VisualPassesResponse{Passes: []Pass{{StartUTC: int(now.Add(-time.Hour).Unix())}}}
+	if got := summarizeNextPass(data, now, time.UTC); got != noUpcomingPasses {
+		t.Errorf("summarizeNextPass() = %q, want %q", got, noUpcomingPasses)
+	}
+	if got := summarizeNextPass(VisualPassesResponse{}, now, nil); got != noUpcomingPasses {
+		t.Errorf("summarizeNextPass() without passes = %q, want %q", got, noUpcomingPasses)
+	}
+}
+
+func TestFormatTimeUntil(t *testing.T) {
+	tests := []struct {
+		wait time.Duration
+		want string
+	}{
+		{30 * time.Second, "less than a minute"},
+		{45 * time.Minute, "45m"},
+		{3*time.Hour + 12*time.Minute + 40*time.Second, "3h 12m"},
+		{50*time.Hour + 5*time.Minute, "2d 2h 5m"},
+	}
+	for _, tt := range tests {
+		if got := formatTimeUntil(tt.wait); got != tt.want {
+			t.Errorf("formatTimeUntil(%v) = %q, want %q", tt.wait, got, tt.want)
+		}
+	}
+}
+
+func TestNextPassBoxLines_Aligned(t *testing.T) {
+	for _, summary := range []string{noUpcomingPasses, "Next visible pass in 1d 2h 0m, max elevation 30° at Sun Jun 2 19:04 local"} {
+		lines := nextPassBoxLines(summary)
+		width := utf8.RuneCountInString(lines[0])
+		if width < 63 {
+			t.Errorf("box is %d characters wide, want at least the 63 of other tables", width)
+		}
+		for _, line := range lines {
+			if utf8.RuneCountInString(line) != width {
+				t.Errorf("line %q is not %d characters wide", line, width)
+			}
+		}
+	}
+}
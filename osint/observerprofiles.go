@@ -0,0 +1,286 @@
+package osint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+const observerProfilesFile = "observer_profiles.json"
+
+// ObserverProfile is a saved observer location, so predictions can reuse it without typing
+// coordinates again. Altitude is in meters.
+type ObserverProfile struct {
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+}
+
+// ObserverProfilesList represents the collection of saved observer locations.
+type ObserverProfilesList struct {
+	Profiles []ObserverProfile `json:"profiles"`
+}
+
+// coordinates returns the profile's latitude, longitude and altitude in the form the prediction
+// prompts produce.
+func (p ObserverProfile) coordinates() (string, string, string) {
+	return strconv.FormatFloat(p.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(p.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(p.Altitude, 'f', -1, 64)
+}
+
+// label describes the profile in menus.
+func (p ObserverProfile) label() string {
+	return fmt.Sprintf("%s (%s, %s, %.0f m)", p.Name,
+		FormatQuantity(QuantityCoordinate, p.Latitude), FormatQuantity(QuantityCoordinate, p.Longitude), p.Altitude)
+}
+
+// LoadObserverProfiles reads the saved observer locations from the data directory.
+func LoadObserverProfiles() ([]ObserverProfile, error) {
+	var list ObserverProfilesList
+	if _, err := loadJSONState(observerProfilesFile, &list); err != nil {
+		return nil, err
+	}
+	if list.Profiles == nil {
+		return []ObserverProfile{}, nil
+	}
+	return list.Profiles, nil
+}
+
+// SaveObserverProfiles writes the saved observer locations to the data directory.
+func SaveObserverProfiles(profiles []ObserverProfile) error {
+	return saveJSONState(observerProfilesFile, ObserverProfilesList{Profiles: profiles})
+}
+
+// AddObserverProfile saves a new observer location. Names are unique, ignoring case.
+func AddObserverProfile(profile ObserverProfile) error {
+	profile.Name = strings.TrimSpace(profile.Name)
+	if profile.Name == "" {
+		return fmt.Errorf("location name cannot be empty")
+	}
+	if profile.Latitude < -90 || profile.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if profile.Longitude < -180 || profile.Longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+
+	profiles, err := LoadObserverProfiles()
+	if err != nil {
+		return err
+	}
+	for _, existing := range profiles {
+		if strings.EqualFold(existing.Name, profile.Name) {
+			return fmt.Errorf("a location named %q is already saved", existing.Name)
+		}
+	}
+	return SaveObserverProfiles(append(profiles, profile))
+}
+
+// RemoveObserverProfile deletes a saved observer location by name, ignoring case.
+func RemoveObserverProfile(name string) error {
+	profiles, err := LoadObserverProfiles()
+	if err != nil {
+		return err
+	}
+
+	var updated []ObserverProfile
+	found := false
+	for _, profile := range profiles {
+		if strings.EqualFold(profile.Name, strings.TrimSpace(name)) {
+			found = true
+			continue
+		}
+		updated = append(updated, profile)
+	}
+	if !found {
+		return fmt.Errorf("location %q not found", name)
+	}
+	return SaveObserverProfiles(updated)
+}
+
+// chooseSavedLocation offers the saved observer locations before the usual detection or manual
+// entry. It returns the chosen profile, or nil to detect or enter the location instead, and false
+// when the user cancels. Without saved locations, or when not interactive, it returns nil at once.
+func chooseSavedLocation() (*ObserverProfile, bool) {
+	profiles, err := LoadObserverProfiles()
+	if err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Failed to load saved locations: "+err.Error()))
+		return nil, true
+	}
+	if len(profiles) == 0 || NonInteractive {
+		return nil, true
+	}
+
+	prompt := promptui.Select{
+		Label: "Observer Location",
+		Items: []string{"📍 Select saved location", "🌐 Detect or enter location"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return nil, false
+	}
+	if idx == 1 {
+		return nil, true
+	}
+	return selectObserverProfile(profiles)
+}
+
+// promptObserverLocation asks for the observer location used by predictions and positions: a
+// saved location, or one detected or entered by hand followed by its altitude. saved reports
+// whether the location came from a saved profile, so callers only offer to save new ones.
+func promptObserverLocation() (latitude, longitude, altitude string, saved bool, err error) {
+	profile, ok := chooseSavedLocation()
+	if !ok {
+		return "", "", "", false, NewAppError(ErrCodeInputEmpty, "Observer location is required")
+	}
+	if profile != nil {
+		latitude, longitude, altitude = profile.coordinates()
+		return latitude, longitude, altitude, true, nil
+	}
+
+	// Automatically detect user location
+	latitude, longitude, autoDetected := GetLocationWithPrompt()
+	if latitude == "" || longitude == "" {
+		return "", "", "", false, NewAppError(ErrCodeInputEmpty, "Observer location is required")
+	}
+	if autoDetected {
+		fmt.Println(Colorize(RoleSuccess, "  [+] Using auto-detected location"))
+	}
+
+	altitude, ok = promptNumber("ENTER ALTITUDE (meters, default: 0)", "ALTITUDE", "0", 0, 0)
+	if !ok {
+		return "", "", "", false, NewAppError(ErrCodeInputEmpty, "Observer altitude is required")
+	}
+	return latitude, longitude, altitude, false, nil
+}
+
+// selectObserverProfile lists the saved locations to pick from. Choosing the manage entry opens
+// ManageObserverProfiles and then falls back to detecting or entering the location.
+func selectObserverProfile(profiles []ObserverProfile) (*ObserverProfile, bool) {
+	var items []string
+	for _, profile := range profiles {
+		items = append(items, profile.label())
+	}
+	items = append(items, "✏ Manage Saved Locations", "❌ Cancel")
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Select Saved Location (%d saved)", len(profiles)),
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx > len(profiles) {
+		return nil, false
+	}
+	if idx == len(profiles) {
+		ManageObserverProfiles()
+		return nil, true
+	}
+
+	selected := profiles[idx]
+	fmt.Println(Colorize(RoleSuccess, "  [+] Using saved location: "+selected.label()))
+	return &selected, true
+}
+
+// offerToSaveObserverProfile asks whether to save a location that was just detected or entered.
+func offerToSaveObserverProfile(latitude, longitude, altitude string) {
+	if !confirm("Save this location for later predictions?", false) {
+		return
+	}
+	lat, err := strconv.ParseFloat(latitude, 64)
+	lon, err2 := strconv.ParseFloat(longitude, 64)
+	alt, err3 := strconv.ParseFloat(altitude, 64)
+	if err != nil || err2 != nil || err3 != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Invalid location, not saved"))
+		return
+	}
+	saveObserverProfile(ObserverProfile{Latitude: lat, Longitude: lon, Altitude: alt})
+}
+
+// saveObserverProfile asks for a name and saves the location under it.
+func saveObserverProfile(profile ObserverProfile) {
+	fmt.Print("\n ENTER A NAME FOR THIS LOCATION > ")
+	profile.Name = scanLine()
+	if err := AddObserverProfile(profile); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Saved location %s", strings.TrimSpace(profile.Name))))
+}
+
+// ManageObserverProfiles provides an interactive menu to create, list and delete saved locations.
+func ManageObserverProfiles() {
+	profiles, err := LoadObserverProfiles()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to load saved locations: "+err.Error()))
+		return
+	}
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Manage Saved Locations 📍 (%d saved)", len(profiles)),
+		Items: []string{"Add Location", "View All Locations", "Remove Location", "Back"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	switch idx {
+	case 0: // Add Location
+		latitude, longitude, _ := GetLocationWithPrompt()
+		if latitude == "" || longitude == "" {
+			return
+		}
+		altitude, ok := promptNumber("ENTER ALTITUDE (meters, default: 0)", "ALTITUDE", "0", 0, 0)
+		if !ok {
+			return
+		}
+		lat, _ := strconv.ParseFloat(latitude, 64)
+		lon, _ := strconv.ParseFloat(longitude, 64)
+		alt, _ := strconv.ParseFloat(altitude, 64)
+		saveObserverProfile(ObserverProfile{Latitude: lat, Longitude: lon, Altitude: alt})
+
+	case 1: // View All Locations
+		if len(profiles) == 0 {
+			fmt.Println(Colorize(RoleWarning, "  [!] No locations saved yet"))
+			return
+		}
+		fmt.Println(Colorize(RoleInfo, "\n  Saved Locations:"))
+		fmt.Println(strings.Repeat("-", 70))
+		for i, profile := range profiles {
+			fmt.Printf("%d. %s\n", i+1, profile.label())
+		}
+		fmt.Println(strings.Repeat("-", 70))
+
+	case 2: // Remove Location
+		if len(profiles) == 0 {
+			fmt.Println(Colorize(RoleWarning, "  [!] No locations saved yet"))
+			return
+		}
+		var items []string
+		for _, profile := range profiles {
+			items = append(items, profile.label())
+		}
+		items = append(items, "Cancel")
+
+		removePrompt := promptui.Select{
+			Label: "Select Location to Remove",
+			Items: items,
+		}
+		removeIdx, _, err := removePrompt.Run()
+		if err != nil || removeIdx >= len(profiles) {
+			return
+		}
+
+		selected := profiles[removeIdx]
+		if err := RemoveObserverProfile(selected.Name); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		} else {
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Removed location %s", selected.Name)))
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package osint
+
+import (
+	"testing"
+)
+
+func TestObserverProfiles_SaveAndLoad(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+
+	profiles, err := LoadObserverProfiles()
+	if err != nil || len(profiles) != 0 {
+		t.Fatalf("LoadObserverProfiles() without a file = %v, %v, want an empty list", profiles, err)
+	}
+
+	want := []ObserverProfile{
+		{Name: "Home", Latitude: 51.4779, Longitude: -0.0015, Altitude: 46},
+		{Name: "Dark Site", Latitude: 51.05, Longitude: -1.8, Altitude: 120},
+	}
+	if err := SaveObserverProfiles(want); err != nil {
+		t.Fatalf("SaveObserverProfiles() failed: %v", err)
+	}
+	got, err := LoadObserverProfiles()
+	if err != nil {
+		t.Fatalf("LoadObserverProfiles() failed: %v", err)
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("LoadObserverProfiles() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAddAndRemoveObserverProfile(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+
+	if err := AddObserverProfile(ObserverProfile{Name: " Home ", Latitude: 40.7128, Longitude: -74.006}); err != nil {
+		t.Fatalf("AddObserverProfile() failed: %v", err)
+	}
+	if err := AddObserverProfile(ObserverProfile{Name: "home", Latitude: 1, Longitude: 1}); err == nil {
+		t.Error("AddObserverProfile() should reject a duplicate name")
+	}
+	if err := AddObserverProfile(ObserverProfile{Name: "Nowhere", Latitude: 95}); err == nil {
+		t.Error("AddObserverProfile() should reject an out-of-range latitude")
+	}
+	if err := AddObserverProfile(ObserverProfile{Name: "  "}); err == nil {
+		t.Error("AddObserverProfile() should reject an empty name")
+	}
+
+	profiles, _ := LoadObserverProfiles()
+	if len(profiles) != 1 || profiles[0].Name != "Home" {
+		t.Fatalf("profiles = %+v, want only the trimmed Home profile", profiles)
+	}
+
+	if err := RemoveObserverProfile("HOME"); err != nil {
+		t.Fatalf("RemoveObserverProfile() failed: %v", err)
+	}
+	if err := RemoveObserverProfile("Home"); err == nil {
+		t.Error("RemoveObserverProfile() of a missing profile should fail")
+	}
+	if profiles, _ := LoadObserverProfiles(); len(profiles) != 0 {
+		t.Errorf("profiles after removal = %+v, want none", profiles)
+	}
+}
+
+func TestObserverProfileCoordinates(t *testing.T) {
+	lat, lon, alt := ObserverProfile{Latitude: 48.8566, Longitude: 2.3522, Altitude: 35}.coordinates()
+	if lat != "48.8566" || lon != "2.3522" || alt != "35" {
+		t.Errorf("coordinates() = %s, %s, %s, want 48.8566, 2.3522, 35", lat, lon, alt)
+	}
+}
+
+func TestChooseSavedLocation_NoProfiles(t *testing.T) {
+	t.Setenv(dataDirEnv, t.TempDir())
+
+	profile, ok := chooseSavedLocation()
+	if profile != nil || !ok {
+		t.Errorf("chooseSavedLocation() without profiles = %v, %v, want nil, true", profile, ok)
+	}
+}
@@ -0,0 +1,136 @@
+package osint
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// Offline disables features that need Space-Track or N2YO, so the tool can run without credentials.
+var Offline bool
+
+// credentialEnvKeys lists the environment variables online features depend on.
+var credentialEnvKeys = []string{"SPACE_TRACK_USERNAME", "SPACE_TRACK_PASSWORD", "N2YO_API_KEY"}
+
+// MissingCredentials returns the credential variables that are unset or blank, in the order
+// online features need them.
+func MissingCredentials() []string {
+	var missing []string
+	for _, key := range credentialEnvKeys {
+		if strings.TrimSpace(os.Getenv(key)) == "" {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// CredentialsConfigured reports whether online features can be used: not in offline mode and
+// every credential variable set.
+func CredentialsConfigured() bool {
+	return !Offline && len(MissingCredentials()) == 0
+}
+
+// RequireCredentials prints an explanation and returns false when feature cannot run without credentials.
+func RequireCredentials(feature string) bool {
+	if CredentialsConfigured() {
+		return true
+	}
+	fmt.Println(Colorize(RoleError, "  [!] "+feature+" needs Space-Track and N2YO credentials"))
+	if Offline {
+		fmt.Println(Colorize(RoleInfo, "  [*] Restart without --offline to use online features"))
+	}
+	return false
+}
+
+// PropagateTLE runs SGP4 for a raw element set at the given time, including whether the satellite
+// is sunlit. It works entirely offline.
+func PropagateTLE(line1, line2 string, at time.Time) (SGPPosition, error) {
+	return CalculateSGP4PositionWithIllumination(line1, line2, at)
+}
+
+// offerSGP4Propagation asks whether to compute the current position of a parsed TLE with SGP4,
+// then whether to propagate a ground track from it and predict passes.
+func offerSGP4Propagation(tle TLE, line1, line2 string) {
+	if !confirm("Calculate current position with SGP4?", false) {
+		return
+	}
+
+	now := time.Now().UTC()
+	pos, err := PropagateTLE(line1, line2, now)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	PrintSGP4Position(pos)
+
+	if tle.MeanMotion > 0 && confirm("Propagate a ground track for one orbit?", false) {
+		showGroundTrack(tle, line1, line2, now)
+	}
+	if confirm("Predict passes over a location for the next 24 hours?", false) {
+		showOfflinePasses(line1, line2, now)
+	}
+}
+
+// showGroundTrack prompts for a step and maps one orbit of the TLE from now.
+func showGroundTrack(tle TLE, line1, line2 string, now time.Time) {
+	stepPrompt := promptui.Prompt{
+		Label:     "Step (seconds)",
+		Default:   strconv.Itoa(int(SuggestStep(tle, StepPurposeGroundTrack).Seconds())),
+		AllowEdit: true,
+		Validate: func(input string) error {
+			if seconds, err := strconv.Atoi(strings.TrimSpace(input)); err != nil || seconds <= 0 {
+				return fmt.Errorf("enter a positive number of seconds")
+			}
+			return nil
+		},
+	}
+	stepInput, err := stepPrompt.Run()
+	if err != nil {
+		return
+	}
+	seconds, _ := strconv.Atoi(strings.TrimSpace(stepInput))
+
+	track, err := CalculateSGP4Positions(line1, line2, now, now.Add(orbitalPeriod(tle)), time.Duration(seconds)*time.Second)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Propagated %d positions", len(track))))
+	DisplayMap(groundTrackResponse(tle, track))
+	offerGroundTrackExport(tle, track)
+}
+
+// showOfflinePasses asks for an observer location and lists the TLE's passes over the next day.
+func showOfflinePasses(line1, line2 string, now time.Time) {
+	latitude, longitude, _ := GetLocationWithPrompt()
+	if latitude == "" || longitude == "" {
+		return
+	}
+	lat, _ := strconv.ParseFloat(latitude, 64)
+	lon, _ := strconv.ParseFloat(longitude, 64)
+
+	passes, err := PredictPassesSGP4(line1, line2, ObserverPosition{Latitude: lat, Longitude: lon}, now, now.Add(24*time.Hour), PassDetection)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	printPaged(offlinePassLines(passes))
+}
+
+// groundTrackResponse wraps SGP4 positions in a Response so they can use the map and export views.
+func groundTrackResponse(tle TLE, track []SGPPosition) Response {
+	data := Response{SatelliteInfo: SatelliteInfo{Satname: tle.CommonName, Satid: tle.SatelliteCatalogNumber}}
+	for _, pos := range track {
+		data.Positions = append(data.Positions, Position{
+			Satlatitude:  pos.Latitude,
+			Satlongitude: pos.Longitude,
+			Sataltitude:  pos.Altitude,
+			Timestamp:    pos.Timestamp,
+		})
+	}
+	return data
+}
@@ -0,0 +1,103 @@
+package osint
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// clearCredentials unsets every credential variable for the duration of the test.
+func clearCredentials(t *testing.T) {
+	t.Helper()
+	for _, key := range credentialEnvKeys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func withOffline(t *testing.T, offline bool) {
+	t.Helper()
+	original := Offline
+	Offline = offline
+	t.Cleanup(func() { Offline = original })
+}
+
+func TestCredentialsConfigured(t *testing.T) {
+	clearCredentials(t)
+	withOffline(t, false)
+
+	if CredentialsConfigured() {
+		t.Error("Expected credentials to be unconfigured with no env vars set")
+	}
+
+	t.Setenv("SPACE_TRACK_USERNAME", "user")
+	t.Setenv("SPACE_TRACK_PASSWORD", "pass")
+	t.Setenv("N2YO_API_KEY", "key")
+	if !CredentialsConfigured() {
+		t.Error("Expected credentials to be configured")
+	}
+
+	Offline = true
+	if CredentialsConfigured() {
+		t.Error("Offline mode should disable online features even with credentials set")
+	}
+}
+
+func TestMissingCredentials(t *testing.T) {
+	clearCredentials(t)
+	t.Setenv("SPACE_TRACK_USERNAME", "user")
+	t.Setenv("SPACE_TRACK_PASSWORD", "  ")
+	t.Setenv("N2YO_API_KEY", "")
+
+	missing := MissingCredentials()
+	if !reflect.DeepEqual(missing, []string{"SPACE_TRACK_PASSWORD", "N2YO_API_KEY"}) {
+		t.Errorf("MissingCredentials() = %v, want the blank password and API key", missing)
+	}
+}
+
+func TestOfflineMode_SGP4WithoutCredentials(t *testing.T) {
+	clearCredentials(t)
+	withOffline(t, true)
+
+	at := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+	pos, err := PropagateTLE(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatalf("PropagateTLE() failed in offline mode: %v", err)
+	}
+	if pos.Altitude < 300 || pos.Altitude > 450 {
+		t.Errorf("Expected ISS altitude around 350-400 km, got %.2f", pos.Altitude)
+	}
+}
+
+func TestOfflineMode_LoginRefused(t *testing.T) {
+	clearCredentials(t)
+	withOffline(t, true)
+
+	_, err := Login()
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAuthCredentials {
+		t.Errorf("Expected %s error from Login in offline mode, got %v", ErrCodeAuthCredentials, err)
+	}
+}
+
+func TestGroundTrackResponse(t *testing.T) {
+	tle := TLE{CommonName: "ISS (ZARYA)", SatelliteCatalogNumber: 25544, MeanMotion: 15.70406856}
+	start := time.Date(2004, 8, 23, 14, 0, 0, 0, time.UTC)
+	track, err := CalculateSGP4Positions(testTLELine1, testTLELine2, start, start.Add(orbitalPeriod(tle)), SuggestStep(tle, StepPurposeGroundTrack))
+	if err != nil {
+		t.Fatalf("CalculateSGP4Positions() failed: %v", err)
+	}
+
+	data := groundTrackResponse(tle, track)
+	if data.SatelliteInfo.Satid != 25544 || len(data.Positions) != len(track) {
+		t.Fatalf("Unexpected response: %+v with %d positions", data.SatelliteInfo, len(data.Positions))
+	}
+	if len(track) < 350 || len(track) > 375 {
+		t.Errorf("One orbit at the suggested step should give about 360 positions, got %d", len(track))
+	}
+	if data.Positions[0].Satlatitude != track[0].Latitude || data.Positions[0].Timestamp != track[0].Timestamp {
+		t.Errorf("First position not copied: %+v vs %+v", data.Positions[0], track[0])
+	}
+}
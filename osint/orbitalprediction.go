@@ -1,16 +1,14 @@
 package osint
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/TwiN/go-color"
 	"github.com/iskaa02/qalam/gradient"
-	"github.com/manifoldco/promptui"
 )
 
 // OrbitalPrediction provides an interactive menu for visual and radio pass predictions.
@@ -18,54 +16,45 @@ func OrbitalPrediction() {
 	options, _ := os.ReadFile("txt/orbital_prediction.txt")
 	opt, _ := gradient.NewGradient("#1179ef", "cyan")
 	opt.Print("\n" + string(options))
-	var selection int = Option(0, 3)
+	var selection int = Option(0, 4)
 
 	if selection == 1 {
 		GetVisualPrediction()
 	} else if selection == 2 {
 		GetRadioPrediction()
+	} else if selection == 3 {
+		GetCombinedPrediction()
 	}
 }
 
 // GetVisualPrediction fetches and displays visual pass predictions for a satellite.
-func GetVisualPrediction() {
+// It returns the decoded response so callers can reuse the data.
+func GetVisualPrediction() (VisualPassesResponse, error) {
 	selection := SatelliteSelection()
 	if selection.norad == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: INVALID INPUT"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT"))
+		return VisualPassesResponse{}, NewAppError(ErrCodeSatInvalidNORAD, "No satellite selected")
 	}
 	
-	// Automatically detect user location
-	latitude, longitude, autoDetected := GetLocationWithPrompt()
-	if latitude == "" || longitude == "" {
-		return
-	}
-
-	if autoDetected {
-		fmt.Println(color.Ize(color.Green, "  [+] Using auto-detected location"))
-	}
-
-	fmt.Print("\n ENTER ALTITUDE (meters, default: 0) > ")
-	var altitude string
-	fmt.Scanln(&altitude)
-	if strings.TrimSpace(altitude) == "" {
-		altitude = "0"
+	latitude, longitude, altitude, saved, err := promptObserverLocation()
+	if err != nil {
+		return VisualPassesResponse{}, err
 	}
 	fmt.Print("\n ENTER DAYS OF PREDICTION > ")
 	var days string
 	fmt.Scanln(&days)
 	days = strings.TrimSpace(days)
 	if days == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Days cannot be empty"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Days cannot be empty"))
+		return VisualPassesResponse{}, NewAppError(ErrCodeInputEmpty, "Prediction parameters are required")
 	}
 	fmt.Print("\n ENTER MIN VISIBILITY > ")
 	var vis string
 	fmt.Scanln(&vis)
 	vis = strings.TrimSpace(vis)
 	if vis == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Minimum visibility cannot be empty"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Minimum visibility cannot be empty"))
+		return VisualPassesResponse{}, NewAppError(ErrCodeInputEmpty, "Prediction parameters are required")
 	}
 
 	// Clean inputs by removing degree symbols and other non-numeric characters (except decimal point and minus)
@@ -73,116 +62,174 @@ func GetVisualPrediction() {
 	longitude = cleanNumericInput(longitude)
 	altitude = cleanNumericInput(altitude)
 	
-	_, err := strconv.ParseFloat(latitude, 64)
+	_, err = strconv.ParseFloat(latitude, 64)
 	_, err2 := strconv.ParseFloat(longitude, 64)
 	_, err3 := strconv.ParseFloat(altitude, 64)
 	_, err4 := strconv.Atoi(days)
 	_, err5 := strconv.Atoi(vis)
 
 	if err != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
+		return VisualPassesResponse{}, NewAppError(ErrCodeInputInvalid, "Invalid prediction parameters")
+	}
+	if !saved {
+		offerToSaveObserverProfile(latitude, longitude, altitude)
 	}
 
 	spinner := ShowProgressWithSpinner("Fetching visual pass predictions")
-	url := "https://api.n2yo.com/rest/v1/satellite/visualpasses/" + selection.norad + "/" + latitude + "/" + longitude + "/" + altitude + "/" + days + "/" + vis + "/&apiKey=" + os.Getenv("N2YO_API_KEY")
-	resp, err := http.Get(url)
+	data, err := FetchVisualPasses(selection.norad, latitude, longitude, altitude, days, vis)
 	spinner.Stop()
 	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", selection.norad, latitude, longitude)
-		HandleErrorWithContext(err, ErrCodeAPIRequestFailed, "Failed to fetch visual pass predictions from N2YO API", context)
-		return
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch visual pass predictions from N2YO API")
+		return VisualPassesResponse{}, err
 	}
-	defer resp.Body.Close()
-
-	var data VisualPassesResponse
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s", selection.norad)
-		HandleErrorWithContext(err, ErrCodeAPIParseFailed, "Failed to parse visual pass prediction response", context)
-		return
-	}
-
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║                    Satellite Information                    ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
 
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Name", data.Info.SatName)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite ID", fmt.Sprintf("%d", data.Info.SatID))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Transactions Count", fmt.Sprintf("%d", data.Info.TransactionsCount))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Passes Count", fmt.Sprintf("%d", data.Info.PassesCount))))
+	PrintVisualPasses(data)
+	PrintNextPassSummary(data)
 
-	if len(data.Passes) > 0 {
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-		fmt.Println(color.Ize(color.Purple, "║                       Satellite Passes                      ║"))
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-
-		for in, pos := range data.Passes {
-			PrintVisualPass(pos, in == len(data.Passes)-1)
-		}
-	} else {
-		fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	if len(data.Passes) > 0 && confirm("Show passes as a calendar?", false) {
+		calendarDays, _ := strconv.Atoi(days)
+		fmt.Println(Colorize(RoleInfo, "\n  Rise times (local) and max elevation of each pass"))
+		fmt.Print(Colorize(RoleText, RenderPassCalendar(data.Passes, time.Now(), calendarDays)))
 	}
 
+	lat, _ := strconv.ParseFloat(latitude, 64)
+	lon, _ := strconv.ParseFloat(longitude, 64)
+	alt, _ := strconv.ParseFloat(altitude, 64)
+	offerPassExplanation(selection.norad, ObserverPosition{Latitude: lat, Longitude: lon, Altitude: alt}, data.Passes)
+
 	// Offer export option
-	exportPrompt := promptui.Prompt{
-		Label:     "Export visual pass predictions? (y/n)",
-		Default:   "n",
-		AllowEdit: true,
-	}
-	exportAnswer, _ := exportPrompt.Run()
-	if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+	if confirm("Export visual pass predictions?", false) {
 		defaultFilename := fmt.Sprintf("visual_passes_%s_%d", strings.ReplaceAll(data.Info.SatName, " ", "_"), data.Info.SatID)
 		format, filePath, err := showExportMenu(defaultFilename)
 		if err == nil {
 			if err := ExportVisualPrediction(data, format, filePath); err != nil {
-				fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to export: "+err.Error()))
+				fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
 			} else {
-				fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+				fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
 			}
 		}
 	}
+
+	if len(data.Passes) > 0 && confirm("Save a sky plot of the passes?", false) {
+		defaultFilename := fmt.Sprintf("sky_plot_%s_%d.html", strings.ReplaceAll(data.Info.SatName, " ", "_"), data.Info.SatID)
+		filePath := compressedPath(withExportExt(scanWithDefault("ENTER FILE PATH (default: "+defaultFilename+")", defaultFilename), ".html"))
+		if err := GeneratePolarPlotHTML(data, filePath); err != nil {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+		} else {
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+			fmt.Println(Colorize(RoleInfo, "  [*] Open this file in your web browser to view the sky plot"))
+		}
+	}
+
+	return data, nil
 }
 
-// GetRadioPrediction fetches and displays radio pass predictions for a satellite.
-func GetRadioPrediction() {
-	selection := SatelliteSelection()
-	if selection.norad == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: INVALID INPUT"))
-		return
+// PrintVisualPasses displays the satellite information and all visual passes in a response.
+func PrintVisualPasses(data VisualPassesResponse) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║                    Satellite Information                    ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite Name", data.Info.SatName)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite ID", fmt.Sprintf("%d", data.Info.SatID))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Transactions Count", fmt.Sprintf("%d", data.Info.TransactionsCount))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Passes Count", fmt.Sprintf("%d", data.Info.PassesCount))))
+
+	if len(data.Passes) > compactPassThreshold {
+		// Many passes: one line each, with the full table on request
+		fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n"))
+		var lines []string
+		for i, pass := range data.Passes {
+			lines = append(lines, Colorize(RoleText, "  "+compactVisualPassLine(i+1, pass)))
+		}
+		printPaged(lines)
+		fmt.Println()
+
+		if !confirm("Show full pass details?", false) {
+			return
+		}
+		fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+		fmt.Println(Colorize(RoleHeader, "║                       Satellite Passes                      ║"))
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+		printPaged(visualPassTableLines(data.Passes))
+	} else if len(data.Passes) > 0 {
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+		fmt.Println(Colorize(RoleHeader, "║                       Satellite Passes                      ║"))
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+		printPaged(visualPassTableLines(data.Passes))
+	} else {
+		fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 	}
-	
-	// Automatically detect user location
-	latitude, longitude, autoDetected := GetLocationWithPrompt()
-	if latitude == "" || longitude == "" {
-		return
+}
+
+// compactPassThreshold is the pass count above which passes are listed one line each by default.
+const compactPassThreshold = 5
+
+// visualPassTableLines returns the full table rows for every visual pass.
+func visualPassTableLines(passes []Pass) []string {
+	var lines []string
+	for in, pass := range passes {
+		lines = append(lines, visualPassLines(pass, in == len(passes)-1)...)
+	}
+	return lines
+}
+
+// unknownMagnitude is the value N2YO reports when a pass has no magnitude estimate.
+const unknownMagnitude = 100000
+
+// compactVisualPassLine summarizes a visual pass on one line, e.g.
+// "#1  AOS 21:04  maxEl 52°  dur 6m  mag -1.2". Times are UTC.
+func compactVisualPassLine(n int, pass Pass) string {
+	mag := "n/a"
+	if pass.Mag < unknownMagnitude {
+		mag = fmt.Sprintf("%.1f", pass.Mag)
 	}
+	return fmt.Sprintf("#%d  AOS %s  maxEl %.0f°  dur %s  mag %s",
+		n,
+		time.Unix(int64(pass.StartUTC), 0).UTC().Format("15:04"),
+		pass.MaxEl,
+		formatPassDuration(pass.Duration),
+		mag,
+	)
+}
 
-	if autoDetected {
-		fmt.Println(color.Ize(color.Green, "  [+] Using auto-detected location"))
+// formatPassDuration renders a pass length in seconds as whole minutes, or seconds when under a minute.
+func formatPassDuration(seconds int) string {
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
 	}
+	return fmt.Sprintf("%dm", (seconds+30)/60)
+}
 
-	fmt.Print("\n ENTER ALTITUDE (meters, default: 0) > ")
-	var altitude string
-	fmt.Scanln(&altitude)
-	if strings.TrimSpace(altitude) == "" {
-		altitude = "0"
+// GetRadioPrediction fetches and displays radio pass predictions for a satellite.
+// It returns the decoded response so callers can reuse the data.
+func GetRadioPrediction() (RadioPassResponse, error) {
+	selection := SatelliteSelection()
+	if selection.norad == "" {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT"))
+		return RadioPassResponse{}, NewAppError(ErrCodeSatInvalidNORAD, "No satellite selected")
+	}
+	
+	latitude, longitude, altitude, saved, err := promptObserverLocation()
+	if err != nil {
+		return RadioPassResponse{}, err
 	}
 	fmt.Print("\n ENTER DAYS OF PREDICTION > ")
 	var days string
 	fmt.Scanln(&days)
 	days = strings.TrimSpace(days)
 	if days == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Days cannot be empty"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Days cannot be empty"))
+		return RadioPassResponse{}, NewAppError(ErrCodeInputEmpty, "Prediction parameters are required")
 	}
 	fmt.Print("\n ENTER MIN ELEVATION > ")
 	var elevation string
 	fmt.Scanln(&elevation)
 	elevation = strings.TrimSpace(elevation)
 	if elevation == "" {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Minimum elevation cannot be empty"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Minimum elevation cannot be empty"))
+		return RadioPassResponse{}, NewAppError(ErrCodeInputEmpty, "Prediction parameters are required")
 	}
 
 	// Clean inputs by removing degree symbols and other non-numeric characters (except decimal point and minus)
@@ -190,94 +237,111 @@ func GetRadioPrediction() {
 	longitude = cleanNumericInput(longitude)
 	altitude = cleanNumericInput(altitude)
 	
-	_, err := strconv.ParseFloat(latitude, 64)
+	_, err = strconv.ParseFloat(latitude, 64)
 	_, err2 := strconv.ParseFloat(longitude, 64)
 	_, err3 := strconv.ParseFloat(altitude, 64)
 	_, err4 := strconv.Atoi(days)
 	_, err5 := strconv.Atoi(elevation)
 
 	if err != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
-		return
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
+		return RadioPassResponse{}, NewAppError(ErrCodeInputInvalid, "Invalid prediction parameters")
 	}
-
-	url := "https://api.n2yo.com/rest/v1/satellite/radiopasses/" + selection.norad + "/" + latitude + "/" + longitude + "/" + altitude + "/" + days + "/" + elevation + "/&apiKey=" + os.Getenv("N2YO_API_KEY")
-	resp, err := http.Get(url)
-	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", selection.norad, latitude, longitude)
-		HandleErrorWithContext(err, ErrCodeAPIRequestFailed, "Failed to fetch radio pass predictions from N2YO API", context)
-		return
+	if !saved {
+		offerToSaveObserverProfile(latitude, longitude, altitude)
 	}
-	defer resp.Body.Close()
 
-	var data RadioPassResponse
-	err = json.NewDecoder(resp.Body).Decode(&data)
+	data, err := FetchRadioPasses(selection.norad, latitude, longitude, altitude, days, elevation)
 	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s", selection.norad)
-		HandleErrorWithContext(err, ErrCodeAPIParseFailed, "Failed to parse radio pass prediction response", context)
-		return
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch radio pass predictions from N2YO API")
+		return RadioPassResponse{}, err
 	}
 
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║                    Satellite Information                    ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+	PrintRadioPasses(data)
 
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Name", data.Info.SatName)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite ID", fmt.Sprintf("%d", data.Info.SatID))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Transactions Count", fmt.Sprintf("%d", data.Info.TransactionsCount))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Passes Count", fmt.Sprintf("%d", data.Info.PassesCount))))
-
-	if len(data.Passes) > 0 {
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-		fmt.Println(color.Ize(color.Purple, "║                       Satellite Passes                      ║"))
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-
-		for in, pos := range data.Passes {
-			PrintRadioPass(pos, in == len(data.Passes)-1)
-		}
-	} else {
-		fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	lat, _ := strconv.ParseFloat(latitude, 64)
+	lon, _ := strconv.ParseFloat(longitude, 64)
+	alt, _ := strconv.ParseFloat(altitude, 64)
+	observer := ObserverPosition{Latitude: lat, Longitude: lon, Altitude: alt}
+	offerDopplerProfile(selection.norad, observer, data.Passes)
+	var passes []Pass
+	for _, pass := range data.Passes {
+		passes = append(passes, radioPassAsPass(pass))
 	}
+	offerPassExplanation(selection.norad, observer, passes)
 
 	// Offer export option
-	exportPrompt := promptui.Prompt{
-		Label:     "Export radio pass predictions? (y/n)",
-		Default:   "n",
-		AllowEdit: true,
-	}
-	exportAnswer, _ := exportPrompt.Run()
-	if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+	if confirm("Export radio pass predictions?", false) {
 		defaultFilename := fmt.Sprintf("radio_passes_%s_%d", strings.ReplaceAll(data.Info.SatName, " ", "_"), data.Info.SatID)
 		format, filePath, err := showExportMenu(defaultFilename)
 		if err == nil {
 			if err := ExportRadioPrediction(data, format, filePath); err != nil {
-				fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to export: "+err.Error()))
+				fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
 			} else {
-				fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+				fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
 			}
 		}
 	}
+
+	return data, nil
+}
+
+// PrintRadioPasses displays the satellite information and all radio passes in a response.
+func PrintRadioPasses(data RadioPassResponse) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║                    Satellite Information                    ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite Name", data.Info.SatName)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite ID", fmt.Sprintf("%d", data.Info.SatID))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Transactions Count", fmt.Sprintf("%d", data.Info.TransactionsCount))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Passes Count", fmt.Sprintf("%d", data.Info.PassesCount))))
+
+	if len(data.Passes) > 0 {
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+		fmt.Println(Colorize(RoleHeader, "║                       Satellite Passes                      ║"))
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+
+		var lines []string
+		for in, pos := range data.Passes {
+			lines = append(lines, radioPassLines(pos, in == len(data.Passes)-1)...)
+		}
+		printPaged(lines)
+	} else {
+		fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	}
 }
 
 // SatelliteSelection provides an interactive menu for selecting a satellite by catalog or NORAD ID.
+// The chosen satellite is added to the recently viewed list.
 func SatelliteSelection() SatelliteSelectionType {
 	options, _ := os.ReadFile("txt/orbital_element.txt")
 	opt, _ := gradient.NewGradient("#1179ef", "cyan")
 	opt.Print("\n" + string(options))
 	var selection int = Option(0, 3)
 	if selection == 1 {
+		if !CredentialsConfigured() {
+			fmt.Println(Colorize(RoleWarning, "  [!] Browsing the catalog needs a Space-Track account; enter a NORAD ID instead"))
+			return SatelliteSelectionType{}
+		}
 		result := SelectSatellite()
 
 		if result == "" {
 			return SatelliteSelectionType{}
 		}
 
-		return SatelliteSelectionType{norad: extractNorad(result), name: result}
+		name, norad := splitSatelliteLabel(result)
+		recordRecent(name, norad)
+		return SatelliteSelectionType{norad: norad, name: result}
 
 	} else if selection == 2 {
 		fmt.Print("\n ENTER NORAD ID > ")
 		var norad string
 		fmt.Scanln(&norad)
+		norad = strings.TrimSpace(norad)
+		if norad != "" {
+			recordRecent(knownSatelliteName(norad), norad)
+		}
 		return SatelliteSelectionType{norad: norad, name: "UNSPECIFIED"}
 	}
 
@@ -338,6 +402,12 @@ type RadioPassResponse struct {
 
 // cleanNumericInput removes non-numeric characters from input string, keeping only digits, decimal point, and minus sign.
 func cleanNumericInput(input string) string {
+	// A comma is a decimal separator unless three digits follow it, as in "1,200"
+	if _, fraction, found := strings.Cut(input, ","); found && len(strings.TrimSpace(fraction)) != 3 {
+		if normalized, err := normalizeDecimalComma(input); err == nil {
+			input = normalized
+		}
+	}
 	var result strings.Builder
 	for _, char := range input {
 		if (char >= '0' && char <= '9') || char == '.' || char == '-' {
@@ -347,44 +417,83 @@ func cleanNumericInput(input string) string {
 	return result.String()
 }
 
+// elevationColor grades a pass by its peak elevation: red below 20°, yellow up to 45° and green
+// above, in the active theme's colors. It is empty when the theme disables colors.
+func elevationColor(maxEl float64) string {
+	switch {
+	case maxEl < 20:
+		return currentTheme.colorFor(RoleError)
+	case maxEl <= 45:
+		return currentTheme.colorFor(RoleWarning)
+	}
+	return currentTheme.colorFor(RoleSuccess)
+}
+
+// maxElevationRow formats the max elevation table row colored by pass quality.
+func maxElevationRow(maxEl float64) string {
+	row := GenRowString("Max Elevation", FormatQuantity(QuantityAngle, maxEl))
+	if code := elevationColor(maxEl); code != "" {
+		return color.Ize(code, row)
+	}
+	return row
+}
+
 // PrintVisualPass displays visual pass information in a formatted table.
 func PrintVisualPass(pass Pass, last bool) {
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start Azimuth", fmt.Sprintf("%f", pass.StartAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start Azimuth Compass", pass.StartAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start Elevation", fmt.Sprintf("%f", pass.StartEl))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start UTC", fmt.Sprintf("%d", pass.StartUTC))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Azimuth for Max Elevation", fmt.Sprintf("%f", pass.MaxAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Azimuth Compass for Max Elevation", pass.MaxAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Max Elevation", fmt.Sprintf("%f", pass.MaxEl))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Max UTC", fmt.Sprintf("%d", pass.MaxUTC))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End Azimuth", fmt.Sprintf("%f", pass.EndAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End Azimuth Compass", pass.EndAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End Elevation", fmt.Sprintf("%f", pass.EndEl))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End UTC", fmt.Sprintf("%d", pass.EndUTC))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Max Visual Magnitude", fmt.Sprintf("%f", pass.Mag))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Visible Duration", fmt.Sprintf("%d", pass.Duration))))
+	for _, line := range visualPassLines(pass, last) {
+		fmt.Println(line)
+	}
+}
+
+// visualPassLines returns the formatted table rows for a single visual pass.
+func visualPassLines(pass Pass, last bool) []string {
+	var lines []string
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start Azimuth", FormatQuantity(QuantityAngle, pass.StartAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start Azimuth Compass", pass.StartAzCompass)))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start Elevation", FormatQuantity(QuantityAngle, pass.StartEl))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start UTC", fmt.Sprintf("%d", pass.StartUTC))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Azimuth for Max Elevation", FormatQuantity(QuantityAngle, pass.MaxAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Azimuth Compass for Max Elevation", pass.MaxAzCompass)))
+	lines = append(lines, maxElevationRow(pass.MaxEl))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Max UTC", fmt.Sprintf("%d", pass.MaxUTC))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End Azimuth", FormatQuantity(QuantityAngle, pass.EndAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End Azimuth Compass", pass.EndAzCompass)))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End Elevation", FormatQuantity(QuantityAngle, pass.EndEl))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End UTC", fmt.Sprintf("%d", pass.EndUTC))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Max Visual Magnitude", fmt.Sprintf("%f", pass.Mag))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Visible Duration", fmt.Sprintf("%d", pass.Duration))))
 	if last {
-		fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+		lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 	} else {
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+		lines = append(lines, Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 	}
+	return lines
 }
 
 // PrintRadioPass displays radio pass information in a formatted table.
 func PrintRadioPass(pass RadioPass, last bool) {
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start Azimuth", fmt.Sprintf("%f", pass.StartAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start Azimuth Compass", pass.StartAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Start UTC", fmt.Sprintf("%d", pass.StartUTC))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Azimuth for Max Elevation", fmt.Sprintf("%f", pass.MaxAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Azimuth Compass for Max Elevation", pass.MaxAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Max Elevation", fmt.Sprintf("%f", pass.MaxEl))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Max UTC", fmt.Sprintf("%d", pass.MaxUTC))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End Azimuth", fmt.Sprintf("%f", pass.EndAz))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End Azimuth Compass", pass.EndAzCompass)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("End UTC", fmt.Sprintf("%d", pass.EndUTC))))
+	for _, line := range radioPassLines(pass, last) {
+		fmt.Println(line)
+	}
+}
+
+// radioPassLines returns the formatted table rows for a single radio pass.
+func radioPassLines(pass RadioPass, last bool) []string {
+	var lines []string
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start Azimuth", FormatQuantity(QuantityAngle, pass.StartAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start Azimuth Compass", pass.StartAzCompass)))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Start UTC", fmt.Sprintf("%d", pass.StartUTC))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Azimuth for Max Elevation", FormatQuantity(QuantityAngle, pass.MaxAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Azimuth Compass for Max Elevation", pass.MaxAzCompass)))
+	lines = append(lines, maxElevationRow(pass.MaxEl))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("Max UTC", fmt.Sprintf("%d", pass.MaxUTC))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End Azimuth", FormatQuantity(QuantityAngle, pass.EndAz))))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End Azimuth Compass", pass.EndAzCompass)))
+	lines = append(lines, Colorize(RoleHeader, GenRowString("End UTC", fmt.Sprintf("%d", pass.EndUTC))))
 	if last {
-		fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+		lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 	} else {
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+		lines = append(lines, Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 	}
+	return lines
 }
@@ -0,0 +1,70 @@
+package osint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TwiN/go-color"
+)
+
+func TestCompactVisualPassLine(t *testing.T) {
+	start := time.Date(2024, 3, 15, 21, 4, 30, 0, time.UTC)
+	pass := Pass{
+		StartUTC: int(start.Unix()),
+		MaxEl:    52.4,
+		Duration: 355,
+		Mag:      -1.24,
+	}
+
+	want := "#3  AOS 21:04  maxEl 52°  dur 6m  mag -1.2"
+	if got := compactVisualPassLine(3, pass); got != want {
+		t.Errorf("compactVisualPassLine() = %q, want %q", got, want)
+	}
+
+	pass.Duration = 45
+	pass.Mag = unknownMagnitude
+	want = "#1  AOS 21:04  maxEl 52°  dur 45s  mag n/a"
+	if got := compactVisualPassLine(1, pass); got != want {
+		t.Errorf("compactVisualPassLine() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatPassDuration(t *testing.T) {
+	tests := map[int]string{0: "0s", 59: "59s", 60: "1m", 89: "1m", 90: "2m", 600: "10m"}
+	for seconds, want := range tests {
+		if got := formatPassDuration(seconds); got != want {
+			t.Errorf("formatPassDuration(%d) = %q, want %q", seconds, got, want)
+		}
+	}
+}
+
+func TestElevationColor(t *testing.T) {
+	t.Cleanup(func() { SetTheme("default") })
+	SetTheme("default")
+
+	tests := []struct {
+		maxEl float64
+		want  string
+	}{
+		{5, color.Red},
+		{19.9, color.Red},
+		{20, color.Yellow},
+		{30, color.Yellow},
+		{45, color.Yellow},
+		{45.1, color.Green},
+		{89, color.Green},
+	}
+	for _, tt := range tests {
+		if got := elevationColor(tt.maxEl); got != tt.want {
+			t.Errorf("elevationColor(%v) = %q, want %q", tt.maxEl, got, tt.want)
+		}
+	}
+
+	SetTheme("mono")
+	if got := elevationColor(60); got != "" {
+		t.Errorf("elevationColor() in the mono theme = %q, want no color", got)
+	}
+	if row := maxElevationRow(60); row != GenRowString("Max Elevation", FormatQuantity(QuantityAngle, 60)) {
+		t.Errorf("maxElevationRow() in the mono theme = %q, want it uncolored", row)
+	}
+}
@@ -1,29 +1,90 @@
 package osint
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
-	"github.com/TwiN/go-color"
 	"github.com/manifoldco/promptui"
 )
 
-const (
+// authURL and queryBaseURL are the Space-Track login and query endpoints.
+var (
 	authURL      = "https://www.space-track.org/ajaxauth/login"
 	queryBaseURL = "https://www.space-track.org/basicspacedata/query"
 )
 
+// requestTimeout bounds each Space-Track request so a hung connection cannot block forever.
+var requestTimeout = 30 * time.Second
+
+// isTimeout reports whether err came from a request deadline or client timeout.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // Login authenticates with Space-Track API using credentials from environment variables.
-// Returns an HTTP client with a cookie jar to maintain the session.
+// Returns an HTTP client with a cookie jar to maintain the session. In interactive sessions a
+// rejected login offers to re-enter the credentials and retries once.
 func Login() (*http.Client, error) {
-	spinner := ShowLoginProgress()
+	return loginWithRetry(func() (*http.Client, error) {
+		return LoginContext(context.Background())
+	})
+}
+
+// loginWithRetry logs in with attempt, trying once more if the user re-enters rejected credentials.
+func loginWithRetry(attempt func() (*http.Client, error)) (*http.Client, error) {
+	client, err := attempt()
+	if err == nil || !offerCredentialRetry(err) {
+		return client, err
+	}
+	return attempt()
+}
+
+// ReenterCredentials prompts for new Space-Track credentials and sets them in the environment.
+// main provides it; when nil, a rejected login fails without offering a retry.
+var ReenterCredentials func()
+
+// offerCredentialRetry reports whether err is a rejected login and the user re-entered their
+// credentials to try again. Non-interactive runs never prompt and fail fast.
+func offerCredentialRetry(err error) bool {
+	var appErr *AppError
+	if NonInteractive || ReenterCredentials == nil || !errors.As(err, &appErr) || appErr.Code != ErrCodeAuthFailed {
+		return false
+	}
+	fmt.Println(Colorize(RoleError, "  [!] ERROR: Space-Track rejected your credentials"))
+	if !confirm("Re-enter Space-Track credentials and try again?", true) {
+		return false
+	}
+	ReenterCredentials()
+	return true
+}
+
+// LoginContext is Login bounded by ctx and requestTimeout. Cancelling ctx aborts the request
+// and stops the progress spinner.
+func LoginContext(ctx context.Context) (*http.Client, error) {
+	if Offline {
+		return nil, NewAppError(ErrCodeAuthCredentials, "Space-Track is unavailable in offline mode")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	spinner := ShowLoginProgressContext(ctx)
 	defer spinner.Stop()
 
 	vals := url.Values{}
@@ -35,74 +96,149 @@ func Login() (*http.Client, error) {
 		return nil, NewAppErrorWithErr(ErrCodeAuthCookieJar, "Failed to create cookie jar for authentication", err)
 	}
 
-	client := &http.Client{
-		Jar: jar,
+	client := NewHTTPClient(requestTimeout)
+	client.Jar = jar
+
+	req, err := http.NewRequestWithContext(ctx, "POST", authURL, strings.NewReader(vals.Encode()))
+	if err != nil {
+		return nil, NewAppErrorWithErr(ErrCodeAuthConnection, "Failed to create login request", err)
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := client.PostForm(authURL, vals)
+	resp, err := client.Do(req)
 	if err != nil {
+		if isTimeout(err) {
+			return nil, NewAppErrorWithErr(ErrCodeNetworkTimeout, "Space-Track login timed out", err)
+		}
 		return nil, NewAppErrorWithErr(ErrCodeAuthConnection, "Unable to connect to Space-Track API", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		username := os.Getenv("SPACE_TRACK_USERNAME")
-		context := fmt.Sprintf("Status code: %d, Username: %s", resp.StatusCode, username)
-		return nil, NewAppErrorWithContext(ErrCodeAuthFailed, "Authentication failed with Space-Track API", context)
+		errContext := fmt.Sprintf("Status code: %d, Username: %s", resp.StatusCode, username)
+		return nil, NewAppErrorWithContext(ErrCodeAuthFailed, "Authentication failed with Space-Track API", errContext)
 	}
 
 	spinner.Stop()
-	fmt.Println(color.Ize(color.Green, "  [+] Logged in successfully"))
+	fmt.Println(Colorize(RoleSuccess, "  [+] Logged in successfully"))
 	return client, nil
 }
 
 // QuerySpaceTrack sends a GET request to the Space-Track API using the authenticated client.
 // Returns the response body as a string.
 func QuerySpaceTrack(client *http.Client, endpoint string) (string, error) {
-	spinner := ShowQueryProgress(endpoint)
+	return QuerySpaceTrackContext(context.Background(), client, endpoint)
+}
+
+// QuerySpaceTrackContext is QuerySpaceTrack bounded by ctx and requestTimeout. Cancelling ctx
+// aborts the request and stops the progress spinner.
+func QuerySpaceTrackContext(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	spinner := ShowQueryProgressContext(ctx, endpoint)
 	defer spinner.Stop()
 
-	req, err := http.NewRequest("GET", queryBaseURL+endpoint, nil)
+	body, err := openSpaceTrackQuery(ctx, client, endpoint)
 	if err != nil {
-		spinner.Stop()
-		return "", fmt.Errorf("failed to create query request: %w", err)
+		return "", err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	return string(data), nil
+}
+
+// openSpaceTrackQuery sends a Space-Track query and returns the response body unread, so large
+// results can be decoded as they arrive. The caller must close it.
+func openSpaceTrackQuery(ctx context.Context, client *http.Client, endpoint string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", queryBaseURL+endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query request: %w", err)
 	}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		spinner.Stop()
-		return "", fmt.Errorf("failed to fetch data from Space-Track: %w", err)
+		if isTimeout(err) {
+			return nil, NewAppErrorWithErr(ErrCodeNetworkTimeout, "Space-Track query timed out", err)
+		}
+		return nil, fmt.Errorf("failed to fetch data from Space-Track: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		spinner.Stop()
-		return "", fmt.Errorf("query returned non-success status code: %d", resp.StatusCode)
+		resp.Body.Close()
+		return nil, fmt.Errorf("query returned non-success status code: %d", resp.StatusCode)
 	}
+	return resp.Body, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		spinner.Stop()
-		return "", fmt.Errorf("failed to read response body: %w", err)
+// spaceTrackErrorEnvelope is the JSON object Space-Track sends instead of the requested array
+// when it refuses a query, sometimes with a 200 status.
+type spaceTrackErrorEnvelope struct {
+	Error string `json:"error"`
+}
+
+// envelopeError turns an error envelope into an AppError, or returns nil when there is no
+// error message.
+func (e spaceTrackErrorEnvelope) envelopeError() error {
+	if strings.TrimSpace(e.Error) == "" {
+		return nil
 	}
-	spinner.Stop()
-	return string(body), nil
+	return NewAppErrorWithContext(ErrCodeAPIResponseFailed, "Space-Track returned an error: "+strings.TrimSpace(e.Error), "The query was answered with an error instead of data")
+}
+
+// decodeSpaceTrackJSON unmarshals a Space-Track query response into v. A Space-Track error
+// envelope is reported as an AppError with its message rather than as a confusing parse failure.
+func decodeSpaceTrackJSON(data string, v any) error {
+	if trimmed := strings.TrimSpace(data); strings.HasPrefix(trimmed, "{") {
+		var envelope spaceTrackErrorEnvelope
+		if json.Unmarshal([]byte(trimmed), &envelope) == nil {
+			if err := envelope.envelopeError(); err != nil {
+				return err
+			}
+		}
+	}
+	return json.Unmarshal([]byte(data), v)
 }
 
 // extractNorad extracts the NORAD ID from a string in the format "Name (NORAD_ID)".
 func extractNorad(str string) string {
-	start := strings.Index(str, "(")
-	end := strings.Index(str, ")")
+	_, norad := splitSatelliteLabel(str)
+	return norad
+}
+
+// splitSatelliteLabel splits a "Name (NORAD_ID)" menu label into the name and the NORAD ID. The ID
+// is taken from the last parentheses, so names like "ISS (ZARYA) (25544)" keep their own.
+func splitSatelliteLabel(label string) (string, string) {
+	start := strings.LastIndex(label, "(")
+	end := strings.LastIndex(label, ")")
 	if start == -1 || end == -1 || start >= end {
-		return ""
+		return strings.TrimSpace(label), ""
 	}
-	return str[start+1 : end]
+	return strings.TrimSpace(label[:start]), label[start+1 : end]
 }
 
-// PrintNORADInfo fetches and displays TLE data for a satellite identified by its NORAD ID.
+// PrintNORADInfo fetches and displays TLE data for a satellite identified by its NORAD ID, from
+// Space-Track or CelesTrak as chosen.
 func PrintNORADInfo(norad string, name string) {
+	provider, ok := chooseTLEProvider()
+	if !ok {
+		return
+	}
+	if provider == TLEProviderCelesTrak {
+		printCelesTrakTLE(norad, name)
+		return
+	}
+
 	client, err := Login()
 	if err != nil {
+		if printCachedTLE(norad, name, err) {
+			return
+		}
 		HandleError(err, ErrCodeAuthFailed, "Failed to authenticate with Space-Track")
 		return
 	}
@@ -110,6 +246,9 @@ func PrintNORADInfo(norad string, name string) {
 	endpoint := fmt.Sprintf("/class/gp_history/format/tle/NORAD_CAT_ID/%s/orderby/EPOCH%%20desc/limit/1", norad)
 	data, err := QuerySpaceTrack(client, endpoint)
 	if err != nil {
+		if printCachedTLE(norad, name, err) {
+			return
+		}
 		context := fmt.Sprintf("NORAD ID: %s, Satellite: %s", norad, name)
 		HandleErrorWithContext(err, ErrCodeAPINoData, "Failed to fetch TLE data for satellite", context)
 		return
@@ -209,9 +348,55 @@ func PrintNORADInfo(norad string, name string) {
 		return
 	}
 
-	PrintTLE(tle)
+	storeCachedTLE(norad, lineOne, lineTwo)
+	warnTLEChecksum(lineOne, lineTwo)
+	PrintTLEWithRawLines(tle, lineOne, lineTwo)
+	printAliases(client, norad)
+
+	if confirm("Check recent element sets for maneuvers?", false) {
+		reportManeuvers(client, norad, name)
+	}
+	if confirm("Compare with the previous element set?", false) {
+		compareLastTwoTLEs(client, norad)
+	}
+	if confirm("Show how mean motion and eccentricity evolved over a date range?", false) {
+		reportElementTrend(client, norad, name)
+	}
+	if confirm("Download the element set history for a date range?", false) {
+		downloadTLEHistory(client, norad, name)
+	}
+}
+
+// printCachedTLE shows the cached TLE for a satellite, labelled as stale, when err is a network
+// failure. It returns false when there is nothing to fall back to.
+func printCachedTLE(norad, name string, err error) bool {
+	if !isNetworkFailure(err) {
+		return false
+	}
+	cached, found := lookupCachedTLE(norad)
+	if !found {
+		return false
+	}
+	fmt.Println(Colorize(RoleWarning, "  [!] "+cached.StaleNotice()))
+	PrintTLEWithRawLines(ConstructTLE(name, cached.Line1, cached.Line2), cached.Line1, cached.Line2)
+	return true
 }
 
+// ExcludeDebrisByDefault hides DEBRIS and ROCKET BODY objects from catalog browsing
+// unless an object type filter is chosen.
+var ExcludeDebrisByDefault = true
+
+// IncludeDecayed also lists objects that have reentered. By default catalog searches only show
+// objects still in orbit.
+var IncludeDecayed = false
+
+// NameSearchLimit is how many catalog entries a name search fetches to filter client-side.
+// Common names such as "STARLINK" can match more objects than this.
+var NameSearchLimit = 500
+
+// nonDebrisObjectTypes are the object types browsed when debris is excluded.
+var nonDebrisObjectTypes = []string{"PAYLOAD", "UNKNOWN", "TBA"}
+
 // buildSatcatQuery constructs a Space-Track API query string with optional filters and pagination.
 // Note: Space-Track API uses path segments for filtering. For name search, we'll filter client-side.
 func buildSatcatQuery(searchName, country, objectType, launchYear string, page, pageSize int) string {
@@ -219,15 +404,7 @@ func buildSatcatQuery(searchName, country, objectType, launchYear string, page,
 	parts = append(parts, "/class/satcat")
 
 	// Add filters (name search is handled client-side for partial matching)
-	if country != "" {
-		parts = append(parts, fmt.Sprintf("/COUNTRY/%s", url.QueryEscape(country)))
-	}
-	if objectType != "" {
-		parts = append(parts, fmt.Sprintf("/OBJECT_TYPE/%s", url.QueryEscape(objectType)))
-	}
-	if launchYear != "" {
-		parts = append(parts, fmt.Sprintf("/LAUNCH_YEAR/%s", url.QueryEscape(launchYear)))
-	}
+	parts = append(parts, satcatFilters(country, objectType, launchYear)...)
 
 	// Add ordering
 	parts = append(parts, "/orderby/SATNAME%20asc")
@@ -236,7 +413,7 @@ func buildSatcatQuery(searchName, country, objectType, launchYear string, page,
 	// Otherwise use normal pagination
 	if searchName != "" {
 		// Fetch more results for client-side filtering
-		parts = append(parts, "/limit/500")
+		parts = append(parts, fmt.Sprintf("/limit/%d", NameSearchLimit))
 	} else if pageSize > 0 {
 		offset := (page - 1) * pageSize
 		parts = append(parts, fmt.Sprintf("/limit/%d,%d", pageSize, offset))
@@ -248,21 +425,73 @@ func buildSatcatQuery(searchName, country, objectType, launchYear string, page,
 	return strings.Join(parts, "")
 }
 
+// buildSatcatIDQuery constructs a query for the catalog entries with the given NORAD IDs that pass
+// the same filters as buildSatcatQuery.
+func buildSatcatIDQuery(ids []string, country, objectType, launchYear string) string {
+	parts := []string{"/class/satcat/NORAD_CAT_ID/" + strings.Join(ids, ",")}
+	parts = append(parts, satcatFilters(country, objectType, launchYear)...)
+	parts = append(parts, "/orderby/SATNAME%20asc", "/emptyresult/show")
+	return strings.Join(parts, "")
+}
+
+// satcatFilters returns the path segments for the catalog filters, including the default debris
+// and decay exclusions.
+func satcatFilters(country, objectType, launchYear string) []string {
+	var parts []string
+	if country != "" {
+		parts = append(parts, fmt.Sprintf("/COUNTRY/%s", url.QueryEscape(country)))
+	}
+	if objectType != "" {
+		parts = append(parts, fmt.Sprintf("/OBJECT_TYPE/%s", url.QueryEscape(objectType)))
+	} else if ExcludeDebrisByDefault {
+		parts = append(parts, "/OBJECT_TYPE/"+strings.Join(nonDebrisObjectTypes, ","))
+	}
+	if launchYear != "" {
+		parts = append(parts, fmt.Sprintf("/LAUNCH_YEAR/%s", url.QueryEscape(launchYear)))
+	}
+	if !IncludeDecayed {
+		// Objects still in orbit have no decay date
+		parts = append(parts, "/DECAY/null-val")
+	}
+	return parts
+}
+
+// searchLimitWarning explains that a name search fetched limit catalog entries and so may have
+// missed matches. It returns "" when fewer entries came back.
+func searchLimitWarning(fetched, limit int) string {
+	if fetched < limit {
+		return ""
+	}
+	return fmt.Sprintf("  [!] Search stopped at the %d-entry catalog limit; more matches may exist. Narrow the search or raise name_search_limit.", limit)
+}
+
 // filterSatellitesByName filters satellites by name (case-insensitive partial match).
 func filterSatellitesByName(sats []Satellite, searchName string) []Satellite {
 	if searchName == "" {
 		return sats
 	}
-	searchLower := strings.ToLower(searchName)
 	var filtered []Satellite
 	for _, sat := range sats {
-		if strings.Contains(strings.ToLower(sat.SATNAME), searchLower) {
+		if satelliteNameMatches(sat, searchName) {
 			filtered = append(filtered, sat)
 		}
 	}
 	return filtered
 }
 
+// satelliteNameMatches reports whether the satellite name contains searchName, ignoring case.
+func satelliteNameMatches(sat Satellite, searchName string) bool {
+	return strings.Contains(strings.ToLower(sat.SATNAME), strings.ToLower(searchName))
+}
+
+// decayedMenuLabel names the search menu entry that toggles decayed objects, with its state.
+func decayedMenuLabel() string {
+	if IncludeDecayed {
+		return "Include Decayed Objects: Yes"
+	}
+	return "Include Decayed Objects: No"
+}
+
 // showSearchMenu displays an interactive menu for searching satellites.
 func showSearchMenu() (string, string, string, string) {
 	searchName := ""
@@ -276,6 +505,7 @@ func showSearchMenu() (string, string, string, string) {
 			"Filter by Country",
 			"Filter by Object Type",
 			"Filter by Launch Year",
+			decayedMenuLabel(),
 			"Clear All Filters",
 			"Search & Continue",
 		}
@@ -343,20 +573,24 @@ func showSearchMenu() (string, string, string, string) {
 				launchYear = strings.TrimSpace(result)
 			}
 
-		case 4: // Clear All Filters
+		case 4: // Include Decayed Objects
+			IncludeDecayed = !IncludeDecayed
+
+		case 5: // Clear All Filters
 			searchName = ""
 			country = ""
 			objectType = ""
 			launchYear = ""
-			fmt.Println(color.Ize(color.Green, "  [+] All filters cleared"))
+			IncludeDecayed = false
+			fmt.Println(Colorize(RoleSuccess, "  [+] All filters cleared"))
 
-		case 5: // Search & Continue
+		case 6: // Search & Continue
 			return searchName, country, objectType, launchYear
 		}
 
 		// Show current filters
 		if searchName != "" || country != "" || objectType != "" || launchYear != "" {
-			fmt.Println(color.Ize(color.Cyan, "\n  Current Filters:"))
+			fmt.Println(Colorize(RoleInfo, "\n  Current Filters:"))
 			if searchName != "" {
 				fmt.Printf("    Name: %s\n", searchName)
 			}
@@ -371,29 +605,61 @@ func showSearchMenu() (string, string, string, string) {
 			}
 			fmt.Println()
 		}
+		if objectType == "" && ExcludeDebrisByDefault {
+			fmt.Println(Colorize(RoleMuted, "  Debris and rocket bodies are hidden, choose an object type to include them"))
+		}
+		if !IncludeDecayed {
+			fmt.Println(Colorize(RoleMuted, "  Decayed objects are hidden, include them from this menu"))
+		}
 	}
 }
 
 // SelectSatellite fetches a list of satellites from Space-Track with search, filter, and pagination support.
 // Returns the selected satellite name with its NORAD ID in parentheses.
 func SelectSatellite() string {
-	// First, show option to select from favorites or search
-	initialMenu := []string{
+	// Recently viewed satellites come first for quick access
+	recent, _ := LoadRecent()
+	var initialMenu []string
+	for _, entry := range recent {
+		initialMenu = append(initialMenu, "🕘 Recent: "+entry.Label())
+	}
+
+	// Then the option to select from favorites or search
+	initialMenu = append(initialMenu,
 		"⭐ Select from Favorites",
 		"🔍 Search Satellites",
-		"❌ Cancel",
+		"🔄 Refresh Catalog",
+	)
+
+	// Offer to pick up a previously saved browse position
+	cursor, _ := LoadBrowseCursor()
+	if cursor != nil {
+		initialMenu = append(initialMenu, fmt.Sprintf("⏯ Resume Previous Browse (%s)", cursor.Describe()))
 	}
+	initialMenu = append(initialMenu, "❌ Cancel")
 
 	initialPrompt := promptui.Select{
 		Label: "Satellite Selection",
 		Items: initialMenu,
+		Size:  15,
 	}
 
 	initialIdx, _, err := initialPrompt.Run()
 	if err != nil {
 		return ""
 	}
+	if initialIdx < len(recent) {
+		return recent[initialIdx].Label()
+	}
+	initialIdx -= len(recent)
 
+	resume := cursor != nil && initialIdx == 3
+	if initialIdx == 2 {
+		// Search as usual, but fetch every catalog query from Space-Track again
+		if err := ClearCatalogCache(); err != nil {
+			fmt.Println(Colorize(RoleWarning, "  [!] "+err.Error()))
+		}
+	}
 	if initialIdx == 0 {
 		// Select from favorites
 		result := SelectFromFavorites()
@@ -402,95 +668,169 @@ func SelectSatellite() string {
 			return result
 		}
 		return ""
-	} else if initialIdx == 2 {
+	} else if initialIdx == len(initialMenu)-len(recent)-1 {
 		// Cancel
 		return ""
 	}
 
-	// Continue with search
-	client, err := Login()
-	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: "+err.Error()))
-		return ""
+	// Continue with search, against the local catalog mirror when asked to or when Space-Track
+	// cannot be reached
+	var session *spaceTrackSession
+	var mirror *CatalogMirror
+	if UseLocalCatalog || Offline {
+		if mirror = loadMirrorForSearch(); mirror == nil {
+			return ""
+		}
+	} else {
+		// Logging in waits for a query the catalog cache cannot answer
+		session = newSpaceTrackSession()
 	}
 
-	// Show search/filter menu
-	searchName, country, objectType, launchYear := showSearchMenu()
-
+	var searchName, country, objectType, launchYear string
 	page := 1
+	if resume {
+		searchName, country, objectType, launchYear = cursor.SearchName, cursor.Country, cursor.ObjectType, cursor.LaunchYear
+		page = cursor.Page
+	} else {
+		// Show search/filter menu
+		searchName, country, objectType, launchYear = showSearchMenu()
+	}
+
 	pageSize := 20
-	var allFilteredSats []Satellite
 	var totalPages int
 
+	// Name searches are filtered client-side from a large catalog query. The response is streamed
+	// so the first page can be shown while the rest is still loading.
+	var stream *catalogStream
+	// renamed maps satellites found by a former name to that name
+	var renamed map[string]string
+	defer func() {
+		if stream != nil {
+			stream.Stop()
+		}
+	}()
+
+	// searchMirrorInstead switches to the local catalog mirror after a failed login, reporting
+	// whether there is one
+	searchMirrorInstead := func(err error) bool {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		if mirror, _ = LoadCatalogMirror(); mirror == nil {
+			return false
+		}
+		fmt.Println(Colorize(RoleInfo, "  [*] Searching the local catalog mirror instead"))
+		if stream != nil {
+			stream.Stop()
+			stream = nil
+		}
+		return true
+	}
+
+	var mirrorResults []Satellite
+	mirrorSearched := false
+	limitWarned := false
+
 	for {
 		var sats []Satellite
+		hasNextPage := false
+		loading := false
+
+		if mirror != nil {
+			if !mirrorSearched {
+				mirrorResults = mirror.Search(searchName, country, objectType, launchYear)
+				mirrorSearched = true
+			}
+			totalPages = (len(mirrorResults) + pageSize - 1) / pageSize
+			if page > totalPages && totalPages > 0 {
+				page = totalPages
+			}
+			sats = pageOf(mirrorResults, page, pageSize)
+			hasNextPage = page < totalPages
+		} else if searchName != "" {
+			if stream == nil {
+				limitWarned = false
+				name := searchName
+				formerNames, _ := findRenamedSatellites(session, searchName)
+				renamed = formerNames
+				// The name query stops at NameSearchLimit entries sorted by current name, so
+				// renamed objects are fetched by ID rather than hoped for in it
+				renamedSats, _ := fetchRenamedSatellites(session, formerNames, country, objectType, launchYear)
+				listed := make(map[string]bool, len(renamedSats))
+				for _, sat := range renamedSats {
+					listed[sat.NORAD_CAT_ID] = true
+				}
+				endpoint := buildSatcatQuery(searchName, country, objectType, launchYear, 1, 0)
+				stream = startCachedCatalogStream(session, endpoint, func(sat Satellite) bool {
+					_, wasNamed := formerNames[sat.NORAD_CAT_ID]
+					return !listed[sat.NORAD_CAT_ID] && (wasNamed || satelliteNameMatches(sat, name))
+				})
+				stream.seed(renamedSats)
+			}
 
-		// If we have name search, we need to fetch all and filter client-side
-		// Cache the filtered results to avoid refetching
-		if searchName != "" && len(allFilteredSats) == 0 {
-			// Fetch a larger batch for client-side filtering
+			// Wait for one match past this page so we know whether there is a next page
 			spinner := ShowProgressWithSpinner("Searching satellite catalog")
-			endpoint := buildSatcatQuery(searchName, country, objectType, launchYear, 1, 0)
-			data, err := QuerySpaceTrack(client, endpoint)
+			loaded, done, err := stream.waitFor(page*pageSize + 1)
 			spinner.Stop()
 			if err != nil {
+				if session.loginFailed() {
+					if searchMirrorInstead(err) {
+						continue
+					}
+					return ""
+				}
 				context := fmt.Sprintf("Search: %s, Country: %s, Object Type: %s, Launch Year: %s", searchName, country, objectType, launchYear)
 				HandleErrorWithContext(err, ErrCodeAPINoData, "Failed to fetch satellite catalog", context)
 				return ""
 			}
-
-			var fetchedSats []Satellite
-			if err := json.Unmarshal([]byte(data), &fetchedSats); err != nil {
-				context := fmt.Sprintf("Search: %s, Response length: %d bytes", searchName, len(data))
-				HandleErrorWithContext(err, ErrCodeAPIParseFailed, "Failed to parse satellite catalog data", context)
-				return ""
-			}
-
-			// Apply client-side name filtering
-			allFilteredSats = filterSatellitesByName(fetchedSats, searchName)
-			totalPages = (len(allFilteredSats) + pageSize - 1) / pageSize
-
-			// Apply pagination
-			startIdx := (page - 1) * pageSize
-			endIdx := startIdx + pageSize
-			if endIdx > len(allFilteredSats) {
-				endIdx = len(allFilteredSats)
-			}
-			if startIdx < len(allFilteredSats) {
-				sats = allFilteredSats[startIdx:endIdx]
-			} else {
-				sats = []Satellite{}
-			}
-		} else if searchName != "" {
-			// Use cached filtered results with pagination
-			startIdx := (page - 1) * pageSize
-			endIdx := startIdx + pageSize
-			if endIdx > len(allFilteredSats) {
-				endIdx = len(allFilteredSats)
+			if done && !limitWarned {
+				limitWarned = true
+				if warning := searchLimitWarning(stream.fetched(), NameSearchLimit); warning != "" {
+					fmt.Println(Colorize(RoleWarning, warning))
+				}
 			}
-			if startIdx < len(allFilteredSats) {
-				sats = allFilteredSats[startIdx:endIdx]
-			} else {
-				sats = []Satellite{}
+			if done {
+				totalPages = (len(loaded) + pageSize - 1) / pageSize
+				if page > totalPages && totalPages > 0 {
+					// A resumed page may no longer exist if the catalog changed
+					page = totalPages
+				}
 			}
+			loading = !done
+			sats = pageOf(loaded, page, pageSize)
+			hasNextPage = len(loaded) > page*pageSize
 		} else {
 			// No name search - use server-side pagination
 			spinner := ShowProgressWithSpinner("Loading satellite catalog")
 			endpoint := buildSatcatQuery(searchName, country, objectType, launchYear, page, pageSize)
-			data, err := QuerySpaceTrack(client, endpoint)
+			data, cachedAt, err := queryCatalog(session, endpoint)
 			spinner.Stop()
+			if !cachedAt.IsZero() && page == 1 {
+				fmt.Println(Colorize(RoleInfo, catalogCacheNotice(cachedAt)))
+			}
 			if err != nil {
+				if session.loginFailed() {
+					if searchMirrorInstead(err) {
+						continue
+					}
+					return ""
+				}
 				context := fmt.Sprintf("Page: %d, Country: %s, Object Type: %s", page, country, objectType)
 				HandleErrorWithContext(err, ErrCodeAPINoData, "Failed to fetch satellite catalog", context)
 				return ""
 			}
 
-			if err := json.Unmarshal([]byte(data), &sats); err != nil {
+			if err := decodeSpaceTrackJSON(data, &sats); err != nil {
 				context := fmt.Sprintf("Page: %d, Response length: %d bytes", page, len(data))
 				HandleErrorWithContext(err, ErrCodeAPIParseFailed, "Failed to parse satellite catalog data", context)
 				return ""
 			}
 			totalPages = 0 // Unknown for server-side pagination
+			hasNextPage = len(sats) == pageSize
+		}
+
+		if len(sats) == 0 && page > 1 && searchName == "" {
+			// A resumed page may be past the end of the catalog; start over from the first page
+			page = 1
+			continue
 		}
 
 		if len(sats) == 0 {
@@ -503,28 +843,33 @@ func SelectSatellite() string {
 			return ""
 		}
 
+		// Remember where we are so the browse can be resumed later
+		SaveBrowseCursor(BrowseCursor{
+			SearchName: searchName,
+			Country:    country,
+			ObjectType: objectType,
+			LaunchYear: launchYear,
+			Page:       page,
+		})
+
 		// Build display strings with additional info
 		var satStrings []string
 		for _, sat := range sats {
-			info := fmt.Sprintf("%s (%s)", sat.SATNAME, sat.NORAD_CAT_ID)
+			suffix := ""
 			if sat.COUNTRY != "" {
-				info += fmt.Sprintf(" - %s", sat.COUNTRY)
+				suffix += fmt.Sprintf(" - %s", sat.COUNTRY)
 			}
 			if sat.OBJECT_TYPE != "" {
-				info += fmt.Sprintf(" [%s]", sat.OBJECT_TYPE)
+				suffix += fmt.Sprintf(" [%s]", sat.OBJECT_TYPE)
 			}
-			satStrings = append(satStrings, info)
+			if formerName, ok := renamed[sat.NORAD_CAT_ID]; ok && !satelliteNameMatches(sat, searchName) {
+				suffix += fmt.Sprintf(" (formerly %s)", formerName)
+			}
+			satStrings = append(satStrings, menuLabel(sat.SATNAME, sat.NORAD_CAT_ID, suffix, terminalWidth()-menuCursorWidth))
 		}
 
 		// Add navigation options
 		var menuItems []string
-		hasNextPage := false
-		if searchName != "" {
-			hasNextPage = page < totalPages
-		} else {
-			hasNextPage = len(sats) == pageSize
-		}
-
 		if page > 1 {
 			menuItems = append(menuItems, "◄ Previous Page")
 		}
@@ -532,11 +877,17 @@ func SelectSatellite() string {
 		if hasNextPage {
 			menuItems = append(menuItems, "Next Page ►")
 		}
+		if !PromptToSaveFavorites {
+			// Without the prompt after selection, favorites are saved from here
+			menuItems = append(menuItems, "☆ Save to Favorites")
+		}
 		menuItems = append(menuItems, "⭐ View Favorites", "🔍 New Search", "❌ Cancel")
 
 		pageInfo := fmt.Sprintf("Page %d", page)
 		if searchName != "" && totalPages > 0 {
 			pageInfo += fmt.Sprintf(" of %d", totalPages)
+		} else if loading {
+			pageInfo += " (still loading)"
 		}
 		if len(sats) == pageSize && hasNextPage {
 			pageInfo += " (showing 20 results)"
@@ -552,7 +903,7 @@ func SelectSatellite() string {
 
 		idx, _, err := prompt.Run()
 		if err != nil {
-			fmt.Println(color.Ize(color.Red, "  [!] PROMPT FAILED"))
+			fmt.Println(Colorize(RoleError, "  [!] PROMPT FAILED"))
 			return ""
 		}
 
@@ -574,24 +925,13 @@ func SelectSatellite() string {
 			selectedSat := sats[selectedIdx]
 			result := fmt.Sprintf("%s (%s)", selectedSat.SATNAME, selectedSat.NORAD_CAT_ID)
 
-			// Check if already in favorites and offer to save/remove
-			isFav, _ := IsFavorite(selectedSat.NORAD_CAT_ID)
-			if !isFav {
-				savePrompt := promptui.Prompt{
-					Label:     fmt.Sprintf("Save %s to favorites? (y/n)", selectedSat.SATNAME),
-					Default:   "n",
-					AllowEdit: true,
-				}
-				saveAnswer, _ := savePrompt.Run()
-				if strings.ToLower(strings.TrimSpace(saveAnswer)) == "y" {
-					if err := AddFavorite(selectedSat.SATNAME, selectedSat.NORAD_CAT_ID, selectedSat.COUNTRY, selectedSat.OBJECT_TYPE); err != nil {
-						fmt.Println(color.Ize(color.Yellow, "  [!] "+err.Error()))
-					} else {
-						fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Saved %s to favorites", selectedSat.SATNAME)))
-					}
+			if resume {
+				// The resumed browse has found what it was looking for
+				if err := clearBrowseCursor(); err != nil {
+					fmt.Println(Colorize(RoleWarning, "  [!] "+err.Error()))
 				}
 			}
-
+			offerToSaveFavorite(selectedSat)
 			return result
 		}
 
@@ -606,6 +946,14 @@ func SelectSatellite() string {
 		if hasNextPage {
 			favoritesIdx++
 		}
+		if !PromptToSaveFavorites {
+			if idx == favoritesIdx {
+				// Save to Favorites, then keep showing the current page
+				saveFavoriteFromPage(sats, satStrings)
+				continue
+			}
+			favoritesIdx++
+		}
 		newSearchIdx := favoritesIdx + 1
 
 		if idx == favoritesIdx {
@@ -619,8 +967,13 @@ func SelectSatellite() string {
 		}
 
 		if idx == newSearchIdx || (idx == favoritesIdx && !hasNextPage) {
-			// New Search - reset cache
-			allFilteredSats = []Satellite{}
+			// New Search - drop the previous results
+			if stream != nil {
+				stream.Stop()
+				stream = nil
+			}
+			mirrorSearched = false
+			renamed = nil
 			searchName, country, objectType, launchYear = showSearchMenu()
 			page = 1
 			totalPages = 0
@@ -632,6 +985,61 @@ func SelectSatellite() string {
 	}
 }
 
+// pageOf returns the satellites on a 1-based page.
+func pageOf(sats []Satellite, page, pageSize int) []Satellite {
+	start := (page - 1) * pageSize
+	if start >= len(sats) {
+		return []Satellite{}
+	}
+	return sats[start:min(start+pageSize, len(sats))]
+}
+
+// loadMirrorForSearch loads the local catalog mirror, explaining how to build one if it is missing.
+func loadMirrorForSearch() *CatalogMirror {
+	mirror, err := LoadCatalogMirror()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return nil
+	}
+	if mirror == nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] No local catalog mirror found"))
+		fmt.Println(Colorize(RoleInfo, "  [*] Build one online with: SatIntel mirror-catalog [country=US] [type=PAYLOAD] [year=2020]"))
+		return nil
+	}
+	fmt.Println(Colorize(RoleInfo, "  [*] Searching local catalog mirror: "+mirror.Describe()))
+	return mirror
+}
+
+// menuCursorWidth is the room promptui's selection cursor takes before each menu item.
+const menuCursorWidth = 4
+
+// minMenuNameWidth is the narrowest a name is clipped to before the suffix is dropped instead.
+const minMenuNameWidth = 12
+
+// menuLabel builds a "Name (NORAD) suffix" menu item that fits in width columns. Long names are
+// clipped with an ellipsis and the suffix is dropped if needed, but the NORAD ID is always kept.
+func menuLabel(name, norad, suffix string, width int) string {
+	id := " (" + norad + ")"
+	label := name + id + suffix
+	if utf8.RuneCountInString(label) <= width {
+		return label
+	}
+
+	available := width - utf8.RuneCountInString(id) - utf8.RuneCountInString(suffix)
+	if available < minMenuNameWidth {
+		suffix = ""
+		available = width - utf8.RuneCountInString(id)
+		if utf8.RuneCountInString(name) <= available {
+			return name + id
+		}
+	}
+	if available < 2 {
+		available = 2
+	}
+	runes := []rune(name)
+	return string(runes[:available-1]) + "…" + id + suffix
+}
+
 // GenRowString formats a key-value pair into a table row with proper spacing.
 func GenRowString(intro string, input string) string {
 	var totalCount int = 4 + len(intro) + len(input) + 2
@@ -647,17 +1055,18 @@ func Option(min int, max int) int {
 	fmt.Scanln(&selection)
 	num, err := strconv.Atoi(selection)
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] INVALID INPUT"))
+		fmt.Println(Colorize(RoleError, "  [!] INVALID INPUT"))
 		return Option(min, max)
 	} else {
 		if num == min {
-			fmt.Println(color.Ize(color.Blue, " Escaping Orbit..."))
-			os.Exit(1)
+			PrintN2YOUsageSummary()
+			fmt.Println(Colorize(RoleAccent, " Escaping Orbit..."))
+			Exit(ExitSuccess)
 			return 0
 		} else if num > min && num < max+1 {
 			return num
 		} else {
-			fmt.Println(color.Ize(color.Red, "  [!] INVALID INPUT"))
+			fmt.Println(Colorize(RoleError, "  [!] INVALID INPUT"))
 			return Option(min, max)
 		}
 	}
@@ -1,8 +1,11 @@
 package osint
 
 import (
+	"io"
+	"net/http"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestExtractNorad(t *testing.T) {
@@ -14,7 +17,7 @@ func TestExtractNorad(t *testing.T) {
 		{
 			name:     "Valid format with parentheses",
 			input:    "ISS (ZARYA) (25544)",
-			expected: "ZARYA",
+			expected: "25544",
 		},
 		{
 			name:     "Simple format",
@@ -22,8 +25,8 @@ func TestExtractNorad(t *testing.T) {
 			expected: "12345",
 		},
 		{
-			name:     "Multiple parentheses - takes first",
-			input:    "Name (NORAD_ID) (extra)",
+			name:     "Multiple parentheses - takes last",
+			input:    "Name (extra) (NORAD_ID)",
 			expected: "NORAD_ID",
 		},
 		{
@@ -63,6 +66,25 @@ func TestExtractNorad(t *testing.T) {
 	}
 }
 
+func TestSplitSatelliteLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		name  string
+		norad string
+	}{
+		{"ISS (ZARYA) (25544)", "ISS (ZARYA)", "25544"},
+		{"HST (20580)", "HST", "20580"},
+		{"NOAA 19", "NOAA 19", ""},
+	}
+
+	for _, tt := range tests {
+		name, norad := splitSatelliteLabel(tt.label)
+		if name != tt.name || norad != tt.norad {
+			t.Errorf("splitSatelliteLabel(%q) = %q, %q, want %q, %q", tt.label, name, norad, tt.name, tt.norad)
+		}
+	}
+}
+
 func TestGenRowString(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -244,6 +266,48 @@ func TestBuildSatcatQuery(t *testing.T) {
 	}
 }
 
+func TestBuildSatcatQuery_DebrisExclusion(t *testing.T) {
+	original := ExcludeDebrisByDefault
+	t.Cleanup(func() { ExcludeDebrisByDefault = original })
+	ExcludeDebrisByDefault = true
+
+	defaultQuery := buildSatcatQuery("", "", "", "", 1, 20)
+	if !strings.Contains(defaultQuery, "/OBJECT_TYPE/PAYLOAD,UNKNOWN,TBA") {
+		t.Errorf("Default query should restrict object types, got %q", defaultQuery)
+	}
+	if strings.Contains(defaultQuery, "DEBRIS") || strings.Contains(defaultQuery, "ROCKET") {
+		t.Errorf("Default query should exclude debris and rocket bodies, got %q", defaultQuery)
+	}
+
+	debrisQuery := buildSatcatQuery("", "", "DEBRIS", "", 1, 20)
+	if !strings.Contains(debrisQuery, "/OBJECT_TYPE/DEBRIS") {
+		t.Errorf("Explicit debris filter should override exclusion, got %q", debrisQuery)
+	}
+	if strings.Contains(debrisQuery, "PAYLOAD") {
+		t.Errorf("Explicit filter should replace the default object types, got %q", debrisQuery)
+	}
+
+	ExcludeDebrisByDefault = false
+	if query := buildSatcatQuery("", "", "", "", 1, 20); strings.Contains(query, "/OBJECT_TYPE/") {
+		t.Errorf("Disabling the setting should remove the object type restriction, got %q", query)
+	}
+}
+
+func TestBuildSatcatQuery_DecayFilter(t *testing.T) {
+	original := IncludeDecayed
+	t.Cleanup(func() { IncludeDecayed = original })
+
+	IncludeDecayed = false
+	if query := buildSatcatQuery("", "US", "", "", 1, 20); !strings.Contains(query, "/DECAY/null-val") {
+		t.Errorf("Active-only query should require an empty decay date, got %q", query)
+	}
+
+	IncludeDecayed = true
+	if query := buildSatcatQuery("", "US", "", "", 1, 20); strings.Contains(query, "/DECAY/") {
+		t.Errorf("Including decayed objects should remove the decay predicate, got %q", query)
+	}
+}
+
 func TestFilterSatellitesByName(t *testing.T) {
 	sats := []Satellite{
 		{SATNAME: "ISS (ZARYA)", NORAD_CAT_ID: "25544"},
@@ -332,3 +396,130 @@ func BenchmarkFilterSatellitesByName(b *testing.B) {
 	}
 }
 
+func TestMenuLabel(t *testing.T) {
+	longName := "STARLINK-1234 EXPERIMENTAL DEMONSTRATION PAYLOAD WITH AN EXCEPTIONALLY LONG CATALOG NAME"
+
+	tests := []struct {
+		name   string
+		sat    string
+		suffix string
+		width  int
+		want   string
+	}{
+		{"fits", "ISS (ZARYA)", " - ISS [PAYLOAD]", 80, "ISS (ZARYA) (25544) - ISS [PAYLOAD]"},
+		{"name clipped", longName, " - US [PAYLOAD]", 50, "STARLINK-1234 EXPERIMENTAL… (25544) - US [PAYLOAD]"},
+		{"suffix dropped", longName, " - US [PAYLOAD]", 30, "STARLINK-1234 EXPERIM… (25544)"},
+		{"very narrow", longName, "", 10, "S… (25544)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := menuLabel(tt.sat, "25544", tt.suffix, tt.width)
+			if !strings.Contains(got, "(25544)") {
+				t.Errorf("menuLabel() = %q lost the NORAD ID", got)
+			}
+			if utf8.RuneCountInString(got) > tt.width {
+				t.Errorf("menuLabel() = %q is %d columns, want at most %d", got, utf8.RuneCountInString(got), tt.width)
+			}
+			if got != tt.want {
+				t.Errorf("menuLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// loginAttempts returns a login attempt that fails with each of results in turn, succeeding on
+// nil, and the number of attempts made.
+func loginAttempts(results ...error) (func() (*http.Client, error), *int) {
+	attempts := 0
+	return func() (*http.Client, error) {
+		err := results[attempts]
+		attempts++
+		if err != nil {
+			return nil, err
+		}
+		return &http.Client{}, nil
+	}, &attempts
+}
+
+// withReenterCredentials counts how often credentials are re-entered.
+func withReenterCredentials(t *testing.T) *int {
+	t.Helper()
+	prompts := 0
+	original := ReenterCredentials
+	ReenterCredentials = func() { prompts++ }
+	t.Cleanup(func() { ReenterCredentials = original })
+	return &prompts
+}
+
+func TestLogin_RetriesAfterReenteringCredentials(t *testing.T) {
+	rejected := NewAppError(ErrCodeAuthFailed, "Authentication failed with Space-Track API")
+	attempt, attempts := loginAttempts(rejected, nil)
+	prompts := withReenterCredentials(t)
+	withConfirmInput(t, io.NopCloser(strings.NewReader("y\n")))
+
+	client, err := loginWithRetry(attempt)
+	if err != nil || client == nil {
+		t.Fatalf("loginWithRetry() = %v, %v; want success on the retry", client, err)
+	}
+	if *attempts != 2 || *prompts != 1 {
+		t.Errorf("attempts = %d, prompts = %d; want 2 attempts and 1 prompt", *attempts, *prompts)
+	}
+}
+
+func TestLogin_RetriesOnlyOnce(t *testing.T) {
+	rejected := NewAppError(ErrCodeAuthFailed, "Authentication failed with Space-Track API")
+	attempt, attempts := loginAttempts(rejected, rejected)
+	prompts := withReenterCredentials(t)
+	withConfirmInput(t, io.NopCloser(strings.NewReader("y\n")))
+
+	if _, err := loginWithRetry(attempt); err == nil {
+		t.Fatal("loginWithRetry() should fail when the retry is rejected too")
+	}
+	if *attempts != 2 || *prompts != 1 {
+		t.Errorf("attempts = %d, prompts = %d; want 2 attempts and 1 prompt", *attempts, *prompts)
+	}
+}
+
+func TestLogin_NoRetry(t *testing.T) {
+	rejected := NewAppError(ErrCodeAuthFailed, "Authentication failed with Space-Track API")
+	tests := []struct {
+		name           string
+		err            error
+		nonInteractive bool
+	}{
+		{"non-interactive", rejected, true},
+		{"not an auth failure", NewAppError(ErrCodeNetworkTimeout, "Space-Track login timed out"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			NonInteractive = tt.nonInteractive
+			t.Cleanup(func() { NonInteractive = false })
+			attempt, attempts := loginAttempts(tt.err)
+			prompts := withReenterCredentials(t)
+			withConfirmInput(t, failingReader{t})
+
+			if _, err := loginWithRetry(attempt); err != tt.err {
+				t.Errorf("loginWithRetry() error = %v, want %v", err, tt.err)
+			}
+			if *attempts != 1 || *prompts != 0 {
+				t.Errorf("attempts = %d, prompts = %d; want a single attempt without prompting", *attempts, *prompts)
+			}
+		})
+	}
+}
+
+func TestDecodeSpaceTrackJSON_ErrorEnvelope(t *testing.T) {
+	var sats []Satellite
+	err := decodeSpaceTrackJSON(`{"error":"You must be logged in to complete this action"}`, &sats)
+	appErr, ok := err.(*AppError)
+	if !ok {
+		t.Fatalf("decodeSpaceTrackJSON() error = %v, want an AppError", err)
+	}
+	if appErr.Code != ErrCodeAPIResponseFailed || !strings.Contains(appErr.Message, "You must be logged in to complete this action") {
+		t.Errorf("error = %v, want the Space-Track message", appErr)
+	}
+
+	if err := decodeSpaceTrackJSON(`[{"SATNAME":"ISS (ZARYA)","NORAD_CAT_ID":"25544"}]`, &sats); err != nil || len(sats) != 1 {
+		t.Errorf("decodeSpaceTrackJSON() of an array = %v, %v", sats, err)
+	}
+}
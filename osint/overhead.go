@@ -0,0 +1,127 @@
+package osint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// BestOverheadNow propagates every candidate with SGP4 and returns the one highest above the
+// observer's horizon at t, with its elevation in degrees. Element sets come from the snapshot
+// TLE source, so no N2YO quota is used. Candidates that cannot be propagated are skipped.
+func BestOverheadNow(observer ObserverPosition, candidates []BatchSatellite, t time.Time) (*BatchSatellite, float64, error) {
+	if len(candidates) == 0 {
+		return nil, 0, NewAppError(ErrCodeSatNoResults, "No candidate satellites to check")
+	}
+
+	source := newSnapshotTLESource()
+	var best *BatchSatellite
+	bestElevation := math.Inf(-1)
+	var failures []error
+	for i, candidate := range candidates {
+		line1, line2, err := source(context.Background(), candidate.NORADID)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", candidate.Name, err))
+			continue
+		}
+		result, err := CalculateSGP4PositionWithObserver(line1, line2, t, observer)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", candidate.Name, err))
+			continue
+		}
+		if result.LookAngles.Elevation > bestElevation {
+			best = &candidates[i]
+			bestElevation = result.LookAngles.Elevation
+		}
+	}
+
+	if best == nil {
+		return nil, 0, errors.Join(failures...)
+	}
+	if bestElevation < 0 {
+		return nil, bestElevation, NewAppErrorWithContext(ErrCodeSatNoResults, "No candidate is above the horizon",
+			fmt.Sprintf("Highest: %s at %.1f°", best.Name, bestElevation))
+	}
+	return best, bestElevation, nil
+}
+
+// IsVisibleNow reports whether a satellite is at least minElevation degrees above the observer's
+// horizon at t, along with its look angles.
+func IsVisibleNow(line1, line2 string, observer ObserverPosition, minElevation float64, t time.Time) (bool, LookAngles, error) {
+	result, err := CalculateSGP4PositionWithObserver(line1, line2, t, observer)
+	if err != nil {
+		return false, LookAngles{}, err
+	}
+	return result.LookAngles.Elevation >= minElevation, result.LookAngles, nil
+}
+
+// SatelliteVisibleNow is IsVisibleNow for a satellite given by NORAD ID, propagating its cached
+// TLE, which is refreshed from Space-Track when out of date.
+func SatelliteVisibleNow(norad string, observer ObserverPosition, minElevation float64, t time.Time) (bool, LookAngles, error) {
+	line1, line2, err := cachedTLE(norad)
+	if err != nil {
+		return false, LookAngles{}, err
+	}
+	return IsVisibleNow(line1, line2, observer, minElevation, t)
+}
+
+// favoriteCandidates converts favorites to batch satellites for BestOverheadNow.
+func favoriteCandidates() ([]BatchSatellite, error) {
+	favorites, err := LoadFavorites()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]BatchSatellite, 0, len(favorites))
+	for _, fav := range favorites {
+		candidates = append(candidates, BatchSatellite{
+			Name:       fav.SatelliteName,
+			NORADID:    fav.NORADID,
+			Country:    fav.Country,
+			ObjectType: fav.ObjectType,
+		})
+	}
+	return candidates, nil
+}
+
+// TrackBestOverhead finds the favorite highest in the sky right now and shows its position.
+func TrackBestOverhead() {
+	candidates, err := favoriteCandidates()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	if len(candidates) == 0 {
+		fmt.Println(Colorize(RoleInfo, "  [*] No favorites yet. Save satellites to favorites to compare them"))
+		return
+	}
+
+	latitude, longitude, _ := GetLocationWithPrompt()
+	if latitude == "" || longitude == "" {
+		return
+	}
+	lat, _ := strconv.ParseFloat(latitude, 64)
+	lon, _ := strconv.ParseFloat(longitude, 64)
+	observer := ObserverPosition{Latitude: lat, Longitude: lon}
+
+	best, elevation, err := BestOverheadNow(observer, candidates, time.Now().UTC())
+	if err != nil {
+		HandleError(err, ErrCodeSatNoResults, "No favorite is overhead right now")
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Best overhead now: %s (%s) at %s° elevation",
+		best.Name, best.NORADID, FormatQuantity(QuantityAngle, elevation))))
+
+	if !confirm("Track it now?", true) {
+		return
+	}
+	data, err := sgp4CurrentPositions(best.NORADID, observer, 2)
+	if err != nil {
+		HandleError(err, ErrCodeTLEInsufficientData, "Failed to propagate satellite position with SGP4")
+		return
+	}
+	PrintSatellitePositions(data)
+	DisplayMapWithObserver(data, &observer)
+}
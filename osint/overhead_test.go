@@ -0,0 +1,122 @@
+package osint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBestOverheadNow(t *testing.T) {
+	withSnapshotTLEs(t, map[string][2]string{
+		"25544": {testTLELine1, testTLELine2},
+		"5":     {vanguardTLELine1, vanguardTLELine2},
+	})
+	at := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+
+	// Stand directly under the ISS so it is nearly at the zenith
+	iss, err := CalculateSGP4Position(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	observer := ObserverPosition{Latitude: iss.Latitude, Longitude: iss.Longitude}
+
+	candidates := []BatchSatellite{
+		{Name: "VANGUARD 1", NORADID: "5"},
+		{Name: "MISSING", NORADID: "99999"},
+		{Name: "ISS (ZARYA)", NORADID: "25544"},
+	}
+	best, elevation, err := BestOverheadNow(observer, candidates, at)
+	if err != nil {
+		t.Fatalf("BestOverheadNow() failed: %v", err)
+	}
+	if best == nil || best.NORADID != "25544" {
+		t.Fatalf("BestOverheadNow() picked %+v, want the ISS", best)
+	}
+	if elevation < 80 {
+		t.Errorf("Elevation = %.1f, want near 90 directly below the ISS", elevation)
+	}
+
+	if _, _, err := BestOverheadNow(observer, nil, at); err == nil {
+		t.Error("Expected error with no candidates")
+	}
+	if _, _, err := BestOverheadNow(observer, candidates[1:2], at); err == nil {
+		t.Error("Expected error when no candidate can be propagated")
+	}
+}
+
+func TestBestOverheadNow_NoneAboveHorizon(t *testing.T) {
+	withSnapshotTLEs(t, map[string][2]string{"25544": {testTLELine1, testTLELine2}})
+	at := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+
+	// The antipode of the ISS sub-point cannot see it
+	iss, err := CalculateSGP4Position(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	antipode := iss.Longitude + 180
+	if antipode > 180 {
+		antipode -= 360
+	}
+	observer := ObserverPosition{Latitude: -iss.Latitude, Longitude: antipode}
+
+	best, elevation, err := BestOverheadNow(observer, []BatchSatellite{{Name: "ISS (ZARYA)", NORADID: "25544"}}, at)
+	if err == nil || best != nil {
+		t.Errorf("Expected no satellite above the horizon, got %+v", best)
+	}
+	if elevation >= 0 {
+		t.Errorf("Elevation = %.1f, want below the horizon", elevation)
+	}
+}
+
+func TestIsVisibleNow(t *testing.T) {
+	at := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+	iss, err := CalculateSGP4Position(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	below := ObserverPosition{Latitude: -iss.Latitude, Longitude: iss.Longitude + 180}
+	if below.Longitude > 180 {
+		below.Longitude -= 360
+	}
+
+	tests := []struct {
+		name         string
+		observer     ObserverPosition
+		minElevation float64
+		expected     bool
+	}{
+		{"under the satellite", ObserverPosition{Latitude: iss.Latitude, Longitude: iss.Longitude}, 10, true},
+		{"threshold above the zenith", ObserverPosition{Latitude: iss.Latitude, Longitude: iss.Longitude}, 90.5, false},
+		{"antipode", below, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible, angles, err := IsVisibleNow(testTLELine1, testTLELine2, tt.observer, tt.minElevation, at)
+			if err != nil {
+				t.Fatalf("IsVisibleNow() failed: %v", err)
+			}
+			if visible != tt.expected {
+				t.Errorf("IsVisibleNow() = %v at %.1f° elevation, want %v", visible, angles.Elevation, tt.expected)
+			}
+		})
+	}
+
+	if _, _, err := IsVisibleNow("1 bad", "2 bad", below, 0, at); err == nil {
+		t.Error("Expected error for invalid element lines")
+	}
+}
+
+func TestSatelliteVisibleNow_UsesCachedTLE(t *testing.T) {
+	t.Setenv(cacheDirEnv, t.TempDir())
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+
+	at := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+	iss, err := CalculateSGP4Position(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	visible, _, err := SatelliteVisibleNow("25544", ObserverPosition{Latitude: iss.Latitude, Longitude: iss.Longitude}, 10, at)
+	if err != nil || !visible {
+		t.Errorf("SatelliteVisibleNow() = %v, %v; want visible from under the satellite", visible, err)
+	}
+}
@@ -0,0 +1,106 @@
+package osint
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// NonInteractive disables prompts and paging, for scripted or piped use.
+var NonInteractive bool
+
+// defaultTerminalHeight is used when the terminal size cannot be detected.
+const defaultTerminalHeight = 24
+
+// terminalHeight returns the number of rows in the attached terminal.
+func terminalHeight() int {
+	_, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || height <= 0 {
+		return defaultTerminalHeight
+	}
+	return height
+}
+
+// defaultTerminalWidth is used when the terminal size cannot be detected.
+const defaultTerminalWidth = 80
+
+// terminalWidth returns the number of columns in the attached terminal.
+func terminalWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return defaultTerminalWidth
+	}
+	return width
+}
+
+// paginate splits lines into pages that fit a terminal height rows tall, leaving one row for the
+// continue prompt.
+func paginate(lines []string, height int) [][]string {
+	pageSize := height - 1
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	var pages [][]string
+	for start := 0; start < len(lines); start += pageSize {
+		end := start + pageSize
+		if end > len(lines) {
+			end = len(lines)
+		}
+		pages = append(pages, lines[start:end])
+	}
+	return pages
+}
+
+// printPaged prints lines, pausing between pages when output would scroll off an interactive terminal.
+func printPaged(lines []string) {
+	height := terminalHeight()
+	if NonInteractive || !IsTerminal() || len(lines) < height {
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		return
+	}
+
+	pages := paginate(lines, height)
+	for i, page := range pages {
+		for _, line := range page {
+			fmt.Println(line)
+		}
+		if i < len(pages)-1 && !waitForContinue() {
+			return
+		}
+	}
+}
+
+// waitForContinue shows a "more" prompt and waits for space or enter. It returns false if the user pressed q.
+func waitForContinue() bool {
+	prompt := Colorize(RoleInfo, "  -- More -- (space/enter to continue, q to quit)")
+	fmt.Print(prompt)
+	defer fmt.Print("\r" + strings.Repeat(" ", 60) + "\r")
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		// Fall back to line-buffered input
+		line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		return strings.ToLower(strings.TrimSpace(line)) != "q"
+	}
+	defer term.Restore(fd, oldState)
+
+	buf := make([]byte, 1)
+	for {
+		if _, err := os.Stdin.Read(buf); err != nil {
+			return false
+		}
+		switch buf[0] {
+		case ' ', '\r', '\n':
+			return true
+		case 'q', 'Q', 3: // 3 is Ctrl+C in raw mode
+			return false
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package osint
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	var lines []string
+	for i := 0; i < 25; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+
+	pages := paginate(lines, 11) // 10 rows per page plus the continue prompt
+	if len(pages) != 3 {
+		t.Fatalf("Expected 3 pages, got %d", len(pages))
+	}
+
+	expectedSizes := []int{10, 10, 5}
+	for i, page := range pages {
+		if len(page) != expectedSizes[i] {
+			t.Errorf("Page %d: expected %d lines, got %d", i, expectedSizes[i], len(page))
+		}
+	}
+	if pages[1][0] != "line 10" {
+		t.Errorf("Expected second page to start with 'line 10', got %q", pages[1][0])
+	}
+	if pages[2][4] != "line 24" {
+		t.Errorf("Expected last line 'line 24', got %q", pages[2][4])
+	}
+}
+
+func TestPaginate_ExactFit(t *testing.T) {
+	pages := paginate([]string{"a", "b", "c", "d", "e"}, 6)
+	if len(pages) != 1 {
+		t.Errorf("Expected 1 page, got %d", len(pages))
+	}
+}
+
+func TestPaginate_Empty(t *testing.T) {
+	if pages := paginate(nil, 24); len(pages) != 0 {
+		t.Errorf("Expected no pages for empty input, got %d", len(pages))
+	}
+}
+
+func TestPaginate_TinyTerminal(t *testing.T) {
+	pages := paginate([]string{"a", "b", "c"}, 1)
+	if len(pages) != 3 {
+		t.Errorf("Expected one line per page on a tiny terminal, got %d pages", len(pages))
+	}
+}
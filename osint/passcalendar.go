@@ -0,0 +1,90 @@
+package osint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// calendarCellWidth fits a pass entry such as "21:04 52°".
+	calendarCellWidth = 9
+	// calendarMaxEntries is how many passes a day lists before summarizing the rest.
+	calendarMaxEntries = 6
+)
+
+// RenderPassCalendar lays out passes as a calendar of days columns, seven to a row, starting on
+// start's day. Each day lists its passes by rise time and max elevation, in start's time zone.
+// Passes outside the calendar are left out.
+func RenderPassCalendar(passes []Pass, start time.Time, days int) string {
+	if days <= 0 {
+		return ""
+	}
+	loc := start.Location()
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+
+	entries := make([][]string, days)
+	for _, pass := range passes {
+		rise := time.Unix(int64(pass.StartUTC), 0).In(loc)
+		day := time.Date(rise.Year(), rise.Month(), rise.Day(), 0, 0, 0, 0, loc)
+		// Count calendar days rather than 24 hour spans, which differ across DST changes
+		index := int(day.Sub(first).Round(24*time.Hour) / (24 * time.Hour))
+		if index < 0 || index >= days {
+			continue
+		}
+		entries[index] = append(entries[index], fmt.Sprintf("%s %2.0f°", rise.Format("15:04"), pass.MaxEl))
+	}
+
+	var builder strings.Builder
+	for week := 0; week < days; week += 7 {
+		columns := min(7, days-week)
+		builder.WriteString(calendarBorder("┌", "┬", "┐", columns))
+
+		var header []string
+		rows := 1
+		for i := week; i < week+columns; i++ {
+			header = append(header, time.Date(first.Year(), first.Month(), first.Day()+i, 0, 0, 0, 0, loc).Format("Mon 01-02"))
+			if len(entries[i]) > calendarMaxEntries {
+				entries[i] = append(entries[i][:calendarMaxEntries-1], fmt.Sprintf("+%d more", len(entries[i])-calendarMaxEntries+1))
+			}
+			rows = max(rows, len(entries[i]))
+		}
+		builder.WriteString(calendarRow(header))
+		builder.WriteString(calendarBorder("├", "┼", "┤", columns))
+
+		for row := 0; row < rows; row++ {
+			var cells []string
+			for i := week; i < week+columns; i++ {
+				cell := ""
+				if row < len(entries[i]) {
+					cell = entries[i][row]
+				} else if row == 0 {
+					cell = "    -"
+				}
+				cells = append(cells, cell)
+			}
+			builder.WriteString(calendarRow(cells))
+		}
+		builder.WriteString(calendarBorder("└", "┴", "┘", columns))
+	}
+	return builder.String()
+}
+
+// calendarBorder draws a horizontal calendar rule across columns cells.
+func calendarBorder(left, middle, right string, columns int) string {
+	segments := make([]string, columns)
+	for i := range segments {
+		segments[i] = strings.Repeat("─", calendarCellWidth)
+	}
+	return left + strings.Join(segments, middle) + right + "\n"
+}
+
+// calendarRow draws one line of calendar cells, padding each to the cell width.
+func calendarRow(cells []string) string {
+	padded := make([]string, len(cells))
+	for i, cell := range cells {
+		padded[i] = cell + strings.Repeat(" ", max(0, calendarCellWidth-utf8.RuneCountInString(cell)))
+	}
+	return "│" + strings.Join(padded, "│") + "│\n"
+}
@@ -0,0 +1,53 @@
+package osint
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderPassCalendar_PlacesPassOnItsDay(t *testing.T) {
+	start := time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC) // a Monday afternoon
+	passes := []Pass{
+		{StartUTC: int(time.Date(2024, 6, 5, 21, 4, 0, 0, time.UTC).Unix()), MaxEl: 52},
+		{StartUTC: int(time.Date(2024, 6, 5, 22, 40, 0, 0, time.UTC).Unix()), MaxEl: 7},
+		{StartUTC: int(time.Date(2024, 6, 11, 3, 15, 0, 0, time.UTC).Unix()), MaxEl: 80},
+		// Outside the calendar
+		{StartUTC: int(time.Date(2024, 6, 20, 1, 0, 0, 0, time.UTC).Unix()), MaxEl: 45},
+	}
+
+	calendar := RenderPassCalendar(passes, start, 10)
+	lines := strings.Split(strings.TrimSuffix(calendar, "\n"), "\n")
+
+	// cell returns the text in a column of a calendar line
+	cell := func(line string, column int) string {
+		cells := strings.Split(line, "│")
+		if column+1 >= len(cells) {
+			t.Fatalf("Line %q has no column %d", line, column)
+		}
+		return strings.TrimSpace(cells[column+1])
+	}
+
+	// First week: border, header, rule, two pass rows, border
+	if cell(lines[1], 0) != "Mon 06-03" || cell(lines[1], 2) != "Wed 06-05" || cell(lines[1], 6) != "Sun 06-09" {
+		t.Errorf("Unexpected first week header: %q", lines[1])
+	}
+	if cell(lines[3], 2) != "21:04 52°" || cell(lines[4], 2) != "22:40  7°" {
+		t.Errorf("Wednesday should list both passes, got %q and %q", lines[3], lines[4])
+	}
+	if cell(lines[3], 0) != "-" || cell(lines[3], 1) != "-" {
+		t.Errorf("Days without passes should be marked empty, got %q", lines[3])
+	}
+
+	// Second week has the remaining three days
+	second := lines[6:]
+	if cell(second[1], 0) != "Mon 06-10" || strings.Count(second[1], "│") != 4 {
+		t.Errorf("Second week should cover three days, got %q", second[1])
+	}
+	if cell(second[3], 1) != "03:15 80°" {
+		t.Errorf("Tuesday of the second week should list the pass, got %q", second[3])
+	}
+	if strings.Contains(calendar, "45°") {
+		t.Error("A pass after the last day should be left out")
+	}
+}
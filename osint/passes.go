@@ -0,0 +1,167 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// PassDetectionOptions tunes how sampled elevations are turned into passes.
+type PassDetectionOptions struct {
+	// MinElevation is the elevation in degrees a satellite must be above to count as visible.
+	MinElevation float64
+	// Margin is how far above MinElevation the peak must reach. It rejects passes that only graze
+	// the threshold because of numerical noise.
+	Margin float64
+	// MergeGap joins passes separated by dips below MinElevation no longer than this.
+	MergeGap time.Duration
+}
+
+const (
+	defaultPassMinElevation = 10.0
+	defaultPassMargin       = 0.5
+	defaultPassMergeGap     = 30 * time.Second
+)
+
+// PassDetection holds the margin and merge gap used by offline pass prediction. main applies
+// config overrides to it.
+var PassDetection = PassDetectionOptions{
+	MinElevation: defaultPassMinElevation,
+	Margin:       defaultPassMargin,
+	MergeGap:     defaultPassMergeGap,
+}
+
+// ElevationSample is a satellite's elevation seen by an observer at one moment.
+type ElevationSample struct {
+	Time      time.Time
+	Elevation float64 // degrees
+	Azimuth   float64 // degrees
+}
+
+// OfflinePass is a pass found by sampling SGP4 elevations. The compass fields match the
+// 16-point directions N2YO reports for its passes.
+type OfflinePass struct {
+	Start        time.Time
+	End          time.Time
+	MaxTime      time.Time
+	MaxElevation float64 // degrees
+
+	StartAz        float64 // degrees
+	StartAzCompass string
+	MaxAz          float64 // degrees
+	MaxAzCompass   string
+	EndAz          float64 // degrees
+	EndAzCompass   string
+}
+
+// compassPoints are the 16 compass directions clockwise from north, as used by N2YO.
+var compassPoints = []string{
+	"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
+	"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
+}
+
+// AzimuthToCompass names the 16-point compass direction of an azimuth in degrees. Each point
+// covers 22.5°, centered on its direction, so 348.75° up to 11.25° is N.
+func AzimuthToCompass(deg float64) string {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return compassPoints[int(math.Floor((deg+11.25)/22.5))%len(compassPoints)]
+}
+
+// detectPasses groups time-ordered samples above opts.MinElevation into passes. Passes separated
+// by a gap of at most opts.MergeGap are merged, then passes whose peak stays below
+// MinElevation+Margin are dropped.
+func detectPasses(samples []ElevationSample, opts PassDetectionOptions) []OfflinePass {
+	var raw []OfflinePass
+	var current *OfflinePass
+	for _, sample := range samples {
+		if sample.Elevation < opts.MinElevation {
+			current = nil
+			continue
+		}
+		if current == nil {
+			raw = append(raw, OfflinePass{
+				Start: sample.Time, MaxTime: sample.Time, MaxElevation: sample.Elevation,
+				StartAz: sample.Azimuth, MaxAz: sample.Azimuth,
+			})
+			current = &raw[len(raw)-1]
+		}
+		current.End = sample.Time
+		current.EndAz = sample.Azimuth
+		if sample.Elevation > current.MaxElevation {
+			current.MaxElevation = sample.Elevation
+			current.MaxTime = sample.Time
+			current.MaxAz = sample.Azimuth
+		}
+	}
+
+	var merged []OfflinePass
+	for _, pass := range raw {
+		if n := len(merged); n > 0 && pass.Start.Sub(merged[n-1].End) <= opts.MergeGap {
+			last := &merged[n-1]
+			last.End = pass.End
+			last.EndAz = pass.EndAz
+			if pass.MaxElevation > last.MaxElevation {
+				last.MaxElevation = pass.MaxElevation
+				last.MaxTime = pass.MaxTime
+				last.MaxAz = pass.MaxAz
+			}
+			continue
+		}
+		merged = append(merged, pass)
+	}
+
+	var passes []OfflinePass
+	for _, pass := range merged {
+		if pass.MaxElevation >= opts.MinElevation+opts.Margin {
+			pass.StartAzCompass = AzimuthToCompass(pass.StartAz)
+			pass.MaxAzCompass = AzimuthToCompass(pass.MaxAz)
+			pass.EndAzCompass = AzimuthToCompass(pass.EndAz)
+			passes = append(passes, pass)
+		}
+	}
+	return passes
+}
+
+// PredictPassesSGP4 finds the passes of a TLE over an observer between start and end by sampling
+// elevations every SuggestStep(..., StepPurposePassDetection). It works entirely offline.
+func PredictPassesSGP4(line1, line2 string, observer ObserverPosition, start, end time.Time, opts PassDetectionOptions) ([]OfflinePass, error) {
+	return PredictPassesSGP4WithDynamicObserver(line1, line2, func(time.Time) ObserverPosition { return observer }, start, end, opts)
+}
+
+// PredictPassesSGP4WithDynamicObserver is PredictPassesSGP4 for an observer on a moving platform,
+// such as an aircraft or ship. observerAt is evaluated at every sample time.
+func PredictPassesSGP4WithDynamicObserver(line1, line2 string, observerAt func(t time.Time) ObserverPosition, start, end time.Time, opts PassDetectionOptions) ([]OfflinePass, error) {
+	if start.After(end) {
+		return nil, fmt.Errorf("start time must be before end time")
+	}
+
+	step := SuggestStep(TLE{}, StepPurposePassDetection)
+	var samples []ElevationSample
+	for t := start; !t.After(end); t = t.Add(step) {
+		result, err := CalculateSGP4PositionWithDynamicObserver(line1, line2, t, observerAt)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, ElevationSample{Time: t, Elevation: result.LookAngles.Elevation, Azimuth: result.LookAngles.Azimuth})
+	}
+	return detectPasses(samples, opts), nil
+}
+
+// offlinePassLines renders offline passes one line each.
+func offlinePassLines(passes []OfflinePass) []string {
+	lines := []string{Colorize(RoleHeader, fmt.Sprintf("\n  %d pass(es) above %.0f°\n", len(passes), PassDetection.MinElevation))}
+	for i, pass := range passes {
+		lines = append(lines, fmt.Sprintf("  #%d  AOS %s %s  max %s° %s at %s  LOS %s %s  dur %s",
+			i+1,
+			pass.Start.UTC().Format("2006-01-02 15:04:05"), pass.StartAzCompass,
+			FormatQuantity(QuantityAngle, pass.MaxElevation), pass.MaxAzCompass,
+			pass.MaxTime.UTC().Format("15:04:05"),
+			pass.End.UTC().Format("15:04:05"), pass.EndAzCompass,
+			formatPassDuration(int(pass.End.Sub(pass.Start).Seconds())),
+		))
+	}
+	return lines
+}
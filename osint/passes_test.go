@@ -0,0 +1,138 @@
+package osint
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// elevationSeries builds samples 10 seconds apart starting at start.
+func elevationSeries(start time.Time, elevations ...float64) []ElevationSample {
+	samples := make([]ElevationSample, len(elevations))
+	for i, elevation := range elevations {
+		samples[i] = ElevationSample{Time: start.Add(time.Duration(i) * 10 * time.Second), Elevation: elevation}
+	}
+	return samples
+}
+
+func TestDetectPasses_SuppressesNoise(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	opts := PassDetectionOptions{MinElevation: 10, Margin: 0.5, MergeGap: 30 * time.Second}
+
+	samples := elevationSeries(start,
+		// Noise grazing the threshold: two micro-passes peaking at 10.2°
+		9.9, 10.1, 9.95, 10.2, 9.8, 5, 0,
+		// A real pass with a one-sample dip below the threshold near the horizon
+		8, 10.3, 9.9, 12, 25, 48, 25, 12, 10.1, 9.7, 10.05, 9,
+		// Far below
+		-5, -20,
+	)
+
+	passes := detectPasses(samples, opts)
+	if len(passes) != 1 {
+		t.Fatalf("Expected 1 pass, got %d: %+v", len(passes), passes)
+	}
+
+	pass := passes[0]
+	if pass.MaxElevation != 48 {
+		t.Errorf("MaxElevation = %v, want 48", pass.MaxElevation)
+	}
+	if want := samples[8].Time; !pass.Start.Equal(want) {
+		t.Errorf("Start = %v, want %v (merged across the dip)", pass.Start, want)
+	}
+	if want := samples[17].Time; !pass.End.Equal(want) {
+		t.Errorf("End = %v, want %v (merged across the dip)", pass.End, want)
+	}
+	if want := samples[12].Time; !pass.MaxTime.Equal(want) {
+		t.Errorf("MaxTime = %v, want %v", pass.MaxTime, want)
+	}
+
+	// Without a margin or merging, the noise shows up as separate passes
+	unfiltered := detectPasses(samples, PassDetectionOptions{MinElevation: 10})
+	if len(unfiltered) <= 1 {
+		t.Errorf("Expected spurious passes without margin and merging, got %d", len(unfiltered))
+	}
+}
+
+func TestPredictPassesSGP4(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC)
+
+	passes, err := PredictPassesSGP4(testTLELine1, testTLELine2, observer, start, start.Add(24*time.Hour), PassDetection)
+	if err != nil {
+		t.Fatalf("PredictPassesSGP4() failed: %v", err)
+	}
+	if len(passes) == 0 || len(passes) > 8 {
+		t.Fatalf("Expected a handful of ISS passes in a day, got %d", len(passes))
+	}
+	for _, pass := range passes {
+		if pass.MaxElevation < PassDetection.MinElevation+PassDetection.Margin || pass.MaxElevation > 90 {
+			t.Errorf("Pass peak %v out of range", pass.MaxElevation)
+		}
+		if d := pass.End.Sub(pass.Start); d <= 0 || d > 15*time.Minute {
+			t.Errorf("Pass duration %v is not an ISS pass", d)
+		}
+		if pass.StartAzCompass != AzimuthToCompass(pass.StartAz) || pass.MaxAzCompass == "" || pass.EndAzCompass == "" {
+			t.Errorf("Pass compass directions %q/%q/%q do not match azimuths %v/%v/%v",
+				pass.StartAzCompass, pass.MaxAzCompass, pass.EndAzCompass, pass.StartAz, pass.MaxAz, pass.EndAz)
+		}
+	}
+}
+
+func TestPredictPassesSGP4WithDynamicObserver(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	// A ship heading east at 5 degrees of longitude per hour
+	var sampled []time.Time
+	eastbound := func(t time.Time) ObserverPosition {
+		sampled = append(sampled, t)
+		obs := observer
+		obs.Longitude += 5.0 * t.Sub(start).Hours()
+		return obs
+	}
+
+	moving, err := PredictPassesSGP4WithDynamicObserver(testTLELine1, testTLELine2, eastbound, start, end, PassDetection)
+	if err != nil {
+		t.Fatalf("PredictPassesSGP4WithDynamicObserver() failed: %v", err)
+	}
+	step := SuggestStep(TLE{}, StepPurposePassDetection)
+	if want := int(end.Sub(start)/step) + 1; len(sampled) != want {
+		t.Errorf("Observer evaluated %d times, want once per sample (%d)", len(sampled), want)
+	}
+
+	static, err := PredictPassesSGP4(testTLELine1, testTLELine2, observer, start, end, PassDetection)
+	if err != nil {
+		t.Fatalf("PredictPassesSGP4() failed: %v", err)
+	}
+	if reflect.DeepEqual(moving, static) {
+		t.Error("Expected passes for the moving observer to differ from the static observer")
+	}
+}
+
+func TestAzimuthToCompass(t *testing.T) {
+	tests := []struct {
+		deg  float64
+		want string
+	}{
+		{0, "N"},
+		{11.24, "N"},
+		{11.25, "NNE"},
+		{45, "NE"},
+		{90, "E"},
+		{180, "S"},
+		{202.5, "SSW"},
+		{270, "W"},
+		{348.74, "NNW"},
+		{348.75, "N"},
+		{360, "N"},
+		{-90, "W"},
+		{450, "E"},
+	}
+	for _, tt := range tests {
+		if got := AzimuthToCompass(tt.deg); got != tt.want {
+			t.Errorf("AzimuthToCompass(%v) = %q, want %q", tt.deg, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package osint
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joshuaferrara/go-satellite"
+	"github.com/manifoldco/promptui"
+)
+
+// sunriseElevation is the Sun's elevation at sunrise and sunset, allowing for refraction and the
+// Sun's radius.
+const sunriseElevation = -0.833
+
+// twilightName classifies the observer's sky by the Sun's elevation in degrees.
+func twilightName(sunElevation float64) string {
+	switch {
+	case sunElevation > sunriseElevation:
+		return "Daylight"
+	case sunElevation > -6:
+		return "Civil twilight"
+	case sunElevation > -12:
+		return "Nautical twilight"
+	case sunElevation > -18:
+		return "Astronomical twilight"
+	}
+	return "Dark"
+}
+
+// sunElevationAt returns the Sun's elevation in degrees for the observer at t.
+func sunElevationAt(observer ObserverPosition, t time.Time) float64 {
+	t = t.UTC()
+	gmst := satellite.GSTimeFromDate(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second())
+	lat := observer.Latitude * degreesToRadians
+	lst := observer.Longitude*degreesToRadians + gmst
+	up := [3]float64{math.Cos(lat) * math.Cos(lst), math.Cos(lat) * math.Sin(lst), math.Sin(lat)}
+	sun := sunDirectionECI(t)
+
+	dot := up[0]*sun[0] + up[1]*sun[1] + up[2]*sun[2]
+	return math.Asin(math.Max(-1, math.Min(1, dot))) / degreesToRadians
+}
+
+// satelliteSunlit propagates a TLE to t and reports whether the satellite is in sunlight there,
+// as decided by IsSunlit.
+func satelliteSunlit(line1, line2 string, t time.Time) bool {
+	t = t.UTC()
+	sat := satellite.TLEToSat(strings.TrimSpace(line1), strings.TrimSpace(line2), satellite.GravityWGS72)
+	position, _ := satellite.Propagate(sat, t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second())
+	return IsSunlit(position, t)
+}
+
+// boxTitleRow centers a section title in a table row.
+func boxTitleRow(title string) string {
+	left := (61 - len(title)) / 2
+	return "║" + strings.Repeat(" ", left) + title + strings.Repeat(" ", 61-left-len(title)) + "║"
+}
+
+// compassOr returns the given compass point, or derives it from the azimuth when it is missing.
+func compassOr(compass string, azimuth float64) string {
+	if compass == "" {
+		return AzimuthToCompass(azimuth)
+	}
+	return compass
+}
+
+// ExplainPass describes a pass in detail: its geometry, whether the satellite is sunlit and the
+// observer's sky dark enough to see it, and the Doppler shift at AOS, TCA and LOS. The look
+// angles, lighting and range rates are computed with SGP4 from the element lines.
+func ExplainPass(line1, line2 string, observer ObserverPosition, pass Pass) string {
+	aos := time.Unix(int64(pass.StartUTC), 0).UTC()
+	tca := time.Unix(int64(pass.MaxUTC), 0).UTC()
+	los := time.Unix(int64(pass.EndUTC), 0).UTC()
+	duration := pass.Duration
+	if duration == 0 {
+		duration = pass.EndUTC - pass.StartUTC
+	}
+
+	lines := []string{
+		"╔═════════════════════════════════════════════════════════════╗",
+		boxTitleRow("Geometry"),
+		"╠═════════════════════════════════════════════════════════════╣",
+		GenRowString("Rise", aos.Format("2006-01-02 15:04:05 UTC")),
+		GenRowString("Rise Azimuth", fmt.Sprintf("%s deg (%s)", FormatQuantity(QuantityAngle, pass.StartAz), compassOr(pass.StartAzCompass, pass.StartAz))),
+		GenRowString("Max Elevation", fmt.Sprintf("%s deg at %s", FormatQuantity(QuantityAngle, pass.MaxEl), tca.Format("15:04:05 UTC"))),
+		GenRowString("Max Elevation Azimuth", fmt.Sprintf("%s deg (%s)", FormatQuantity(QuantityAngle, pass.MaxAz), compassOr(pass.MaxAzCompass, pass.MaxAz))),
+		GenRowString("Set", los.Format("2006-01-02 15:04:05 UTC")),
+		GenRowString("Set Azimuth", fmt.Sprintf("%s deg (%s)", FormatQuantity(QuantityAngle, pass.EndAz), compassOr(pass.EndAzCompass, pass.EndAz))),
+		GenRowString("Duration", formatPassDuration(duration)),
+	}
+
+	// Check the element lines once, so the sections below can rely on them
+	if _, err := CalculateSGP4Position(line1, line2, tca); err != nil {
+		lines = append(lines,
+			"╠═════════════════════════════════════════════════════════════╣",
+			GenRowString("SGP4", "unavailable, the element lines are invalid"),
+			"╚═════════════════════════════════════════════════════════════╝",
+		)
+		return strings.Join(lines, "\n")
+	}
+
+	sunlit := satelliteSunlit(line1, line2, tca)
+	sunElevation := sunElevationAt(observer, tca)
+	lighting := "In Earth's shadow"
+	if sunlit {
+		lighting = "Sunlit"
+	}
+	verdict := "Visible to the eye"
+	switch {
+	case !sunlit:
+		verdict = "Not visible, the satellite is in shadow"
+	case sunElevation > -6:
+		verdict = "Not visible, the sky is too bright"
+	}
+	lines = append(lines,
+		"╠═════════════════════════════════════════════════════════════╣",
+		boxTitleRow("Visibility"),
+		"╠═════════════════════════════════════════════════════════════╣",
+		GenRowString("Satellite at TCA", lighting),
+		GenRowString("Observer Sky", fmt.Sprintf("%s, Sun at %s deg", twilightName(sunElevation), FormatQuantity(QuantityAngle, sunElevation))),
+		GenRowString("Verdict", verdict),
+	)
+	if pass.Mag < unknownMagnitude {
+		lines = append(lines, GenRowString("Magnitude", strconv.FormatFloat(pass.Mag, 'f', 1, 64)))
+	}
+
+	lines = append(lines,
+		"╠═════════════════════════════════════════════════════════════╣",
+		boxTitleRow("Doppler"),
+		"╠═════════════════════════════════════════════════════════════╣",
+	)
+	for _, point := range []struct {
+		name string
+		t    time.Time
+	}{{"AOS", aos}, {"TCA", tca}, {"LOS", los}} {
+		result, err := CalculateSGP4PositionWithObserver(line1, line2, point.t, observer)
+		if err != nil {
+			lines = append(lines, GenRowString(point.name, "unavailable"))
+			continue
+		}
+		// The shift per MHz of carrier applies to any downlink frequency
+		shift := CorrectedFrequency(1e6, result.LookAngles.RangeRate) - 1e6
+		lines = append(lines, GenRowString(point.name, fmt.Sprintf("%+.3f km/s, %+.2f Hz per MHz", result.LookAngles.RangeRate, shift)))
+	}
+	lines = append(lines, "╚═════════════════════════════════════════════════════════════╝")
+	return strings.Join(lines, "\n")
+}
+
+// radioPassAsPass converts a radio pass so it can be explained like a visual one.
+func radioPassAsPass(pass RadioPass) Pass {
+	return Pass{
+		StartAz:        pass.StartAz,
+		StartAzCompass: pass.StartAzCompass,
+		StartUTC:       int(pass.StartUTC),
+		MaxAz:          pass.MaxAz,
+		MaxAzCompass:   pass.MaxAzCompass,
+		MaxEl:          pass.MaxEl,
+		MaxUTC:         int(pass.MaxUTC),
+		EndAz:          pass.EndAz,
+		EndAzCompass:   pass.EndAzCompass,
+		EndUTC:         int(pass.EndUTC),
+		Mag:            unknownMagnitude,
+		Duration:       int(pass.EndUTC - pass.StartUTC),
+	}
+}
+
+// offerPassExplanation lets the user pick one of the listed passes and shows ExplainPass for it.
+func offerPassExplanation(norad string, observer ObserverPosition, passes []Pass) {
+	if len(passes) == 0 || NonInteractive {
+		return
+	}
+
+	var items []string
+	for i, pass := range passes {
+		items = append(items, fmt.Sprintf("Pass %d: %s, max elevation %s°", i+1,
+			time.Unix(int64(pass.StartUTC), 0).UTC().Format("2006-01-02 15:04 UTC"), FormatQuantity(QuantityAngle, pass.MaxEl)))
+	}
+	items = append(items, "Skip")
+
+	prompt := promptui.Select{
+		Label: "Explain a pass in detail?",
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx >= len(passes) {
+		return
+	}
+
+	line1, line2, err := newSnapshotTLESource()(context.Background(), norad)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+
+	var lines []string
+	for _, line := range strings.Split(ExplainPass(line1, line2, observer, passes[idx]), "\n") {
+		lines = append(lines, Colorize(RoleHeader, line))
+	}
+	printPaged(lines)
+}
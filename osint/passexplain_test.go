@@ -0,0 +1,72 @@
+package osint
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExplainPass_Sections(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC)
+	predicted, err := PredictPassesSGP4(testTLELine1, testTLELine2, observer, start, start.Add(24*time.Hour), PassDetection)
+	if err != nil || len(predicted) == 0 {
+		t.Fatalf("PredictPassesSGP4() = %d passes, %v; need one to explain", len(predicted), err)
+	}
+	offline := predicted[0]
+	pass := Pass{
+		StartAz:  offline.StartAz,
+		StartUTC: int(offline.Start.Unix()),
+		MaxAz:    offline.MaxAz,
+		MaxEl:    offline.MaxElevation,
+		MaxUTC:   int(offline.MaxTime.Unix()),
+		EndAz:    offline.EndAz,
+		EndUTC:   int(offline.End.Unix()),
+		Mag:      unknownMagnitude,
+	}
+
+	explanation := ExplainPass(testTLELine1, testTLELine2, observer, pass)
+	for _, want := range []string{
+		"Geometry", "Rise Azimuth", "(" + offline.StartAzCompass + ")", "Max Elevation", "Set Azimuth", "Duration",
+		"Visibility", "Satellite at TCA", "Observer Sky", "Verdict",
+		"Doppler", "AOS", "TCA", "LOS", "Hz per MHz",
+	} {
+		if !strings.Contains(explanation, want) {
+			t.Errorf("Explanation is missing %q:\n%s", want, explanation)
+		}
+	}
+	if strings.Contains(explanation, "Magnitude") {
+		t.Error("A pass without a magnitude estimate should not show one")
+	}
+
+	// The satellite approaches at AOS and recedes at LOS
+	aos := lineWithPrefix(explanation, "║ AOS: ")
+	los := lineWithPrefix(explanation, "║ LOS: ")
+	if !strings.HasPrefix(aos, "║ AOS: -") || !strings.HasPrefix(los, "║ LOS: +") {
+		t.Errorf("Expected closing range rate at AOS and opening at LOS, got %q and %q", aos, los)
+	}
+
+	broken := ExplainPass("1 bad", "2 bad", observer, pass)
+	if !strings.Contains(broken, "Geometry") || !strings.Contains(broken, "SGP4") || strings.Contains(broken, "Doppler") {
+		t.Errorf("Invalid element lines should still explain the geometry and note SGP4 failed:\n%s", broken)
+	}
+}
+
+func TestTwilightName(t *testing.T) {
+	tests := map[float64]string{10: "Daylight", -3: "Civil twilight", -9: "Nautical twilight", -15: "Astronomical twilight", -30: "Dark"}
+	for elevation, want := range tests {
+		if got := twilightName(elevation); got != want {
+			t.Errorf("twilightName(%v) = %q, want %q", elevation, got, want)
+		}
+	}
+}
+
+// lineWithPrefix returns the first line of text starting with prefix.
+func lineWithPrefix(text, prefix string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+	return ""
+}
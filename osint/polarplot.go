@@ -0,0 +1,139 @@
+package osint
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+	"time"
+)
+
+// Sky plot layout in pixels.
+const (
+	skyPlotSize   = 520
+	skyPlotRadius = 220
+	// skyPlotArcSteps is the number of segments drawn between two points of a pass.
+	skyPlotArcSteps = 16
+)
+
+// skyPlotColors cycles through the colours given to successive passes.
+var skyPlotColors = []string{"#e63946", "#2a9d8f", "#f4a261", "#457b9d", "#9b5de5", "#e9c46a", "#06d6a0", "#ef476f"}
+
+// skyPlotPoint converts an azimuth and elevation in degrees to sky plot coordinates: the horizon
+// lies on the rim, the zenith at the centre, north at the top and east to the right.
+func skyPlotPoint(azimuth, elevation float64) (float64, float64) {
+	elevation = math.Max(0, math.Min(90, elevation))
+	r := skyPlotRadius * (90 - elevation) / 90
+	rad := azimuth * math.Pi / 180
+	center := skyPlotSize / 2.0
+	return center + r*math.Sin(rad), center - r*math.Cos(rad)
+}
+
+// skyPlotSegment samples the sky track from one azimuth/elevation to another, turning through the
+// shorter way round in azimuth. The first point is left out so segments can be chained.
+func skyPlotSegment(fromAz, fromEl, toAz, toEl float64) [][2]float64 {
+	turn := math.Mod(toAz-fromAz+540, 360) - 180
+	points := make([][2]float64, 0, skyPlotArcSteps)
+	for step := 1; step <= skyPlotArcSteps; step++ {
+		t := float64(step) / skyPlotArcSteps
+		x, y := skyPlotPoint(fromAz+turn*t, fromEl+(toEl-fromEl)*t)
+		points = append(points, [2]float64{x, y})
+	}
+	return points
+}
+
+// skyPlotPath returns the SVG path of a pass from its start through culmination to its end, or
+// false when all three points fall on the same spot and there is no arc to draw.
+func skyPlotPath(pass Pass) (string, bool) {
+	startX, startY := skyPlotPoint(pass.StartAz, pass.StartEl)
+	points := append(skyPlotSegment(pass.StartAz, pass.StartEl, pass.MaxAz, pass.MaxEl),
+		skyPlotSegment(pass.MaxAz, pass.MaxEl, pass.EndAz, pass.EndEl)...)
+
+	moves := false
+	var path strings.Builder
+	path.WriteString(fmt.Sprintf("M %.2f %.2f", startX, startY))
+	for _, point := range points {
+		if math.Abs(point[0]-startX) >= 0.01 || math.Abs(point[1]-startY) >= 0.01 {
+			moves = true
+		}
+		path.WriteString(fmt.Sprintf(" L %.2f %.2f", point[0], point[1]))
+	}
+	return path.String(), moves
+}
+
+// GeneratePolarPlotHTML writes an HTML page with a polar sky plot of the visual passes in data.
+// Each pass is drawn as an arc from where it rises through its highest point to where it sets,
+// with elevation rings every 30 degrees and the compass points around the rim.
+func GeneratePolarPlotHTML(data VisualPassesResponse, filePath string) error {
+	if len(data.Passes) == 0 {
+		return fmt.Errorf("no passes to plot")
+	}
+
+	name := html.EscapeString(data.Info.SatName)
+	center := skyPlotSize / 2
+
+	var builder strings.Builder
+	builder.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	builder.WriteString(fmt.Sprintf("<title>Sky Plot - %s</title>\n", name))
+	builder.WriteString("<style>\n")
+	builder.WriteString("  body { font-family: sans-serif; background: #0b132b; color: #e0e1dd; margin: 20px; }\n")
+	builder.WriteString("  table { border-collapse: collapse; margin-top: 16px; }\n")
+	builder.WriteString("  td, th { padding: 4px 12px; text-align: left; border-bottom: 1px solid #3a506b; }\n")
+	builder.WriteString("  .swatch { display: inline-block; width: 12px; height: 12px; border-radius: 6px; }\n")
+	builder.WriteString("</style>\n</head>\n<body>\n")
+	builder.WriteString(fmt.Sprintf("<h2>%s (NORAD %d) - Visual Passes</h2>\n", name, data.Info.SatID))
+
+	builder.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-size="12">`+"\n",
+		skyPlotSize, skyPlotSize, skyPlotSize, skyPlotSize))
+	for _, elevation := range []int{0, 30, 60} {
+		r := skyPlotRadius * float64(90-elevation) / 90
+		builder.WriteString(fmt.Sprintf(`  <circle cx="%d" cy="%d" r="%.2f" fill="none" stroke="#3a506b"/>`+"\n", center, center, r))
+		builder.WriteString(fmt.Sprintf(`  <text x="%d" y="%.2f" fill="#8d99ae">%d°</text>`+"\n", center+3, float64(center)-r-3, elevation))
+	}
+	builder.WriteString(fmt.Sprintf(`  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#3a506b"/>`+"\n", center, center-skyPlotRadius, center, center+skyPlotRadius))
+	builder.WriteString(fmt.Sprintf(`  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#3a506b"/>`+"\n", center-skyPlotRadius, center, center+skyPlotRadius, center))
+	for _, label := range []struct {
+		text    string
+		azimuth float64
+	}{{"N", 0}, {"E", 90}, {"S", 180}, {"W", 270}} {
+		rad := label.azimuth * math.Pi / 180
+		x := float64(center) + (skyPlotRadius+16)*math.Sin(rad)
+		y := float64(center) - (skyPlotRadius+16)*math.Cos(rad) + 5
+		builder.WriteString(fmt.Sprintf(`  <text x="%.2f" y="%.2f" text-anchor="middle" font-weight="bold">%s</text>`+"\n", x, y, label.text))
+	}
+
+	for i, pass := range data.Passes {
+		color := skyPlotColors[i%len(skyPlotColors)]
+		start := time.Unix(int64(pass.StartUTC), 0).UTC().Format("2006-01-02 15:04:05")
+		title := fmt.Sprintf("Pass %d: %s UTC, max %.0f° at %s, mag %.1f", i+1, start, pass.MaxEl, html.EscapeString(pass.MaxAzCompass), pass.Mag)
+		startX, startY := skyPlotPoint(pass.StartAz, pass.StartEl)
+
+		path, moves := skyPlotPath(pass)
+		if !moves {
+			// A pass reported with a single position still needs a visible mark
+			builder.WriteString(fmt.Sprintf(`  <circle class="pass-point" cx="%.2f" cy="%.2f" r="5" fill="%s"><title>%s</title></circle>`+"\n",
+				startX, startY, color, title))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf(`  <path class="pass-arc" d="%s" fill="none" stroke="%s" stroke-width="2.5"><title>%s</title></path>`+"\n",
+			path, color, title))
+		builder.WriteString(fmt.Sprintf(`  <circle cx="%.2f" cy="%.2f" r="3.5" fill="%s"/>`+"\n", startX, startY, color))
+		builder.WriteString(fmt.Sprintf(`  <text x="%.2f" y="%.2f" fill="%s">%d</text>`+"\n", startX+6, startY-6, color, i+1))
+	}
+	builder.WriteString("</svg>\n")
+
+	builder.WriteString("<table>\n<tr><th></th><th>#</th><th>Start (UTC)</th><th>Rise</th><th>Max Elevation</th><th>Set</th><th>Magnitude</th></tr>\n")
+	for i, pass := range data.Passes {
+		builder.WriteString(fmt.Sprintf("<tr><td><span class=\"swatch\" style=\"background: %s\"></span></td><td>%d</td><td>%s</td><td>%s</td><td>%.1f° %s</td><td>%s</td><td>%.1f</td></tr>\n",
+			skyPlotColors[i%len(skyPlotColors)], i+1,
+			time.Unix(int64(pass.StartUTC), 0).UTC().Format("2006-01-02 15:04:05"),
+			html.EscapeString(pass.StartAzCompass), pass.MaxEl, html.EscapeString(pass.MaxAzCompass),
+			html.EscapeString(pass.EndAzCompass), pass.Mag))
+	}
+	builder.WriteString("</table>\n</body>\n</html>\n")
+
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSkyPlotPoint(t *testing.T) {
+	center := skyPlotSize / 2.0
+	tests := []struct {
+		name               string
+		azimuth, elevation float64
+		wantX, wantY       float64
+	}{
+		{"zenith at centre", 123, 90, center, center},
+		{"north horizon at top", 0, 0, center, center - skyPlotRadius},
+		{"east horizon at right", 90, 0, center + skyPlotRadius, center},
+		{"south at 45 degrees", 180, 45, center, center + skyPlotRadius/2},
+		{"below horizon clamped to rim", 270, -5, center - skyPlotRadius, center},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := skyPlotPoint(tt.azimuth, tt.elevation)
+			if math.Abs(x-tt.wantX) > 1e-9 || math.Abs(y-tt.wantY) > 1e-9 {
+				t.Errorf("skyPlotPoint(%v, %v) = (%.2f, %.2f), want (%.2f, %.2f)", tt.azimuth, tt.elevation, x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestSkyPlotPath_TurnsShortWayRound(t *testing.T) {
+	// Rising in the north-west and setting in the north-east should pass through north, not south
+	path, moves := skyPlotPath(Pass{StartAz: 315, MaxAz: 0, MaxEl: 40, EndAz: 45})
+	if !moves {
+		t.Fatal("skyPlotPath() reported no movement for a real pass")
+	}
+	center := skyPlotSize / 2.0
+	points := strings.Split(strings.TrimPrefix(path, "M "), " L ")
+	for _, point := range points {
+		var x, y float64
+		if _, err := fmt.Sscan(point, &x, &y); err != nil {
+			t.Fatalf("unexpected path point %q: %v", point, err)
+		}
+		if y > center+0.01 {
+			t.Errorf("path point %q lies in the southern half of the sky", point)
+		}
+	}
+}
+
+func TestGeneratePolarPlotHTML(t *testing.T) {
+	data := VisualPassesResponse{
+		Info: Info{SatName: "ISS <ZARYA>", SatID: 25544},
+		Passes: []Pass{
+			{StartAz: 300, StartAzCompass: "NW", StartEl: 10, StartUTC: 1700000000, MaxAz: 30, MaxAzCompass: "<NNE>", MaxEl: 60, EndAz: 120, EndAzCompass: "ESE", EndEl: 10, Mag: -3.1},
+			{StartAz: 200, StartAzCompass: "SSW", StartEl: 15, StartUTC: 1700090000, MaxAz: 200, MaxAzCompass: "SSW", MaxEl: 15, EndAz: 200, EndAzCompass: "SSW", EndEl: 15, Mag: 1.2},
+		},
+	}
+	filePath := filepath.Join(t.TempDir(), "sky.html")
+	if err := GeneratePolarPlotHTML(data, filePath); err != nil {
+		t.Fatalf("GeneratePolarPlotHTML() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read plot: %v", err)
+	}
+	page := string(content)
+	if strings.Count(page, `class="pass-arc"`) != 1 {
+		t.Errorf("want one arc for the moving pass, got:\n%s", page)
+	}
+	if strings.Count(page, `class="pass-point"`) != 1 {
+		t.Errorf("want a point for the pass with identical start, max and end, got:\n%s", page)
+	}
+	if !strings.Contains(page, "ISS &lt;ZARYA&gt;") || strings.Contains(page, "ISS <ZARYA>") {
+		t.Error("satellite name should be HTML-escaped")
+	}
+	if strings.Count(page, "&lt;NNE&gt;") != 2 || strings.Contains(page, "<NNE>") {
+		t.Error("compass directions should be HTML-escaped in the pass title and table")
+	}
+
+	if err := GeneratePolarPlotHTML(VisualPassesResponse{}, filepath.Join(t.TempDir(), "empty.html")); err == nil {
+		t.Error("GeneratePolarPlotHTML() with no passes should fail")
+	}
+}
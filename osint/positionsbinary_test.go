@@ -0,0 +1,98 @@
+package osint
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPositionsBinary_RoundTrip(t *testing.T) {
+	start := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+	positions, err := CalculateSGP4Positions(testTLELine1, testTLELine2, start, start.Add(6*time.Hour), 10*time.Second)
+	if err != nil {
+		t.Fatalf("CalculateSGP4Positions() failed: %v", err)
+	}
+	// Values that text formats commonly mangle must survive too
+	positions = append(positions,
+		SGPPosition{Latitude: math.Copysign(0, -1), Longitude: math.SmallestNonzeroFloat64, Altitude: math.MaxFloat64, Velocity: math.NaN(), Timestamp: -1},
+	)
+
+	path := filepath.Join(t.TempDir(), "track.spos")
+	if err := ExportPositionsBinary(positions, path); err != nil {
+		t.Fatalf("ExportPositionsBinary() failed: %v", err)
+	}
+	loaded, err := LoadPositionsBinary(path)
+	if err != nil {
+		t.Fatalf("LoadPositionsBinary() failed: %v", err)
+	}
+
+	if len(loaded) != len(positions) {
+		t.Fatalf("loaded %d positions, want %d", len(loaded), len(positions))
+	}
+	for i := range positions {
+		want, got := positions[i], loaded[i]
+		same := math.Float64bits(got.Latitude) == math.Float64bits(want.Latitude) &&
+			math.Float64bits(got.Longitude) == math.Float64bits(want.Longitude) &&
+			math.Float64bits(got.Altitude) == math.Float64bits(want.Altitude) &&
+			math.Float64bits(got.Velocity) == math.Float64bits(want.Velocity) &&
+			got.Timestamp == want.Timestamp
+		if !same {
+			t.Fatalf("position %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	// The point of the format: smaller than the same series as JSON
+	jsonData, _ := json.Marshal(positions[:len(positions)-1])
+	info, _ := os.Stat(path)
+	if info.Size() >= int64(len(jsonData)) {
+		t.Errorf("binary file is %d bytes, JSON is %d", info.Size(), len(jsonData))
+	}
+}
+
+func TestPositionsBinary_Empty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.spos")
+	if err := ExportPositionsBinary(nil, path); err != nil {
+		t.Fatalf("ExportPositionsBinary() failed: %v", err)
+	}
+	loaded, err := LoadPositionsBinary(path)
+	if err != nil || len(loaded) != 0 {
+		t.Errorf("LoadPositionsBinary() = %v, %v; want no positions", loaded, err)
+	}
+}
+
+func TestLoadPositionsBinary_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	notGzip := filepath.Join(dir, "track.json")
+	os.WriteFile(notGzip, []byte(`[{"Latitude":1}]`), 0644)
+
+	wrongMagic := filepath.Join(dir, "other.gz")
+	writeGzip(t, wrongMagic, []byte("JUNKDATA1234"))
+
+	// The header claims more records than follow
+	truncated := filepath.Join(dir, "truncated.spos")
+	header := append([]byte(positionsMagic), positionsVersion, 3, 0, 0, 0)
+	writeGzip(t, truncated, append(header, make([]byte, positionRecordBytes)...))
+
+	for _, path := range []string{notGzip, wrongMagic, truncated, filepath.Join(dir, "missing.spos")} {
+		if _, err := LoadPositionsBinary(path); err == nil {
+			t.Errorf("LoadPositionsBinary(%s) should fail", filepath.Base(path))
+		}
+	}
+}
+
+func writeGzip(t *testing.T, path string, data []byte) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	zw := gzip.NewWriter(file)
+	zw.Write(data)
+	zw.Close()
+}
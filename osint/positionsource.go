@@ -0,0 +1,182 @@
+package osint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// PositionSource selects where current satellite positions come from.
+type PositionSource string
+
+const (
+	// PositionSourceN2YO asks the N2YO API, which uses request quota.
+	PositionSourceN2YO PositionSource = "n2yo"
+	// PositionSourceSGP4 propagates a cached TLE locally with SGP4.
+	PositionSourceSGP4 PositionSource = "sgp4"
+)
+
+// DefaultPositionSource is the source preselected when fetching current positions.
+var DefaultPositionSource = PositionSourceN2YO
+
+// ParsePositionSource converts a config value such as "sgp4" to a PositionSource.
+func ParsePositionSource(name string) (PositionSource, error) {
+	switch source := PositionSource(strings.ToLower(strings.TrimSpace(name))); source {
+	case PositionSourceN2YO, PositionSourceSGP4:
+		return source, nil
+	}
+	return "", fmt.Errorf("unknown position source %q (use n2yo or sgp4)", name)
+}
+
+// cachedTLE returns the element set for a satellite, refreshing it from Space-Track when the cached
+// copy is older than tleCacheMaxAge. In offline mode the cached copy is used whatever its age.
+func cachedTLE(norad string) (string, string, error) {
+	cached, found := lookupCachedTLE(norad)
+	if found && (Offline || time.Since(cached.FetchedAt) < tleCacheMaxAge) {
+		return cached.Line1, cached.Line2, nil
+	}
+	if Offline {
+		return "", "", NewAppErrorWithContext(ErrCodeTLEInsufficientData, "No cached TLE for this satellite",
+			"NORAD ID: "+norad+", fetch it once online to cache it")
+	}
+	return newSnapshotTLESource()(context.Background(), norad)
+}
+
+// SGP4PositionResponse propagates a TLE for count consecutive seconds from start and returns the
+// positions in the same Response shape as the N2YO positions endpoint.
+func SGP4PositionResponse(name, line1, line2 string, observer ObserverPosition, start time.Time, count int) (Response, error) {
+	line1 = strings.TrimSpace(line1)
+	if len(line1) < 7 {
+		return Response{}, NewAppError(ErrCodeTLEInsufficientData, "TLE line 1 is too short")
+	}
+	satid, err := strconv.Atoi(strings.TrimSpace(line1[2:7]))
+	if err != nil {
+		return Response{}, NewAppErrorWithErr(ErrCodeTLEParseFailed, "Invalid catalog number in TLE line 1", err)
+	}
+
+	data := Response{SatelliteInfo: SatelliteInfo{Satname: name, Satid: satid}}
+	for i := 0; i < count; i++ {
+		t := start.Add(time.Duration(i) * time.Second)
+		result, err := CalculateSGP4PositionWithObserver(line1, line2, t, observer)
+		if err != nil {
+			return Response{}, err
+		}
+		data.Positions = append(data.Positions, Position{
+			Satlatitude:  result.Position.Latitude,
+			Satlongitude: result.Position.Longitude,
+			Sataltitude:  result.Position.Altitude,
+			Azimuth:      result.LookAngles.Azimuth,
+			Elevation:    result.LookAngles.Elevation,
+			Ra:           result.LookAngles.RightAscension,
+			Dec:          result.LookAngles.Declination,
+			Timestamp:    t.Unix(),
+		})
+	}
+	return data, nil
+}
+
+// DensifyTrack adds up to samples SGP4 positions evenly spaced between the first and last
+// positions of data, so maps draw a smooth track without more API requests. The original
+// positions, including both endpoints, are kept unchanged. Added positions fall on whole seconds,
+// so one that would repeat the timestamp before it is skipped and timestamps strictly increase.
+// Look angles of the added positions are not computed.
+func DensifyTrack(data Response, line1, line2 string, samples int) Response {
+	if samples <= 0 || len(data.Positions) < 2 {
+		return data
+	}
+	positions := append([]Position(nil), data.Positions...)
+	sort.SliceStable(positions, func(i, j int) bool { return positions[i].Timestamp < positions[j].Timestamp })
+	first, last := positions[0], positions[len(positions)-1]
+	span := time.Duration(last.Timestamp-first.Timestamp) * time.Second
+	if span <= 0 {
+		return data
+	}
+
+	dense := []Position{first}
+	start := time.Unix(first.Timestamp, 0).UTC()
+	step := span / time.Duration(samples+1)
+	sample := 1
+	for _, next := range positions[1:] {
+		// Fill the segment up to next, skipping the first point of a segment when it lands on the
+		// second of the position it starts from
+		for ; sample <= samples; sample++ {
+			t := start.Add(time.Duration(sample) * step)
+			if t.Unix() >= next.Timestamp {
+				break
+			}
+			if t.Unix() <= dense[len(dense)-1].Timestamp {
+				continue
+			}
+			pos, err := CalculateSGP4Position(line1, line2, t)
+			if err != nil {
+				continue
+			}
+			dense = append(dense, Position{
+				Satlatitude:  pos.Latitude,
+				Satlongitude: pos.Longitude,
+				Sataltitude:  pos.Altitude,
+				Timestamp:    t.Unix(),
+			})
+		}
+		dense = append(dense, next)
+	}
+
+	return Response{SatelliteInfo: data.SatelliteInfo, Positions: dense}
+}
+
+// knownSatelliteName looks up a satellite's name in favorites and recently viewed satellites.
+func knownSatelliteName(norad string) string {
+	if favorites, err := LoadFavorites(); err == nil {
+		for _, fav := range favorites {
+			if fav.NORADID == norad {
+				return fav.SatelliteName
+			}
+		}
+	}
+	if recent, err := LoadRecent(); err == nil {
+		for _, entry := range recent {
+			if entry.NORADID == norad {
+				return entry.SatelliteName
+			}
+		}
+	}
+	return "NORAD " + norad
+}
+
+// choosePositionSource asks which source to use, starting on DefaultPositionSource.
+// Non-interactive runs use the default.
+func choosePositionSource() (PositionSource, bool) {
+	if NonInteractive {
+		return DefaultPositionSource, true
+	}
+
+	sources := []PositionSource{PositionSourceN2YO, PositionSourceSGP4}
+	cursor := 0
+	if DefaultPositionSource == PositionSourceSGP4 {
+		cursor = 1
+	}
+	prompt := promptui.Select{
+		Label:     "Position Source",
+		Items:     []string{"N2YO API", "SGP4 from cached TLE (no N2YO quota)"},
+		CursorPos: cursor,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return "", false
+	}
+	return sources[idx], true
+}
+
+// sgp4CurrentPositions fetches positions for the next seconds seconds using SGP4 on a cached TLE.
+func sgp4CurrentPositions(norad string, observer ObserverPosition, seconds int) (Response, error) {
+	line1, line2, err := cachedTLE(norad)
+	if err != nil {
+		return Response{}, err
+	}
+	return SGP4PositionResponse(knownSatelliteName(norad), line1, line2, observer, time.Now().UTC(), seconds)
+}
@@ -0,0 +1,153 @@
+package osint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSGP4PositionResponse(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060, Altitude: 10}
+	start := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+
+	data, err := SGP4PositionResponse("ISS (ZARYA)", testTLELine1, testTLELine2, observer, start, 3)
+	if err != nil {
+		t.Fatalf("SGP4PositionResponse() failed: %v", err)
+	}
+	if data.SatelliteInfo.Satid != 25544 || data.SatelliteInfo.Satname != "ISS (ZARYA)" {
+		t.Errorf("SatelliteInfo = %+v, want ISS (ZARYA) 25544", data.SatelliteInfo)
+	}
+	if len(data.Positions) != 3 {
+		t.Fatalf("Expected 3 positions, got %d", len(data.Positions))
+	}
+
+	for i, pos := range data.Positions {
+		at := start.Add(time.Duration(i) * time.Second)
+		if pos.Timestamp != at.Unix() {
+			t.Errorf("Position %d timestamp = %d, want %d", i, pos.Timestamp, at.Unix())
+		}
+
+		want, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, at, observer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.Satlatitude != want.Position.Latitude || pos.Satlongitude != want.Position.Longitude ||
+			pos.Sataltitude != want.Position.Altitude {
+			t.Errorf("Position %d = %+v, want %+v", i, pos, want.Position)
+		}
+		if pos.Azimuth != want.LookAngles.Azimuth || pos.Elevation != want.LookAngles.Elevation {
+			t.Errorf("Position %d look angles = %v/%v, want %v/%v", i, pos.Azimuth, pos.Elevation,
+				want.LookAngles.Azimuth, want.LookAngles.Elevation)
+		}
+		if pos.Sataltitude < 300 || pos.Sataltitude > 500 {
+			t.Errorf("Position %d altitude %v km is not an ISS altitude", i, pos.Sataltitude)
+		}
+		if pos.Ra < 0 || pos.Ra >= 360 || pos.Dec < -90 || pos.Dec > 90 {
+			t.Errorf("Position %d RA/Dec out of range: %v/%v", i, pos.Ra, pos.Dec)
+		}
+	}
+
+	if _, err := SGP4PositionResponse("", "1", testTLELine2, observer, start, 1); err == nil {
+		t.Error("Expected error for truncated TLE")
+	}
+}
+
+func TestCachedTLE(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	withSnapshotTLEs(t, map[string][2]string{"25544": {testTLELine1, testTLELine2}})
+
+	line1, line2, err := cachedTLE("25544")
+	if err != nil || line1 != testTLELine1 || line2 != testTLELine2 {
+		t.Fatalf("cachedTLE() = %q, %q, %v", line1, line2, err)
+	}
+
+	// A cached TLE is available offline.
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+	withSnapshotTLEs(t, map[string][2]string{})
+	Offline = true
+	t.Cleanup(func() { Offline = false })
+	if line1, _, err := cachedTLE("25544"); err != nil || line1 != testTLELine1 {
+		t.Errorf("Offline cachedTLE() = %q, %v; want cached line", line1, err)
+	}
+	if _, _, err := cachedTLE("5"); err == nil {
+		t.Error("Expected error for uncached satellite in offline mode")
+	}
+}
+
+func TestParsePositionSource(t *testing.T) {
+	if source, err := ParsePositionSource(" SGP4 "); err != nil || source != PositionSourceSGP4 {
+		t.Errorf("ParsePositionSource(SGP4) = %q, %v", source, err)
+	}
+	if source, err := ParsePositionSource("n2yo"); err != nil || source != PositionSourceN2YO {
+		t.Errorf("ParsePositionSource(n2yo) = %q, %v", source, err)
+	}
+	if _, err := ParsePositionSource("celestrak"); err == nil {
+		t.Error("Expected error for unknown source")
+	}
+}
+
+func TestDensifyTrack(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+	first, err := SGP4PositionResponse("ISS (ZARYA)", testTLELine1, testTLELine2, observer, start, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, err := SGP4PositionResponse("ISS (ZARYA)", testTLELine1, testTLELine2, observer, start.Add(10*time.Minute), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := Response{SatelliteInfo: first.SatelliteInfo, Positions: []Position{first.Positions[0], last.Positions[0]}}
+
+	dense := DensifyTrack(data, testTLELine1, testTLELine2, 9)
+	if len(dense.Positions) != 11 {
+		t.Fatalf("Expected 11 positions after densifying, got %d", len(dense.Positions))
+	}
+	if dense.Positions[0] != data.Positions[0] || dense.Positions[10] != data.Positions[1] {
+		t.Errorf("Endpoints changed: %+v ... %+v", dense.Positions[0], dense.Positions[10])
+	}
+	for i := 1; i < len(dense.Positions); i++ {
+		if dense.Positions[i].Timestamp <= dense.Positions[i-1].Timestamp {
+			t.Errorf("Position %d at %d is not after %d", i, dense.Positions[i].Timestamp, dense.Positions[i-1].Timestamp)
+		}
+	}
+	if dense.SatelliteInfo != data.SatelliteInfo {
+		t.Errorf("SatelliteInfo = %+v, want %+v", dense.SatelliteInfo, data.SatelliteInfo)
+	}
+
+	if unchanged := DensifyTrack(data, testTLELine1, testTLELine2, 0); len(unchanged.Positions) != 2 {
+		t.Errorf("Expected no densification with 0 samples, got %d positions", len(unchanged.Positions))
+	}
+}
+
+func TestDensifyTrack_TimestampsStrictlyIncrease(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+	// N2YO returns one position per second, closer together than the samples asked for
+	data, err := SGP4PositionResponse("ISS (ZARYA)", testTLELine1, testTLELine2, observer, start, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data.Positions = append(data.Positions, data.Positions[3])
+	data.Positions[4].Timestamp += 10
+
+	dense := DensifyTrack(data, testTLELine1, testTLELine2, 20)
+	for i := 1; i < len(dense.Positions); i++ {
+		if dense.Positions[i].Timestamp <= dense.Positions[i-1].Timestamp {
+			t.Errorf("Position %d at %d is not after %d", i, dense.Positions[i].Timestamp, dense.Positions[i-1].Timestamp)
+		}
+	}
+	kept := 0
+	for _, pos := range dense.Positions {
+		for _, original := range data.Positions {
+			if pos == original {
+				kept++
+			}
+		}
+	}
+	if kept != len(data.Positions) {
+		t.Errorf("Kept %d of the %d original positions", kept, len(data.Positions))
+	}
+	if len(dense.Positions) <= len(data.Positions) {
+		t.Errorf("Expected positions added in the 10-second gap, got %d", len(dense.Positions))
+	}
+}
@@ -1,27 +1,37 @@
 package osint
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
-
-	"github.com/TwiN/go-color"
 )
 
-// Spinner provides an animated loading spinner for indeterminate operations.
-type Spinner struct {
+// Spinner shows that an indeterminate operation is in progress.
+type Spinner interface {
+	Start()
+	StartWithContext(ctx context.Context)
+	Stop()
+	UpdateMessage(message string)
+}
+
+// TerminalSpinner provides an animated loading spinner for indeterminate operations.
+type TerminalSpinner struct {
 	chars    []string
 	index    int
 	message  string
 	stopChan chan bool
 	doneChan chan bool
 	running  bool
+	mu       sync.Mutex
 }
 
 // NewSpinner creates a new spinner with a custom message.
-func NewSpinner(message string) *Spinner {
-	return &Spinner{
+func NewSpinner(message string) *TerminalSpinner {
+	return &TerminalSpinner{
 		chars:    []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
 		index:    0,
 		message:  message,
@@ -32,11 +42,20 @@ func NewSpinner(message string) *Spinner {
 }
 
 // Start begins the spinner animation in a goroutine.
-func (s *Spinner) Start() {
+func (s *TerminalSpinner) Start() {
+	s.StartWithContext(context.Background())
+}
+
+// StartWithContext begins the spinner animation and stops it as soon as ctx is done,
+// reporting whether the operation timed out or was cancelled.
+func (s *TerminalSpinner) StartWithContext(ctx context.Context) {
+	s.mu.Lock()
 	if s.running {
+		s.mu.Unlock()
 		return
 	}
 	s.running = true
+	s.mu.Unlock()
 
 	go func() {
 		ticker := time.NewTicker(100 * time.Millisecond)
@@ -47,8 +66,22 @@ func (s *Spinner) Start() {
 			case <-s.stopChan:
 				s.doneChan <- true
 				return
+			case <-ctx.Done():
+				s.mu.Lock()
+				if !s.running {
+					// Stop is already waiting on us
+					s.mu.Unlock()
+					<-s.stopChan
+					s.doneChan <- true
+					return
+				}
+				s.running = false
+				s.mu.Unlock()
+				s.clearLine()
+				s.reportContextDone(ctx.Err())
+				return
 			case <-ticker.C:
-				fmt.Printf("\r%s %s", color.Ize(color.Cyan, s.chars[s.index]), color.Ize(color.Cyan, s.message))
+				fmt.Printf("\r%s %s", Colorize(RoleInfo, s.chars[s.index]), Colorize(RoleInfo, s.message))
 				s.index = (s.index + 1) % len(s.chars)
 			}
 		}
@@ -56,21 +89,69 @@ func (s *Spinner) Start() {
 }
 
 // Stop stops the spinner and clears the line.
-func (s *Spinner) Stop() {
+func (s *TerminalSpinner) Stop() {
+	s.mu.Lock()
 	if !s.running {
+		s.mu.Unlock()
 		return
 	}
+	s.running = false
+	s.mu.Unlock()
+
 	s.stopChan <- true
 	<-s.doneChan
+	s.clearLine()
+}
+
+// Running reports whether the spinner is still animating.
+func (s *TerminalSpinner) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// clearLine erases the spinner from the current terminal line.
+func (s *TerminalSpinner) clearLine() {
 	fmt.Print("\r" + strings.Repeat(" ", len(s.message)+10) + "\r")
-	s.running = false
+}
+
+// reportContextDone prints why the spinner's operation ended early.
+func (s *TerminalSpinner) reportContextDone(err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		fmt.Println(Colorize(RoleError, "  [!] "+s.message+" timed out"))
+	} else {
+		fmt.Println(Colorize(RoleWarning, "  [!] "+s.message+" cancelled"))
+	}
 }
 
 // UpdateMessage updates the spinner message while it's running.
-func (s *Spinner) UpdateMessage(message string) {
+func (s *TerminalSpinner) UpdateMessage(message string) {
 	s.message = message
 }
 
+// noopSpinner is used when there is no terminal to animate, so redirected output stays free of
+// spinner frames.
+type noopSpinner struct{}
+
+func (noopSpinner) Start()                               {}
+func (noopSpinner) StartWithContext(ctx context.Context) {}
+func (noopSpinner) Stop()                                {}
+func (noopSpinner) UpdateMessage(message string)         {}
+
+// stdoutIsTerminal reports whether stdout is a terminal, which decides whether progress output
+// is animated.
+var stdoutIsTerminal = IsTerminal
+
+// newSpinner creates the spinner for a progress message: animated on a terminal, a no-op when
+// output is redirected or the session is non-interactive. Every progress helper creates its
+// spinner through it.
+var newSpinner = func(message string) Spinner {
+	if NonInteractive || !stdoutIsTerminal() {
+		return noopSpinner{}
+	}
+	return NewSpinner(message)
+}
+
 // ProgressBar provides a progress bar for operations with known progress.
 type ProgressBar struct {
 	total     int
@@ -132,8 +213,8 @@ func (pb *ProgressBar) render() {
 
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", empty)
 	fmt.Printf("\r%s [%s] %d/%d (%.1f%%)", 
-		color.Ize(color.Cyan, pb.message),
-		color.Ize(color.Green, bar),
+		Colorize(RoleInfo, pb.message),
+		Colorize(RoleSuccess, bar),
 		pb.current,
 		pb.total,
 		percentage)
@@ -152,7 +233,7 @@ func (pb *ProgressBar) Complete() {
 
 // ShowProgress shows a simple progress message for operations.
 func ShowProgress(message string) {
-	fmt.Print(color.Ize(color.Cyan, "  [*] "+message+"..."))
+	fmt.Print(Colorize(RoleInfo, "  [*] "+message+"..."))
 }
 
 // HideProgress clears the progress message.
@@ -161,19 +242,26 @@ func HideProgress() {
 }
 
 // ShowProgressWithSpinner shows a progress message with a spinner.
-func ShowProgressWithSpinner(message string) *Spinner {
-	spinner := NewSpinner(message)
+func ShowProgressWithSpinner(message string) Spinner {
+	spinner := newSpinner(message)
 	spinner.Start()
 	return spinner
 }
 
+// ShowProgressWithSpinnerContext shows a progress message with a spinner that stops when ctx is done.
+func ShowProgressWithSpinnerContext(ctx context.Context, message string) Spinner {
+	spinner := newSpinner(message)
+	spinner.StartWithContext(ctx)
+	return spinner
+}
+
 // ShowProgressWithBar shows a progress message with a progress bar.
 func ShowProgressWithBar(total int, message string) *ProgressBar {
 	return NewProgressBar(total, message)
 }
 
 // ShowAPIProgress shows a progress indicator for API calls.
-func ShowAPIProgress(operation string) *Spinner {
+func ShowAPIProgress(operation string) Spinner {
 	message := fmt.Sprintf("Fetching %s", operation)
 	return ShowProgressWithSpinner(message)
 }
@@ -189,12 +277,22 @@ func ShowBatchProgress(current, total int, item string) {
 }
 
 // ShowLoginProgress shows progress for login operations.
-func ShowLoginProgress() *Spinner {
-	return ShowProgressWithSpinner("Authenticating with Space-Track")
+func ShowLoginProgress() Spinner {
+	return ShowLoginProgressContext(context.Background())
+}
+
+// ShowLoginProgressContext shows progress for a login that is bounded by ctx.
+func ShowLoginProgressContext(ctx context.Context) Spinner {
+	return ShowProgressWithSpinnerContext(ctx, "Authenticating with Space-Track")
 }
 
 // ShowQueryProgress shows progress for query operations.
-func ShowQueryProgress(endpoint string) *Spinner {
+func ShowQueryProgress(endpoint string) Spinner {
+	return ShowQueryProgressContext(context.Background(), endpoint)
+}
+
+// ShowQueryProgressContext shows progress for a query that is bounded by ctx.
+func ShowQueryProgressContext(ctx context.Context, endpoint string) Spinner {
 	// Extract a readable description from the endpoint
 	desc := "satellite data"
 	if strings.Contains(endpoint, "satcat") {
@@ -204,11 +302,11 @@ func ShowQueryProgress(endpoint string) *Spinner {
 	} else if strings.Contains(endpoint, "gp_history") {
 		desc = "orbital history"
 	}
-	return ShowProgressWithSpinner(fmt.Sprintf("Querying %s", desc))
+	return ShowProgressWithSpinnerContext(ctx, fmt.Sprintf("Querying %s", desc))
 }
 
 // ShowDownloadProgress shows progress for download operations.
-func ShowDownloadProgress(filename string) *Spinner {
+func ShowDownloadProgress(filename string) Spinner {
 	return ShowProgressWithSpinner(fmt.Sprintf("Downloading %s", filename))
 }
 
@@ -224,9 +322,9 @@ func IsTerminal() bool {
 // ShowSimpleProgress shows a simple progress message that works in all environments.
 func ShowSimpleProgress(message string) {
 	if IsTerminal() {
-		fmt.Print(color.Ize(color.Cyan, "  [*] "+message+"..."))
+		fmt.Print(Colorize(RoleInfo, "  [*] "+message+"..."))
 	} else {
-		fmt.Println(color.Ize(color.Cyan, "  [*] "+message+"..."))
+		fmt.Println(Colorize(RoleInfo, "  [*] "+message+"..."))
 	}
 }
 
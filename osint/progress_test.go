@@ -1,6 +1,9 @@
 package osint
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 )
@@ -153,11 +156,28 @@ func TestShowProgress(t *testing.T) {
 	HideProgress()
 }
 
+// withTerminalStdout makes the spinner factory behave as if stdout were an interactive terminal.
+func withTerminalStdout(t *testing.T) {
+	t.Helper()
+	origTerminal, origNonInteractive := stdoutIsTerminal, NonInteractive
+	stdoutIsTerminal = func() bool { return true }
+	NonInteractive = false
+	t.Cleanup(func() { stdoutIsTerminal, NonInteractive = origTerminal, origNonInteractive })
+}
+
+// terminalSpinner asserts that spinner animates and returns it.
+func terminalSpinner(t *testing.T, spinner Spinner) *TerminalSpinner {
+	t.Helper()
+	ts, ok := spinner.(*TerminalSpinner)
+	if !ok {
+		t.Fatalf("spinner is %T, want *TerminalSpinner", spinner)
+	}
+	return ts
+}
+
 func TestShowProgressWithSpinner(t *testing.T) {
-	spinner := ShowProgressWithSpinner("Test")
-	if spinner == nil {
-		t.Fatal("ShowProgressWithSpinner() returned nil")
-	}
+	withTerminalStdout(t)
+	spinner := terminalSpinner(t, ShowProgressWithSpinner("Test"))
 	if !spinner.running {
 		t.Error("Spinner should be running")
 	}
@@ -175,10 +195,8 @@ func TestShowProgressWithBar(t *testing.T) {
 }
 
 func TestShowAPIProgress(t *testing.T) {
-	spinner := ShowAPIProgress("test operation")
-	if spinner == nil {
-		t.Fatal("ShowAPIProgress() returned nil")
-	}
+	withTerminalStdout(t)
+	spinner := terminalSpinner(t, ShowAPIProgress("test operation"))
 	if spinner.message != "Fetching test operation" {
 		t.Errorf("message = %q, want %q", spinner.message, "Fetching test operation")
 	}
@@ -186,10 +204,8 @@ func TestShowAPIProgress(t *testing.T) {
 }
 
 func TestShowLoginProgress(t *testing.T) {
-	spinner := ShowLoginProgress()
-	if spinner == nil {
-		t.Fatal("ShowLoginProgress() returned nil")
-	}
+	withTerminalStdout(t)
+	spinner := terminalSpinner(t, ShowLoginProgress())
 	if spinner.message != "Authenticating with Space-Track" {
 		t.Errorf("message = %q, want %q", spinner.message, "Authenticating with Space-Track")
 	}
@@ -197,16 +213,14 @@ func TestShowLoginProgress(t *testing.T) {
 }
 
 func TestShowQueryProgress(t *testing.T) {
-	spinner := ShowQueryProgress("/class/satcat")
-	if spinner == nil {
-		t.Fatal("ShowQueryProgress() returned nil")
-	}
+	withTerminalStdout(t)
+	spinner := terminalSpinner(t, ShowQueryProgress("/class/satcat"))
 	if spinner.message != "Querying satellite catalog" {
 		t.Errorf("message = %q, want %q", spinner.message, "Querying satellite catalog")
 	}
 	spinner.Stop()
 	
-	spinner2 := ShowQueryProgress("/class/gp_history/format/tle")
+	spinner2 := terminalSpinner(t, ShowQueryProgress("/class/gp_history/format/tle"))
 	if spinner2.message != "Querying TLE data" {
 		t.Errorf("message = %q, want %q", spinner2.message, "Querying TLE data")
 	}
@@ -256,3 +270,127 @@ func BenchmarkProgressBarIncrement(b *testing.B) {
 	}
 }
 
+
+// waitForSpinnerStop polls until the spinner stops or the deadline passes.
+func waitForSpinnerStop(t *testing.T, spinner *TerminalSpinner) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for spinner.Running() {
+		if time.Now().After(deadline) {
+			t.Fatal("Spinner did not stop after its context was done")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSpinnerStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	spinner := NewSpinner("Testing cancel")
+	spinner.StartWithContext(ctx)
+	if !spinner.Running() {
+		t.Fatal("Spinner should be running after StartWithContext()")
+	}
+
+	cancel()
+	waitForSpinnerStop(t, spinner)
+
+	// Stop after an automatic stop must not block
+	spinner.Stop()
+}
+
+func TestSpinnerStopsOnDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	spinner := NewSpinner("Testing timeout")
+	spinner.StartWithContext(ctx)
+	waitForSpinnerStop(t, spinner)
+}
+
+func TestSpinnerStopBeforeContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	spinner := NewSpinner("Testing stop")
+	spinner.StartWithContext(ctx)
+	spinner.Stop()
+	cancel()
+
+	if spinner.Running() {
+		t.Error("Spinner should not be running after Stop()")
+	}
+}
+
+func TestQuerySpaceTrackContext_Cancelled(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	if _, err := QuerySpaceTrackContext(ctx, client, "/class/satcat"); err == nil {
+		t.Error("Expected error from cancelled query")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Cancelled query took %v to return", elapsed)
+	}
+}
+
+func TestQuerySpaceTrack_Timeout(t *testing.T) {
+	original := requestTimeout
+	requestTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { requestTimeout = original })
+
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	_, err := QuerySpaceTrack(client, "/class/satcat")
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeNetworkTimeout {
+		t.Errorf("Expected %s error, got %v", ErrCodeNetworkTimeout, err)
+	}
+}
+
+func TestNewSpinner_NoopWithoutTerminal(t *testing.T) {
+	origTerminal, origNonInteractive := stdoutIsTerminal, NonInteractive
+	defer func() { stdoutIsTerminal, NonInteractive = origTerminal, origNonInteractive }()
+
+	stdoutIsTerminal = func() bool { return false }
+	NonInteractive = false
+	if spinner := ShowProgressWithSpinner("Redirected"); spinner != (noopSpinner{}) {
+		t.Errorf("spinner without a terminal is %T, want noopSpinner", spinner)
+	}
+
+	stdoutIsTerminal = func() bool { return true }
+	NonInteractive = true
+	if spinner := ShowProgressWithSpinner("Non-interactive"); spinner != (noopSpinner{}) {
+		t.Errorf("spinner in non-interactive mode is %T, want noopSpinner", spinner)
+	}
+}
+
+// recordingSpinner counts Start and Stop calls.
+type recordingSpinner struct {
+	noopSpinner
+	starts, stops int
+}
+
+func (r *recordingSpinner) StartWithContext(ctx context.Context) { r.starts++ }
+func (r *recordingSpinner) Stop()                                { r.stops++ }
+
+func TestQuerySpaceTrack_StartsAndStopsSpinner(t *testing.T) {
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	})
+	recorder := &recordingSpinner{}
+	origNewSpinner := newSpinner
+	newSpinner = func(message string) Spinner { return recorder }
+	defer func() { newSpinner = origNewSpinner }()
+
+	if _, err := QuerySpaceTrack(client, "/class/satcat"); err != nil {
+		t.Fatalf("QuerySpaceTrack() failed: %v", err)
+	}
+	if recorder.starts != 1 || recorder.stops == 0 {
+		t.Errorf("spinner started %d and stopped %d times, want started once and stopped", recorder.starts, recorder.stops)
+	}
+}
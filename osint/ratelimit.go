@@ -0,0 +1,146 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultN2YORateLimit is the number of requests per hour sent to each N2YO endpoint when
+// N2YO_RATE_LIMIT is not set.
+const defaultN2YORateLimit = 1000
+
+// N2YORateLimit is the most requests per hour SatIntel sends to each N2YO endpoint, capped by the
+// endpoint's own limit in n2yoHourlyLimits. Zero turns rate limiting off. main sets it from
+// N2YO_RATE_LIMIT.
+var N2YORateLimit = defaultN2YORateLimit
+
+// SetN2YORateLimit parses and applies a requests-per-hour value such as the one in N2YO_RATE_LIMIT.
+func SetN2YORateLimit(value string) error {
+	limit, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || limit < 0 {
+		return fmt.Errorf("N2YO_RATE_LIMIT must be a whole number of requests per hour, got %q", value)
+	}
+	n2yoLimiter.Lock()
+	defer n2yoLimiter.Unlock()
+	N2YORateLimit = limit
+	n2yoLimiter.buckets = map[string]*tokenBucket{}
+	return nil
+}
+
+// tokenBucket holds up to capacity requests and refills at capacity per hour. Tokens go negative
+// when requests queue up, so each waiter is given its own turn.
+type tokenBucket struct {
+	capacity float64
+	tokens   float64
+	updated  time.Time
+}
+
+func newTokenBucket(capacity int, now time.Time) *tokenBucket {
+	return &tokenBucket{capacity: float64(capacity), tokens: float64(capacity), updated: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed.Hours()*b.capacity)
+		b.updated = now
+	}
+}
+
+// take reserves a request and returns how long to wait before sending it.
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.capacity * float64(time.Hour))
+}
+
+// observe lowers the bucket to what the server says is left, when requests made elsewhere (another
+// run or another program with the same key) have used part of the hour.
+func (b *tokenBucket) observe(used int, now time.Time) {
+	b.refill(now)
+	b.tokens = math.Min(b.tokens, b.capacity-float64(used))
+}
+
+// n2yoLimiter keeps a token bucket per N2YO endpoint, since N2YO counts transactions per endpoint.
+// Buckets are refilled by the now clock, and waits for a free token go through sleep.
+var n2yoLimiter = struct {
+	sync.Mutex
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+	sleep   func(time.Duration)
+}{buckets: map[string]*tokenBucket{}, now: time.Now, sleep: time.Sleep}
+
+// n2yoBucket returns the endpoint's bucket, or nil when rate limiting is off. The caller must hold
+// the n2yoLimiter lock.
+func n2yoBucket(endpoint string) *tokenBucket {
+	if N2YORateLimit <= 0 {
+		return nil
+	}
+	bucket, ok := n2yoLimiter.buckets[endpoint]
+	if !ok {
+		capacity := N2YORateLimit
+		if limit, known := n2yoHourlyLimits[endpoint]; known {
+			capacity = min(capacity, limit)
+		}
+		bucket = newTokenBucket(capacity, n2yoLimiter.now())
+		n2yoLimiter.buckets[endpoint] = bucket
+	}
+	return bucket
+}
+
+// waitForN2YO blocks until a request to the endpoint fits within the rate limit, counting down
+// the wait on a spinner.
+func waitForN2YO(endpoint string) {
+	n2yoLimiter.Lock()
+	var wait time.Duration
+	if bucket := n2yoBucket(endpoint); bucket != nil {
+		wait = bucket.take(n2yoLimiter.now())
+	}
+	sleep := n2yoLimiter.sleep
+	n2yoLimiter.Unlock()
+	if wait <= 0 {
+		return
+	}
+
+	message := func(left time.Duration) string {
+		return fmt.Sprintf("N2YO %s rate limit reached, next request in %s", endpoint, left.Round(time.Second))
+	}
+	spinner := newSpinner(message(wait))
+	spinner.Start()
+	defer spinner.Stop()
+	for left := wait; left > 0; {
+		step := min(left, time.Second)
+		sleep(step)
+		left -= step
+		spinner.UpdateMessage(message(left))
+	}
+}
+
+// observeN2YOUsage corrects the endpoint's bucket with the transactionscount N2YO reported for
+// the last hour.
+func observeN2YOUsage(endpoint string, transactions int) {
+	n2yoLimiter.Lock()
+	defer n2yoLimiter.Unlock()
+	if bucket := n2yoBucket(endpoint); bucket != nil {
+		bucket.observe(transactions, n2yoLimiter.now())
+	}
+}
+
+// N2YORateLimitRemaining returns how many requests to an N2YO endpoint can be sent now without
+// waiting, or -1 when rate limiting is off.
+func N2YORateLimitRemaining(endpoint string) int {
+	n2yoLimiter.Lock()
+	defer n2yoLimiter.Unlock()
+	bucket := n2yoBucket(endpoint)
+	if bucket == nil {
+		return -1
+	}
+	bucket.refill(n2yoLimiter.now())
+	return max(0, int(bucket.tokens))
+}
@@ -0,0 +1,143 @@
+package osint
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// withRateLimiter gives the test fresh N2YO buckets at the given limit and a fake clock whose
+// sleeps advance it instead of waiting. It returns the total time slept.
+func withRateLimiter(t *testing.T, limit int) *time.Duration {
+	t.Helper()
+	originalLimit, originalNow, originalSleep := N2YORateLimit, n2yoLimiter.now, n2yoLimiter.sleep
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	slept := new(time.Duration)
+	n2yoLimiter.now = func() time.Time { return now }
+	n2yoLimiter.sleep = func(d time.Duration) {
+		*slept += d
+		now = now.Add(d)
+	}
+	if err := SetN2YORateLimit(strconv.Itoa(limit)); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		n2yoLimiter.now, n2yoLimiter.sleep = originalNow, originalSleep
+		SetN2YORateLimit(strconv.Itoa(originalLimit))
+	})
+	return slept
+}
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(60, start)
+
+	for i := 0; i < 60; i++ {
+		if wait := bucket.take(start); wait != 0 {
+			t.Fatalf("request %d waited %v, want none while the bucket is full", i+1, wait)
+		}
+	}
+	if wait := bucket.take(start); wait != time.Minute {
+		t.Errorf("61st request waits %v, want 1m at 60 per hour", wait)
+	}
+	if wait := bucket.take(start); wait != 2*time.Minute {
+		t.Errorf("62nd request waits %v, want 2m behind the queued one", wait)
+	}
+
+	later := start.Add(time.Hour)
+	bucket.refill(later)
+	if bucket.tokens != 58 {
+		t.Errorf("tokens after an hour = %v, want 58 (refilled 60 from -2)", bucket.tokens)
+	}
+	bucket.refill(later.Add(time.Hour))
+	if bucket.tokens != 60 {
+		t.Errorf("tokens = %v, want the bucket capped at its capacity", bucket.tokens)
+	}
+}
+
+func TestTokenBucket_ObserveServerUsage(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(100, now)
+	bucket.take(now)
+
+	bucket.observe(40, now)
+	if bucket.tokens != 60 {
+		t.Errorf("tokens = %v, want 60 after the server reports 40 used", bucket.tokens)
+	}
+	bucket.observe(10, now)
+	if bucket.tokens != 60 {
+		t.Errorf("tokens = %v, a lower server count should not add tokens", bucket.tokens)
+	}
+}
+
+func TestWaitForN2YO_BlocksWhenEmpty(t *testing.T) {
+	slept := withRateLimiter(t, 2)
+
+	waitForN2YO("positions")
+	waitForN2YO("positions")
+	if *slept != 0 {
+		t.Fatalf("slept %v within the limit, want no wait", *slept)
+	}
+	if remaining := N2YORateLimitRemaining("positions"); remaining != 0 {
+		t.Errorf("N2YORateLimitRemaining() = %d, want 0", remaining)
+	}
+
+	waitForN2YO("positions")
+	if *slept != 30*time.Minute {
+		t.Errorf("slept %v, want 30m for the third request at 2 per hour", *slept)
+	}
+
+	// Other endpoints have buckets of their own
+	before := *slept
+	waitForN2YO("tle")
+	if *slept != before {
+		t.Error("a request to another endpoint should not wait")
+	}
+}
+
+func TestWaitForN2YO_Disabled(t *testing.T) {
+	slept := withRateLimiter(t, 0)
+	for i := 0; i < 5; i++ {
+		waitForN2YO("visualpasses")
+	}
+	if *slept != 0 || N2YORateLimitRemaining("visualpasses") != -1 {
+		t.Errorf("rate limiting should be off at 0, slept %v", *slept)
+	}
+}
+
+func TestN2YORateLimit_CappedByEndpointLimit(t *testing.T) {
+	withRateLimiter(t, 1000)
+	if remaining := N2YORateLimitRemaining("radiopasses"); remaining != n2yoHourlyLimits["radiopasses"] {
+		t.Errorf("N2YORateLimitRemaining(radiopasses) = %d, want the endpoint limit %d", remaining, n2yoHourlyLimits["radiopasses"])
+	}
+}
+
+func TestN2YORateLimit_CorrectsFromResponse(t *testing.T) {
+	withRateLimiter(t, 1000)
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		response := createTestResponse()
+		response.SatelliteInfo.Transactionscount = 995
+		json.NewEncoder(w).Encode(response)
+	})
+
+	if _, err := FetchSatellitePositions("25544", "0", "0", "0", 1); err != nil {
+		t.Fatalf("FetchSatellitePositions() failed: %v", err)
+	}
+	if remaining := N2YORateLimitRemaining("positions"); remaining != 5 {
+		t.Errorf("N2YORateLimitRemaining(positions) = %d, want 5 after N2YO reports 995 used", remaining)
+	}
+}
+
+func TestSetN2YORateLimit_RejectsInvalid(t *testing.T) {
+	withRateLimiter(t, 1000)
+	for _, value := range []string{"fast", "-1", "1.5"} {
+		if err := SetN2YORateLimit(value); err == nil {
+			t.Errorf("SetN2YORateLimit(%q) should fail", value)
+		}
+	}
+	if N2YORateLimit != 1000 {
+		t.Errorf("N2YORateLimit = %d, an invalid value should leave it unchanged", N2YORateLimit)
+	}
+}
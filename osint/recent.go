@@ -0,0 +1,67 @@
+package osint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const recentFile = "recent.json"
+
+// maxRecent is how many recently viewed satellites are kept.
+const maxRecent = 10
+
+// RecentSatellite is an entry in the recently viewed list.
+type RecentSatellite struct {
+	SatelliteName string `json:"satellite_name"`
+	NORADID       string `json:"norad_id"`
+	ViewedAt      string `json:"viewed_at"`
+}
+
+// Label returns the "NAME (NORAD)" form used by selection menus.
+func (r RecentSatellite) Label() string {
+	return r.SatelliteName + " (" + r.NORADID + ")"
+}
+
+// LoadRecent returns the recently viewed satellites, most recent first.
+func LoadRecent() ([]RecentSatellite, error) {
+	var recent []RecentSatellite
+	if _, err := loadJSONState(recentFile, &recent); err != nil {
+		return nil, err
+	}
+	return recent, nil
+}
+
+// recordRecent adds a looked up satellite to the recently viewed list, warning rather than failing
+// the lookup when the list cannot be saved.
+func recordRecent(name, norad string) {
+	if err := RecordRecent(name, norad); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Could not update recent satellites: "+err.Error()))
+	}
+}
+
+// RecordRecent moves a satellite to the front of the recently viewed list, dropping any
+// earlier entry for the same NORAD ID and the oldest entries beyond maxRecent.
+func RecordRecent(name, norad string) error {
+	norad = strings.TrimSpace(norad)
+	if norad == "" {
+		return nil
+	}
+
+	recent, err := LoadRecent()
+	if err != nil {
+		return err
+	}
+
+	updated := []RecentSatellite{{
+		SatelliteName: strings.TrimSpace(name),
+		NORADID:       norad,
+		ViewedAt:      time.Now().Format("2006-01-02 15:04:05"),
+	}}
+	for _, entry := range recent {
+		if entry.NORADID != norad && len(updated) < maxRecent {
+			updated = append(updated, entry)
+		}
+	}
+	return saveJSONState(recentFile, updated)
+}
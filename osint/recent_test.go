@@ -0,0 +1,79 @@
+package osint
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRecordRecent_Capacity(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for i := 1; i <= maxRecent+5; i++ {
+		if err := RecordRecent(fmt.Sprintf("SAT-%d", i), fmt.Sprintf("%d", i)); err != nil {
+			t.Fatalf("RecordRecent() failed: %v", err)
+		}
+	}
+
+	recent, err := LoadRecent()
+	if err != nil {
+		t.Fatalf("LoadRecent() failed: %v", err)
+	}
+	if len(recent) != maxRecent {
+		t.Fatalf("Expected %d entries, got %d", maxRecent, len(recent))
+	}
+	if recent[0].NORADID != fmt.Sprintf("%d", maxRecent+5) {
+		t.Errorf("Most recent entry should be first, got %s", recent[0].NORADID)
+	}
+	if recent[maxRecent-1].NORADID != "6" {
+		t.Errorf("Oldest kept entry should be 6, got %s", recent[maxRecent-1].NORADID)
+	}
+}
+
+func TestRecordRecent_DedupeAndReorder(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, sat := range []struct{ name, norad string }{
+		{"ISS (ZARYA)", "25544"},
+		{"HST", "20580"},
+		{"NOAA 19", "33591"},
+		{"ISS", "25544"},
+	} {
+		if err := RecordRecent(sat.name, sat.norad); err != nil {
+			t.Fatalf("RecordRecent() failed: %v", err)
+		}
+	}
+
+	recent, err := LoadRecent()
+	if err != nil {
+		t.Fatalf("LoadRecent() failed: %v", err)
+	}
+	want := []string{"25544", "33591", "20580"}
+	if len(recent) != len(want) {
+		t.Fatalf("Expected %d entries, got %d: %+v", len(want), len(recent), recent)
+	}
+	for i, norad := range want {
+		if recent[i].NORADID != norad {
+			t.Errorf("Entry %d = %s, want %s", i, recent[i].NORADID, norad)
+		}
+	}
+	if recent[0].Label() != "ISS (25544)" {
+		t.Errorf("Re-recording should keep the latest name, got %q", recent[0].Label())
+	}
+
+	// Blank NORAD IDs are ignored
+	if err := RecordRecent("UNKNOWN", " "); err != nil {
+		t.Fatalf("RecordRecent() failed: %v", err)
+	}
+	if recent, _ := LoadRecent(); len(recent) != len(want) {
+		t.Errorf("Blank NORAD ID should not be recorded, got %d entries", len(recent))
+	}
+}
+
+func TestLoadRecent_Empty(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	recent, err := LoadRecent()
+	if err != nil || len(recent) != 0 {
+		t.Errorf("Expected no entries and no error, got %v, %v", recent, err)
+	}
+}
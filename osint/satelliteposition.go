@@ -3,188 +3,320 @@ package osint
 import (
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"math"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/TwiN/go-color"
-	"github.com/iskaa02/qalam/gradient"
 	"github.com/manifoldco/promptui"
 )
 
 // SatellitePositionVisualization provides an interactive menu for viewing satellite positions.
 func SatellitePositionVisualization() {
-	options, _ := os.ReadFile("txt/orbital_element.txt")
-	opt, _ := gradient.NewGradient("#1179ef", "cyan")
-	opt.Print("\n" + string(options))
-	var selection int = Option(0, 3)
-
-	if selection == 1 {
-		result := SelectSatellite()
-
-		if result == "" {
-			return
-		}
-
-		GetLocation(extractNorad(result))
-
-	} else if selection == 2 {
-		fmt.Print("\n ENTER NORAD ID > ")
-		var norad string
-		fmt.Scanln(&norad)
-		GetLocation(norad)
+	selection := SatelliteSelection()
+	if selection.norad == "" {
+		return
 	}
+	GetLocation(selection.norad)
 }
 
 // GetLocation fetches and displays the current position of a satellite for a given observer location.
-func GetLocation(norad string) {
-	// Automatically detect user location
-	latitude, longitude, autoDetected := GetLocationWithPrompt()
-	if latitude == "" || longitude == "" {
-		return
+// It returns the decoded response so callers can reuse the data.
+func GetLocation(norad string) (Response, error) {
+	latitude, longitude, altitude, saved, err := promptObserverLocation()
+	if err != nil {
+		return Response{}, err
 	}
 
-	if autoDetected {
-		fmt.Println(color.Ize(color.Green, "  [+] Using auto-detected location"))
+	// Latitude and longitude were validated when entered or detected
+	lat, err := strconv.ParseFloat(latitude, 64)
+	lon, err2 := strconv.ParseFloat(longitude, 64)
+	if err != nil || err2 != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
+		return Response{}, NewAppError(ErrCodeInputInvalid, "Invalid observer location")
 	}
-
-	fmt.Print("\n ENTER ALTITUDE (meters, default: 0) > ")
-	var altitude string
-	fmt.Scanln(&altitude)
-	if strings.TrimSpace(altitude) == "" {
-		altitude = "0"
+	alt, _ := strconv.ParseFloat(altitude, 64)
+	observer := ObserverPosition{Latitude: lat, Longitude: lon, Altitude: alt}
+	if !saved {
+		offerToSaveObserverProfile(latitude, longitude, altitude)
 	}
 
-	// Validate inputs
-	_, err := strconv.ParseFloat(latitude, 64)
-	_, err2 := strconv.ParseFloat(longitude, 64)
-	_, err3 := strconv.Atoi(altitude)
-
-	if err != nil || err2 != nil || err3 != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
-		return
+	source, ok := choosePositionSource()
+	if !ok {
+		return Response{}, NewAppError(ErrCodeInputEmpty, "Position source is required")
 	}
 
-	spinner := ShowProgressWithSpinner("Fetching satellite position data")
-	url := "https://api.n2yo.com/rest/v1/satellite/positions/" + norad + "/" + latitude + "/" + longitude + "/" + altitude + "/2/&apiKey=" + os.Getenv("N2YO_API_KEY")
-	resp, err := http.Get(url)
-	spinner.Stop()
-	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s, Latitude: %s, Longitude: %s", norad, latitude, longitude)
-		HandleErrorWithContext(err, ErrCodeAPIRequestFailed, "Failed to fetch satellite position data from N2YO API", context)
-		return
-	}
-	defer resp.Body.Close()
-
 	var data Response
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	if err != nil {
-		context := fmt.Sprintf("NORAD ID: %s", norad)
-		HandleErrorWithContext(err, ErrCodeAPIParseFailed, "Failed to parse satellite position response", context)
-		return
+	if source == PositionSourceSGP4 {
+		data, err = sgp4CurrentPositions(norad, observer, 2)
+		if err != nil {
+			HandleError(err, ErrCodeTLEInsufficientData, "Failed to propagate satellite position with SGP4")
+			return Response{}, err
+		}
+	} else {
+		spinner := ShowProgressWithSpinner("Fetching satellite position data")
+		data, err = FetchSatellitePositions(norad, latitude, longitude, altitude, 2)
+		spinner.Stop()
+		if err != nil && isNetworkFailure(err) {
+			if cached, found := lookupCachedTLE(norad); found {
+				fmt.Println(Colorize(RoleWarning, "  [!] "+cached.StaleNotice()+", positions computed with SGP4"))
+				data, err = SGP4PositionResponse(knownSatelliteName(norad), cached.Line1, cached.Line2, observer, time.Now().UTC(), 2)
+			}
+		}
+		if err != nil {
+			HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch satellite position data from N2YO API")
+			return Response{}, err
+		}
 	}
 
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║                    Satellite Information                    ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Name", data.SatelliteInfo.Satname)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite ID", fmt.Sprintf("%d", data.SatelliteInfo.Satid))))
-
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Purple, "║                     Satellite Positions                     ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-
-	for in, pos := range data.Positions {
-		PrintSatellitePosition(pos, in == len(data.Positions)-1)
+	PrintSatellitePositions(data)
+	if source == PositionSourceSGP4 {
+		offerSGP4Doppler(norad, observer)
 	}
 
 	// Offer map visualization option
-	mapPrompt := promptui.Prompt{
-		Label:     "View map visualization? (y/n)",
-		Default:   "n",
-		AllowEdit: true,
-	}
-	mapAnswer, _ := mapPrompt.Run()
-	if strings.ToLower(strings.TrimSpace(mapAnswer)) == "y" {
-		DisplayMap(data)
+	if confirm("View map visualization?", false) {
+		mapData := data
+		if MapTrackSamples > 0 {
+			if line1, line2, err := cachedTLE(norad); err == nil {
+				mapData = DensifyTrack(data, line1, line2, MapTrackSamples)
+			}
+		}
+		DisplayMapWithObserver(mapData, &observer)
 	}
 
 	// Offer export option
-	exportPrompt := promptui.Prompt{
-		Label:     "Export satellite positions? (y/n)",
-		Default:   "n",
-		AllowEdit: true,
-	}
-	exportAnswer, _ := exportPrompt.Run()
-	if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+	if confirm("Export satellite positions?", false) {
 		defaultFilename := fmt.Sprintf("positions_%s_%d", strings.ReplaceAll(data.SatelliteInfo.Satname, " ", "_"), data.SatelliteInfo.Satid)
-		format, filePath, err := showExportMenu(defaultFilename)
+		format, filePath, err := showExportMenuWithFormats(defaultFilename, FormatCSV, FormatJSON, FormatText, FormatGeoJSON)
 		if err == nil {
 			if err := ExportSatellitePosition(data, format, filePath); err != nil {
-				fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to export: "+err.Error()))
+				fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
 			} else {
-				fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+				fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
 			}
 		}
 	}
+
+	return data, nil
+}
+
+// PrintSatellitePositions displays the satellite information and all positions in a response.
+func PrintSatellitePositions(data Response) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║                    Satellite Information                    ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite Name", data.SatelliteInfo.Satname)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite ID", fmt.Sprintf("%d", data.SatelliteInfo.Satid))))
+
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, "║                     Satellite Positions                     ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+
+	for in, pos := range data.Positions {
+		PrintSatellitePosition(pos, in == len(data.Positions)-1)
+	}
 }
 
 // DisplayMap provides interactive map visualization options for satellite positions.
-// It offers three visualization methods: ASCII terminal map, KML export, and web-based map.
+// It offers an ASCII terminal map, KML and GeoJSON export, and a web-based map.
 func DisplayMap(data Response) {
+	DisplayMapWithObserver(data, nil)
+}
+
+// DisplayMapWithObserver is DisplayMap with the observer's location marked on the ASCII map.
+// A nil observer leaves the location off the map.
+func DisplayMapWithObserver(data Response, observer *ObserverPosition) {
 	if len(data.Positions) == 0 {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: No position data available for visualization"))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: No position data available for visualization"))
 		return
 	}
 
-	fmt.Println(color.Ize(color.Cyan, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Cyan, "║              Map Visualization Options                     ║"))
-	fmt.Println(color.Ize(color.Cyan, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Cyan, "║  1. Terminal ASCII Map                                     ║"))
-	fmt.Println(color.Ize(color.Cyan, "║  2. Export to KML (Google Earth)                           ║"))
-	fmt.Println(color.Ize(color.Cyan, "║  3. Web-based Interactive Map                               ║"))
-	fmt.Println(color.Ize(color.Cyan, "║  0. Cancel                                                 ║"))
-	fmt.Println(color.Ize(color.Cyan, "╚═════════════════════════════════════════════════════════════╝"))
+	fmt.Println(Colorize(RoleInfo, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleInfo, "║              Map Visualization Options                     ║"))
+	fmt.Println(Colorize(RoleInfo, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleInfo, "║  1. Terminal ASCII Map                                     ║"))
+	fmt.Println(Colorize(RoleInfo, "║  2. Export to KML (Google Earth)                           ║"))
+	fmt.Println(Colorize(RoleInfo, "║  3. Web-based Interactive Map                               ║"))
+	fmt.Println(Colorize(RoleInfo, "║  4. Export to GeoJSON (GIS tools)                          ║"))
+	fmt.Println(Colorize(RoleInfo, "║  0. Cancel                                                 ║"))
+	fmt.Println(Colorize(RoleInfo, "╚═════════════════════════════════════════════════════════════╝"))
 
-	selection := Option(0, 3)
+	selection := Option(0, 4)
 
 	switch selection {
 	case 1:
-		displayASCIIMap(data)
+		displayASCIIMap(data, observer, offerGroundTrack(data))
 	case 2:
 		exportToKML(data)
 	case 3:
 		generateWebMap(data)
+	case 4:
+		exportToGeoJSON(data)
+	}
+}
+
+// observerMarker marks the observer's location on the ASCII map.
+const observerMarker = '⌂'
+
+// MapFadeWindow is how far before the newest position map markers fade to their dimmest color,
+// so the direction of travel shows on long tracks.
+var MapFadeWindow = 90 * time.Minute
+
+// MapTrackSamples is how many SGP4 positions are added between the fetched positions before they
+// are mapped. Zero maps the positions as fetched.
+var MapTrackSamples = 0
+
+// Intermediate map markers fade from recentMarkerRGB to fadedMarkerRGB with age.
+var (
+	recentMarkerRGB = [3]float64{0x00, 0xff, 0xff}
+	fadedMarkerRGB  = [3]float64{0x1a, 0x3d, 0x4d}
+)
+
+// recencyRGB grades a marker at ts by its age relative to now over MapFadeWindow.
+// Timestamps at or after now get the brightest color.
+func recencyRGB(ts, now int64) [3]uint8 {
+	age := float64(now-ts) / MapFadeWindow.Seconds()
+	age = math.Max(0, math.Min(1, age))
+	var rgb [3]uint8
+	for i := range rgb {
+		rgb[i] = uint8(math.Round(recentMarkerRGB[i] + (fadedMarkerRGB[i]-recentMarkerRGB[i])*age))
+	}
+	return rgb
+}
+
+// recencyColor returns the "#rrggbb" color for a marker at ts, dimmer the older it is than now.
+func recencyColor(ts, now int64) string {
+	rgb := recencyRGB(ts, now)
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
+
+// colorizeRecency colors a terminal map marker by age. Themes without colors leave it plain.
+func colorizeRecency(text string, ts, now int64) string {
+	if currentTheme.InfoColor == "" {
+		return text
+	}
+	rgb := recencyRGB(ts, now)
+	return fmt.Sprintf("\033[38;2;%d;%d;%dm%s\033[0m", rgb[0], rgb[1], rgb[2], text)
+}
+
+// latestTimestamp returns the newest position time, the reference point for marker fading.
+func latestTimestamp(positions []Position) int64 {
+	var latest int64
+	for _, pos := range positions {
+		latest = max(latest, pos.Timestamp)
+	}
+	return latest
+}
+
+// MapGridlines overlays a latitude/longitude grid on the ASCII maps to help read positions.
+var MapGridlines = false
+
+// mapGridInterval is the spacing of map gridlines in degrees.
+const mapGridInterval = 30.0
+
+// Gridline runes. The world map file doesn't use box-drawing characters, so they can't be
+// mistaken for land.
+const (
+	gridHorizontal   = '─'
+	gridVertical     = '│'
+	gridIntersection = '┼'
+)
+
+// isGridRune reports whether r was drawn by drawMapGridlines.
+func isGridRune(r rune) bool {
+	return r == gridHorizontal || r == gridVertical || r == gridIntersection
+}
+
+// drawMapGridlines draws parallels and meridians every interval degrees into grid. Only blank
+// cells are drawn on, so land, position markers and the observer stay visible. The map edges
+// at ±90° and ±180° are left out.
+func drawMapGridlines(grid [][]rune, interval float64) {
+	height := len(grid)
+	if height == 0 || interval <= 0 {
+		return
+	}
+	width := len(grid[0])
+
+	rows := make(map[int]bool)
+	for lat := -90 + interval; lat < 90; lat += interval {
+		row, _ := mapCell(lat, 0, width, height)
+		rows[row] = true
+	}
+	cols := make(map[int]bool)
+	for lon := -180 + interval; lon < 180; lon += interval {
+		_, col := mapCell(0, lon, width, height)
+		cols[col] = true
+	}
+
+	for i := range grid {
+		for j := range grid[i] {
+			if grid[i][j] != ' ' {
+				continue
+			}
+			switch {
+			case rows[i] && cols[j]:
+				grid[i][j] = gridIntersection
+			case rows[i]:
+				grid[i][j] = gridHorizontal
+			case cols[j]:
+				grid[i][j] = gridVertical
+			}
+		}
 	}
 }
 
+// mapCell converts a latitude/longitude to a row and column on a map grid of the given size,
+// clamped to the grid.
+func mapCell(lat, lon float64, mapWidth, mapHeight int) (int, int) {
+	// Latitude: 90 to -90 -> 0 to mapHeight-1
+	// Longitude: -180 to 180 -> 0 to mapWidth-1
+	row := int((90.0 - lat) / 180.0 * float64(mapHeight-1))
+	col := int((lon + 180.0) / 360.0 * float64(mapWidth-1))
+
+	if row < 0 {
+		row = 0
+	}
+	if row >= mapHeight {
+		row = mapHeight - 1
+	}
+	if col < 0 {
+		col = 0
+	}
+	if col >= mapWidth {
+		col = mapWidth - 1
+	}
+	return row, col
+}
+
 // displayASCIIMap creates a terminal-based ASCII visualization of satellite positions.
-// It loads the world map from txt/map.txt and overlays satellite positions with telemetry data.
-func displayASCIIMap(data Response) {
-	fmt.Println(color.Ize(color.Green, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Green, "║              ASCII Map Visualization                      ║"))
-	fmt.Println(color.Ize(color.Green, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Printf(color.Ize(color.Green, "║  Satellite: %-45s ║\n"), data.SatelliteInfo.Satname)
-	fmt.Printf(color.Ize(color.Green, "║  NORAD ID: %-47d ║\n"), data.SatelliteInfo.Satid)
-	fmt.Println(color.Ize(color.Green, "╚═════════════════════════════════════════════════════════════╝\n"))
+// It loads the world map from txt/map.txt and overlays satellite positions with telemetry data,
+// plus the observer's location when observer is not nil and a predicted ground track when track
+// is not empty.
+func displayASCIIMap(data Response, observer *ObserverPosition, track []SGPPosition) {
+	fmt.Println(Colorize(RoleSuccess, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleSuccess, "║              ASCII Map Visualization                      ║"))
+	fmt.Println(Colorize(RoleSuccess, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Printf(Colorize(RoleSuccess, "║  Satellite: %-45s ║\n"), data.SatelliteInfo.Satname)
+	fmt.Printf(Colorize(RoleSuccess, "║  NORAD ID: %-47d ║\n"), data.SatelliteInfo.Satid)
+	fmt.Println(Colorize(RoleSuccess, "╚═════════════════════════════════════════════════════════════╝\n"))
 
 	// Load world map from txt/map.txt
 	mapContent, err := os.ReadFile("txt/map.txt")
 	if err != nil {
 		// Fallback to generated map if file not found
-		fmt.Println(color.Ize(color.Yellow, "  [*] Map file not found, using generated map..."))
-		displayASCIIMapGenerated(data)
+		fmt.Println(Colorize(RoleWarning, "  [*] Map file not found, using generated map..."))
+		displayASCIIMapGenerated(data, observer, track)
 		return
 	}
 
 	// Parse map into lines
 	mapLines := strings.Split(string(mapContent), "\n")
 	if len(mapLines) == 0 {
-		fmt.Println(color.Ize(color.Yellow, "  [*] Map file is empty, using generated map..."))
-		displayASCIIMapGenerated(data)
+		fmt.Println(Colorize(RoleWarning, "  [*] Map file is empty, using generated map..."))
+		displayASCIIMapGenerated(data, observer, track)
 		return
 	}
 
@@ -214,6 +346,7 @@ func displayASCIIMap(data Response) {
 			mapGrid[i][j] = ' '
 		}
 	}
+	drawGroundTrack(mapGrid, track)
 
 	// Plot satellite positions on the map
 	positionMarkers := make([]struct {
@@ -225,24 +358,7 @@ func displayASCIIMap(data Response) {
 
 	for i, pos := range data.Positions {
 		// Convert lat/lon to map coordinates
-		// Latitude: -90 to 90 -> 0 to mapHeight-1
-		// Longitude: -180 to 180 -> 0 to mapWidth-1
-		row := int((90.0 - pos.Satlatitude) / 180.0 * float64(mapHeight-1))
-		col := int((pos.Satlongitude + 180.0) / 360.0 * float64(mapWidth-1))
-
-		// Clamp to valid range
-		if row < 0 {
-			row = 0
-		}
-		if row >= mapHeight {
-			row = mapHeight - 1
-		}
-		if col < 0 {
-			col = 0
-		}
-		if col >= mapWidth {
-			col = mapWidth - 1
-		}
+		row, col := mapCell(pos.Satlatitude, pos.Satlongitude, mapWidth, mapHeight)
 
 		positionMarkers[i] = struct {
 			row int
@@ -266,14 +382,14 @@ func displayASCIIMap(data Response) {
 		if row >= 0 && row < mapHeight && col >= 0 && col < mapWidth {
 			currentChar := mapGrid[row][col]
 			// Overlay on spaces or light characters, preserve land mass
-			if currentChar == ' ' || currentChar == '.' || currentChar == '-' || currentChar == '_' {
+			if currentChar == ' ' || currentChar == '.' || currentChar == '-' || currentChar == '_' || currentChar == groundTrackRune {
 				mapGrid[row][col] = symbol
 			} else {
 				// Try adjacent cells if current is land
-				if col+1 < mapWidth && (mapGrid[row][col+1] == ' ' || mapGrid[row][col+1] == '.') {
+				if col+1 < mapWidth && (mapGrid[row][col+1] == ' ' || mapGrid[row][col+1] == '.' || mapGrid[row][col+1] == groundTrackRune) {
 					mapGrid[row][col+1] = symbol
 					positionMarkers[i].col = col + 1
-				} else if col-1 >= 0 && (mapGrid[row][col-1] == ' ' || mapGrid[row][col-1] == '.') {
+				} else if col-1 >= 0 && (mapGrid[row][col-1] == ' ' || mapGrid[row][col-1] == '.' || mapGrid[row][col-1] == groundTrackRune) {
 					mapGrid[row][col-1] = symbol
 					positionMarkers[i].col = col - 1
 				} else {
@@ -283,15 +399,37 @@ func displayASCIIMap(data Response) {
 		}
 	}
 
+	// Plot the observer unless a satellite marker already occupies the cell
+	observerRow, observerCol := -1, -1
+	if observer != nil {
+		row, col := mapCell(observer.Latitude, observer.Longitude, mapWidth, mapHeight)
+		occupied := false
+		for _, marker := range positionMarkers {
+			if marker.row == row && marker.col == col {
+				occupied = true
+				break
+			}
+		}
+		if !occupied {
+			mapGrid[row][col] = observerMarker
+			observerRow, observerCol = row, col
+		}
+	}
+
+	if MapGridlines {
+		drawMapGridlines(mapGrid, mapGridInterval)
+	}
+
 	// Display the map with positions
-	fmt.Println(color.Ize(color.Cyan, "                    WORLD MAP - SATELLITE POSITIONS"))
-	fmt.Println(color.Ize(color.Yellow, "    Longitude: -180°                                   0°                                   180°\n"))
+	fmt.Println(Colorize(RoleInfo, "                    WORLD MAP - SATELLITE POSITIONS"))
+	fmt.Println(Colorize(RoleWarning, "    Longitude: -180°                                   0°                                   180°\n"))
 	
+	newest := latestTimestamp(data.Positions)
 	for i, row := range mapGrid {
 		// Print latitude labels on the left
 		lat := 90.0 - float64(i)*180.0/float64(mapHeight-1)
 		if i%4 == 0 || i == 0 || i == mapHeight-1 {
-			fmt.Printf(color.Ize(color.Yellow, "%5.0f° "), lat)
+			fmt.Printf(Colorize(RoleWarning, "%5.0f° "), lat)
 		} else {
 			fmt.Print("      ")
 		}
@@ -310,18 +448,23 @@ func displayASCIIMap(data Response) {
 				}
 			}
 			
-			if isMarker {
+			if i == observerRow && j == observerCol {
+				fmt.Print(Colorize(RoleAccent, char)) // Observer - blue
+			} else if isMarker {
 				// Color code the markers
 				if markerIdx == 0 {
-					fmt.Print(color.Ize(color.Red, char)) // First position - red
+					fmt.Print(Colorize(RoleError, char)) // First position - red
 				} else if markerIdx == len(positionMarkers)-1 {
-					fmt.Print(color.Ize(color.Green, char)) // Last position - green
+					fmt.Print(Colorize(RoleSuccess, char)) // Last position - green
 				} else {
-					fmt.Print(color.Ize(color.Cyan, char)) // Intermediate - cyan
+					// Intermediate - cyan, dimmer the older the position
+					fmt.Print(colorizeRecency(char, positionMarkers[markerIdx].pos.Timestamp, newest))
 				}
+			} else if isGridRune(cell) || cell == groundTrackRune {
+				fmt.Print(Colorize(RoleMuted, char))
 			} else {
 				// Regular map characters in dim color
-				fmt.Print(color.Ize(color.White, char))
+				fmt.Print(Colorize(RoleText, char))
 			}
 		}
 		fmt.Println()
@@ -329,9 +472,9 @@ func displayASCIIMap(data Response) {
 	fmt.Println()
 
 	// Display telemetry data in a formatted table
-	fmt.Println(color.Ize(color.Green, "╔════════════════════════════════════════════════════════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Green, "║                                    SATELLITE TELEMETRY DATA                                    ║"))
-	fmt.Println(color.Ize(color.Green, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleSuccess, "╔════════════════════════════════════════════════════════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleSuccess, "║                                    SATELLITE TELEMETRY DATA                                    ║"))
+	fmt.Println(Colorize(RoleSuccess, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
 	
 	for i, pos := range data.Positions {
 		// Format timestamp
@@ -340,47 +483,55 @@ func displayASCIIMap(data Response) {
 		
 		// Determine position type
 		posType := "Intermediate"
-		posColor := color.Cyan
+		posRole := RoleInfo
 		if i == 0 {
 			posType = "First"
-			posColor = color.Red
+			posRole = RoleError
 		} else if i == len(data.Positions)-1 {
 			posType = "Last"
-			posColor = color.Green
+			posRole = RoleSuccess
 		}
 		
-		fmt.Println(color.Ize(color.Green, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
-		fmt.Printf(color.Ize(posColor, "║  Position #%d (%s)                                                                                              ║\n"), i+1, posType)
-		fmt.Println(color.Ize(color.Green, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
-		fmt.Printf(color.Ize(color.White, "║  Latitude:     %10.6f°                                                                                      ║\n"), pos.Satlatitude)
-		fmt.Printf(color.Ize(color.White, "║  Longitude:    %10.6f°                                                                                      ║\n"), pos.Satlongitude)
-		fmt.Printf(color.Ize(color.White, "║  Altitude:     %10.2f km                                                                                    ║\n"), pos.Sataltitude)
-		fmt.Printf(color.Ize(color.White, "║  Azimuth:      %10.2f°                                                                                      ║\n"), pos.Azimuth)
-		fmt.Printf(color.Ize(color.White, "║  Elevation:    %10.2f°                                                                                      ║\n"), pos.Elevation)
-		fmt.Printf(color.Ize(color.White, "║  Right Asc:    %10.2f°                                                                                      ║\n"), pos.Ra)
-		fmt.Printf(color.Ize(color.White, "║  Declination:  %10.2f°                                                                                      ║\n"), pos.Dec)
-		fmt.Printf(color.Ize(color.White, "║  Timestamp:    %-60s ║\n"), timeStr)
+		fmt.Println(Colorize(RoleSuccess, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
+		fmt.Printf(Colorize(posRole, "║  Position #%d (%s)                                                                                              ║\n"), i+1, posType)
+		fmt.Println(Colorize(RoleSuccess, "╠════════════════════════════════════════════════════════════════════════════════════════════════════════════════╣"))
+		fmt.Printf(Colorize(RoleText, "║  Latitude:     %10.6f°                                                                                      ║\n"), pos.Satlatitude)
+		fmt.Printf(Colorize(RoleText, "║  Longitude:    %10.6f°                                                                                      ║\n"), pos.Satlongitude)
+		fmt.Printf(Colorize(RoleText, "║  Altitude:     %10.2f km                                                                                    ║\n"), pos.Sataltitude)
+		fmt.Printf(Colorize(RoleText, "║  Azimuth:      %10.2f°                                                                                      ║\n"), pos.Azimuth)
+		fmt.Printf(Colorize(RoleText, "║  Elevation:    %10.2f°                                                                                      ║\n"), pos.Elevation)
+		fmt.Printf(Colorize(RoleText, "║  Right Asc:    %10.2f°                                                                                      ║\n"), pos.Ra)
+		fmt.Printf(Colorize(RoleText, "║  Declination:  %10.2f°                                                                                      ║\n"), pos.Dec)
+		fmt.Printf(Colorize(RoleText, "║  Timestamp:    %-60s ║\n"), timeStr)
 		
 		// Show map coordinates
-		row := int((90.0 - pos.Satlatitude) / 180.0 * float64(mapHeight-1))
-		col := int((pos.Satlongitude + 180.0) / 360.0 * float64(mapWidth-1))
-		fmt.Printf(color.Ize(color.Yellow, "║  Map Position: Row %3d, Col %3d                                                                              ║\n"), row, col)
+		row, col := mapCell(pos.Satlatitude, pos.Satlongitude, mapWidth, mapHeight)
+		fmt.Printf(Colorize(RoleWarning, "║  Map Position: Row %3d, Col %3d                                                                              ║\n"), row, col)
 	}
 	
-	fmt.Println(color.Ize(color.Green, "╚════════════════════════════════════════════════════════════════════════════════════════════════════════════════╝"))
+	fmt.Println(Colorize(RoleSuccess, "╚════════════════════════════════════════════════════════════════════════════════════════════════════════════════╝"))
 
 	// Print legend
-	fmt.Println(color.Ize(color.Green, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Green, "║                         Legend                            ║"))
-	fmt.Println(color.Ize(color.Green, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Red, "║  ● First Position (Red)                                   ║"))
-	fmt.Println(color.Ize(color.Cyan, "║  · Intermediate Positions (Cyan)                          ║"))
-	fmt.Println(color.Ize(color.Green, "║  ○ Last Position (Green)                                 ║"))
-	fmt.Println(color.Ize(color.Green, "╚═════════════════════════════════════════════════════════════╝\n"))
+	fmt.Println(Colorize(RoleSuccess, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleSuccess, "║                         Legend                            ║"))
+	fmt.Println(Colorize(RoleSuccess, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleError, "║  ● First Position (Red)                                   ║"))
+	fmt.Println(Colorize(RoleInfo, "║  · Intermediate Positions (Cyan, older ones dimmer)       ║"))
+	fmt.Println(Colorize(RoleSuccess, "║  ○ Last Position (Green)                                 ║"))
+	if observer != nil {
+		fmt.Println(Colorize(RoleAccent, "║  ⌂ Your Location (Blue)                                  ║"))
+	}
+	if len(track) > 0 {
+		fmt.Println(Colorize(RoleMuted, "║  ∙ Predicted ground track for one orbit (SGP4)           ║"))
+	}
+	if MapGridlines {
+		fmt.Println(Colorize(RoleMuted, "║  ┼ Gridlines every 30° of latitude and longitude          ║"))
+	}
+	fmt.Println(Colorize(RoleSuccess, "╚═════════════════════════════════════════════════════════════╝\n"))
 }
 
 // displayASCIIMapGenerated is a fallback function that generates a simple map if txt/map.txt is not available.
-func displayASCIIMapGenerated(data Response) {
+func displayASCIIMapGenerated(data Response, observer *ObserverPosition, track []SGPPosition) {
 	// Create a simple ASCII world map representation
 	// Map dimensions: 80 columns (longitude) x 24 rows (latitude)
 	const mapWidth = 80
@@ -398,25 +549,19 @@ func displayASCIIMapGenerated(data Response) {
 	// Draw basic world map outline (simplified)
 	drawWorldMapOutline(mapGrid)
 
-	// Plot satellite positions
+	// Plot the observer first so satellite markers take priority
+	if observer != nil {
+		row, col := mapCell(observer.Latitude, observer.Longitude, mapWidth, mapHeight)
+		mapGrid[row][col] = observerMarker
+	}
+	drawGroundTrack(mapGrid, track)
+
+	// Plot satellite positions, remembering when each cell was visited for fading
+	markerTimes := make(map[[2]int]int64)
 	for i, pos := range data.Positions {
 		// Convert lat/lon to map coordinates
-		row := int((90.0 - pos.Satlatitude) / 180.0 * float64(mapHeight-1))
-		col := int((pos.Satlongitude + 180.0) / 360.0 * float64(mapWidth-1))
-
-		// Clamp to valid range
-		if row < 0 {
-			row = 0
-		}
-		if row >= mapHeight {
-			row = mapHeight - 1
-		}
-		if col < 0 {
-			col = 0
-		}
-		if col >= mapWidth {
-			col = mapWidth - 1
-		}
+		row, col := mapCell(pos.Satlatitude, pos.Satlongitude, mapWidth, mapHeight)
+		markerTimes[[2]int{row, col}] = pos.Timestamp
 
 		// Use different symbols for different positions
 		symbol := '*'
@@ -433,42 +578,62 @@ func displayASCIIMapGenerated(data Response) {
 		}
 	}
 
+	if MapGridlines {
+		drawMapGridlines(mapGrid, mapGridInterval)
+	}
+
 	// Print the map
-	fmt.Println(color.Ize(color.Yellow, "    Longitude: -180°                                   0°                                   180°"))
-	fmt.Println(color.Ize(color.Yellow, "Latitude"))
+	newest := latestTimestamp(data.Positions)
+	fmt.Println(Colorize(RoleWarning, "    Longitude: -180°                                   0°                                   180°"))
+	fmt.Println(Colorize(RoleWarning, "Latitude"))
 	for i, row := range mapGrid {
 		lat := 90.0 - float64(i)*180.0/float64(mapHeight-1)
 		if i%3 == 0 || i == 0 || i == mapHeight-1 {
-			fmt.Printf(color.Ize(color.Yellow, "%5.0f° "), lat)
+			fmt.Printf(Colorize(RoleWarning, "%5.0f° "), lat)
 		} else {
 			fmt.Print("      ")
 		}
 		fmt.Print("│")
-		for _, cell := range row {
+		for j, cell := range row {
 			if cell == ' ' {
 				fmt.Print(" ")
+			} else if cell == observerMarker {
+				fmt.Print(Colorize(RoleAccent, string(cell)))
+			} else if isGridRune(cell) || cell == groundTrackRune {
+				fmt.Print(Colorize(RoleMuted, string(cell)))
+			} else if ts, ok := markerTimes[[2]int{i, j}]; ok && cell == '·' {
+				fmt.Print(colorizeRecency(string(cell), ts, newest))
 			} else {
-				fmt.Print(color.Ize(color.Cyan, string(cell)))
+				fmt.Print(Colorize(RoleInfo, string(cell)))
 			}
 		}
 		fmt.Println("│")
 	}
-	fmt.Println(color.Ize(color.Yellow, "      └────────────────────────────────────────────────────────────────────────┘"))
+	fmt.Println(Colorize(RoleWarning, "      └────────────────────────────────────────────────────────────────────────┘"))
 
 	// Print legend
-	fmt.Println(color.Ize(color.Green, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Green, "║                         Legend                            ║"))
-	fmt.Println(color.Ize(color.Green, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Green, "║  ● First Position                                        ║"))
-	fmt.Println(color.Ize(color.Green, "║  · Intermediate Positions                                ║"))
-	fmt.Println(color.Ize(color.Green, "║  ○ Last Position                                         ║"))
-	fmt.Println(color.Ize(color.Green, "╚═════════════════════════════════════════════════════════════╝\n"))
+	fmt.Println(Colorize(RoleSuccess, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleSuccess, "║                         Legend                            ║"))
+	fmt.Println(Colorize(RoleSuccess, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleSuccess, "║  ● First Position                                        ║"))
+	fmt.Println(Colorize(RoleSuccess, "║  · Intermediate Positions (older ones dimmer)            ║"))
+	fmt.Println(Colorize(RoleSuccess, "║  ○ Last Position                                         ║"))
+	if observer != nil {
+		fmt.Println(Colorize(RoleAccent, "║  ⌂ Your Location                                         ║"))
+	}
+	if len(track) > 0 {
+		fmt.Println(Colorize(RoleMuted, "║  ∙ Predicted ground track for one orbit (SGP4)           ║"))
+	}
+	if MapGridlines {
+		fmt.Println(Colorize(RoleMuted, "║  ┼ Gridlines every 30° of latitude and longitude          ║"))
+	}
+	fmt.Println(Colorize(RoleSuccess, "╚═════════════════════════════════════════════════════════════╝\n"))
 
 	// Print position details
-	fmt.Println(color.Ize(color.Cyan, "\nPosition Details:"))
+	fmt.Println(Colorize(RoleInfo, "\nPosition Details:"))
 	for i, pos := range data.Positions {
-		fmt.Printf(color.Ize(color.Cyan, "  Position %d: Lat %.4f°, Lon %.4f°, Alt %.2f km\n"),
-			i+1, pos.Satlatitude, pos.Satlongitude, pos.Sataltitude)
+		fmt.Println(Colorize(RoleInfo, fmt.Sprintf("  Position %d: Lat %s°, Lon %s°, Alt %s km",
+			i+1, FormatQuantity(QuantityCoordinate, pos.Satlatitude), FormatQuantity(QuantityCoordinate, pos.Satlongitude), FormatQuantity(QuantityAltitude, pos.Sataltitude))))
 	}
 	fmt.Println()
 }
@@ -538,7 +703,7 @@ func exportToKML(data Response) {
 
 	filePath, err := pathPrompt.Run()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] Export cancelled"))
+		fmt.Println(Colorize(RoleError, "  [!] Export cancelled"))
 		return
 	}
 
@@ -547,22 +712,56 @@ func exportToKML(data Response) {
 		filePath = defaultFilename
 	}
 
-	// Ensure .kml extension
-	if !strings.HasSuffix(strings.ToLower(filePath), ".kml") {
-		filePath += ".kml"
-	}
+	// Ensure .kml or .kmz extension
+	filePath = compressedPath(withExportExt(filePath, ".kml"))
 
 	// Generate KML content
 	kmlContent := generateKMLContent(data)
 
 	// Write to file
-	if err := os.WriteFile(filePath, []byte(kmlContent), 0644); err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to write KML file: "+err.Error()))
+	if err := writeExportFile(filePath, []byte(kmlContent)); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to write KML file: "+err.Error()))
 		return
 	}
 
-	fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] KML file exported to: %s", filePath)))
-	fmt.Println(color.Ize(color.Cyan, "  [*] You can open this file in Google Earth or other KML-compatible applications"))
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] KML file exported to: %s", filePath)))
+	fmt.Println(Colorize(RoleInfo, "  [*] You can open this file in Google Earth or other KML-compatible applications"))
+}
+
+// splitAtAntimeridian splits a track into segments wherever it crosses the ±180° meridian, so
+// maps draw it wrapping around rather than as a line across the whole globe. Each crossing ends
+// one segment and starts the next with a point on the meridian, interpolated between the
+// positions either side of it.
+func splitAtAntimeridian(positions []Position) [][]Position {
+	if len(positions) == 0 {
+		return nil
+	}
+	segments := [][]Position{{positions[0]}}
+	for i := 1; i < len(positions); i++ {
+		prev, next := positions[i-1], positions[i]
+		// A step of more than half the globe is shorter the other way round, across the meridian
+		if math.Abs(next.Satlongitude-prev.Satlongitude) <= 180 {
+			segments[len(segments)-1] = append(segments[len(segments)-1], next)
+			continue
+		}
+
+		edge := 180.0
+		unwrapped := next.Satlongitude + 360
+		if prev.Satlongitude < 0 {
+			edge, unwrapped = -180, next.Satlongitude-360
+		}
+		fraction := (edge - prev.Satlongitude) / (unwrapped - prev.Satlongitude)
+		crossing := prev
+		crossing.Satlatitude += (next.Satlatitude - prev.Satlatitude) * fraction
+		crossing.Sataltitude += (next.Sataltitude - prev.Sataltitude) * fraction
+		crossing.Timestamp += int64(math.Round(float64(next.Timestamp-prev.Timestamp) * fraction))
+
+		crossing.Satlongitude = edge
+		segments[len(segments)-1] = append(segments[len(segments)-1], crossing)
+		crossing.Satlongitude = -edge
+		segments = append(segments, []Position{crossing, next})
+	}
+	return segments
 }
 
 // generateKMLContent creates KML XML content for satellite positions.
@@ -595,34 +794,37 @@ func generateKMLContent(data Response) string {
 		builder.WriteString("    <Placemark>\n")
 		builder.WriteString(fmt.Sprintf("      <name>Position %d</name>\n", i+1))
 		builder.WriteString(fmt.Sprintf("      <description>\n"))
-		builder.WriteString(fmt.Sprintf("        Latitude: %.6f°\n", pos.Satlatitude))
-		builder.WriteString(fmt.Sprintf("        Longitude: %.6f°\n", pos.Satlongitude))
-		builder.WriteString(fmt.Sprintf("        Altitude: %.2f km\n", pos.Sataltitude))
+		builder.WriteString(fmt.Sprintf("        Latitude: %s°\n", FormatQuantity(QuantityCoordinate, pos.Satlatitude)))
+		builder.WriteString(fmt.Sprintf("        Longitude: %s°\n", FormatQuantity(QuantityCoordinate, pos.Satlongitude)))
+		builder.WriteString(fmt.Sprintf("        Altitude: %s km\n", FormatQuantity(QuantityAltitude, pos.Sataltitude)))
 		builder.WriteString(fmt.Sprintf("        Timestamp: %d\n", pos.Timestamp))
 		builder.WriteString(fmt.Sprintf("      </description>\n"))
 		builder.WriteString("      <styleUrl>#satelliteStyle</styleUrl>\n")
 		builder.WriteString("      <Point>\n")
-		builder.WriteString(fmt.Sprintf("        <coordinates>%.6f,%.6f,%.2f</coordinates>\n",
-			pos.Satlongitude, pos.Satlatitude, pos.Sataltitude*1000)) // KML uses meters
+		builder.WriteString(fmt.Sprintf("        <coordinates>%s</coordinates>\n", kmlCoordinates(pos)))
 		builder.WriteString("      </Point>\n")
 		builder.WriteString("    </Placemark>\n")
 
-		// Add a path between positions if not the last one
+		// Add a path between positions if not the last one, in two parts if it crosses the antimeridian
 		if i < len(data.Positions)-1 {
-			nextPos := data.Positions[i+1]
-			builder.WriteString("    <Placemark>\n")
-			builder.WriteString(fmt.Sprintf("      <name>Path %d-%d</name>\n", i+1, i+2))
-			builder.WriteString("      <styleUrl>#satelliteStyle</styleUrl>\n")
-			builder.WriteString("      <LineString>\n")
-			builder.WriteString("        <tessellate>1</tessellate>\n")
-			builder.WriteString("        <coordinates>\n")
-			builder.WriteString(fmt.Sprintf("          %.6f,%.6f,%.2f\n",
-				pos.Satlongitude, pos.Satlatitude, pos.Sataltitude*1000))
-			builder.WriteString(fmt.Sprintf("          %.6f,%.6f,%.2f\n",
-				nextPos.Satlongitude, nextPos.Satlatitude, nextPos.Sataltitude*1000))
-			builder.WriteString("        </coordinates>\n")
-			builder.WriteString("      </LineString>\n")
-			builder.WriteString("    </Placemark>\n")
+			segments := splitAtAntimeridian(data.Positions[i : i+2])
+			for part, segment := range segments {
+				name := fmt.Sprintf("Path %d-%d", i+1, i+2)
+				if len(segments) > 1 {
+					name += fmt.Sprintf(" (part %d)", part+1)
+				}
+				builder.WriteString("    <Placemark>\n")
+				builder.WriteString(fmt.Sprintf("      <name>%s</name>\n", name))
+				builder.WriteString("      <styleUrl>#satelliteStyle</styleUrl>\n")
+				builder.WriteString("      <LineString>\n")
+				builder.WriteString("        <tessellate>1</tessellate>\n")
+				builder.WriteString("        <coordinates>\n")
+				builder.WriteString(fmt.Sprintf("          %s\n", kmlCoordinates(segment[0])))
+				builder.WriteString(fmt.Sprintf("          %s\n", kmlCoordinates(segment[1])))
+				builder.WriteString("        </coordinates>\n")
+				builder.WriteString("      </LineString>\n")
+				builder.WriteString("    </Placemark>\n")
+			}
 		}
 	}
 
@@ -632,6 +834,70 @@ func generateKMLContent(data Response) string {
 	return builder.String()
 }
 
+// exportToGeoJSON exports satellite positions and their ground track to a GeoJSON file.
+func exportToGeoJSON(data Response) {
+	defaultFilename := fmt.Sprintf("satellite_%s_%d.geojson",
+		strings.ReplaceAll(data.SatelliteInfo.Satname, " ", "_"), data.SatelliteInfo.Satid)
+
+	pathPrompt := promptui.Prompt{
+		Label:     "Enter GeoJSON file path (or press Enter for default)",
+		Default:   defaultFilename,
+		AllowEdit: true,
+	}
+
+	filePath, err := pathPrompt.Run()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] Export cancelled"))
+		return
+	}
+
+	filePath = strings.TrimSpace(filePath)
+	if filePath == "" {
+		filePath = defaultFilename
+	}
+
+	filePath = compressedPath(withExportExt(filePath, ".geojson"))
+
+	if err := writeExportFile(filePath, []byte(generateGeoJSONContent(data))); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to write GeoJSON file: "+err.Error()))
+		return
+	}
+
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] GeoJSON file exported to: %s", filePath)))
+	fmt.Println(Colorize(RoleInfo, "  [*] You can open this file in QGIS, geojson.io or other GIS tools"))
+}
+
+// generateGeoJSONContent creates a GeoJSON FeatureCollection for satellite positions: a LineString
+// for the ground track (when there are at least two positions) followed by a Point per position.
+func generateGeoJSONContent(data Response) string {
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+
+	if len(data.Positions) >= 2 {
+		track := make([][]float64, 0, len(data.Positions))
+		for _, pos := range data.Positions {
+			track = append(track, []float64{pos.Satlongitude, pos.Satlatitude})
+		}
+		collection.Features = append(collection.Features, newGeoJSONLineString(track, map[string]interface{}{
+			"name":     data.SatelliteInfo.Satname,
+			"norad_id": data.SatelliteInfo.Satid,
+			"kind":     "ground_track",
+		}))
+	}
+
+	for i, pos := range data.Positions {
+		collection.Features = append(collection.Features, newGeoJSONPoint(pos.Satlatitude, pos.Satlongitude, map[string]interface{}{
+			"name":        fmt.Sprintf("Position %d", i+1),
+			"norad_id":    data.SatelliteInfo.Satid,
+			"altitude_km": pos.Sataltitude,
+			"timestamp":   pos.Timestamp,
+			"time":        time.Unix(pos.Timestamp, 0).UTC().Format(time.RFC3339),
+		}))
+	}
+
+	content, _ := json.MarshalIndent(collection, "", "  ")
+	return string(content)
+}
+
 // generateWebMap creates an HTML file with an interactive web-based map using Leaflet.
 func generateWebMap(data Response) {
 	defaultFilename := fmt.Sprintf("satellite_map_%s_%d.html",
@@ -645,7 +911,7 @@ func generateWebMap(data Response) {
 
 	filePath, err := pathPrompt.Run()
 	if err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] Export cancelled"))
+		fmt.Println(Colorize(RoleError, "  [!] Export cancelled"))
 		return
 	}
 
@@ -655,29 +921,43 @@ func generateWebMap(data Response) {
 	}
 
 	// Ensure .html extension
-	if !strings.HasSuffix(strings.ToLower(filePath), ".html") {
-		filePath += ".html"
-	}
+	filePath = compressedPath(withExportExt(filePath, ".html"))
 
 	// Generate HTML content
 	htmlContent := generateHTMLMapContent(data)
 
 	// Write to file
-	if err := os.WriteFile(filePath, []byte(htmlContent), 0644); err != nil {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to write HTML file: "+err.Error()))
+	if err := writeExportFile(filePath, []byte(htmlContent)); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to write HTML file: "+err.Error()))
 		return
 	}
 
-	fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Interactive map exported to: %s", filePath)))
-	fmt.Println(color.Ize(color.Cyan, "  [*] Open this file in your web browser to view the interactive map"))
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Interactive map exported to: %s", filePath)))
+	fmt.Println(Colorize(RoleInfo, "  [*] Open this file in your web browser to view the interactive map"))
 }
 
 // generateHTMLMapContent creates HTML content with Leaflet.js for interactive map visualization.
 func generateHTMLMapContent(data Response) string {
 	var builder strings.Builder
 
-	// Prepare position data as JSON for JavaScript
+	// Prepare position data as JSON for JavaScript, with each marker's recency color
 	positionsJSON, _ := json.Marshal(data.Positions)
+	newest := latestTimestamp(data.Positions)
+	markerColors := make([]string, len(data.Positions))
+	for i, pos := range data.Positions {
+		markerColors[i] = recencyColor(pos.Timestamp, newest)
+	}
+	colorsJSON, _ := json.Marshal(markerColors)
+	// Draw the path as one line per segment so it wraps at the antimeridian
+	pathSegments := [][][2]float64{}
+	for _, segment := range splitAtAntimeridian(data.Positions) {
+		coordinates := make([][2]float64, len(segment))
+		for i, pos := range segment {
+			coordinates[i] = [2]float64{pos.Satlatitude, pos.Satlongitude}
+		}
+		pathSegments = append(pathSegments, coordinates)
+	}
+	pathJSON, _ := json.Marshal(pathSegments)
 
 	builder.WriteString(`<!DOCTYPE html>
 <html lang="en">
@@ -748,7 +1028,7 @@ func generateHTMLMapContent(data Response) string {
 	
 	// Center map on first position
 	if len(data.Positions) > 0 {
-		builder.WriteString(fmt.Sprintf("%.6f, %.6f", data.Positions[0].Satlatitude, data.Positions[0].Satlongitude))
+		builder.WriteString(FormatQuantity(QuantityCoordinate, data.Positions[0].Satlatitude) + ", " + FormatQuantity(QuantityCoordinate, data.Positions[0].Satlongitude))
 	} else {
 		builder.WriteString("0, 0")
 	}
@@ -765,13 +1045,16 @@ func generateHTMLMapContent(data Response) string {
         var positions = `)
 	builder.WriteString(string(positionsJSON))
 	builder.WriteString(`;
+        var markerColors = `)
+	builder.WriteString(string(colorsJSON))
+	builder.WriteString(`;
 
-        // Create polyline for path
-        var pathCoordinates = positions.map(function(pos) {
-            return [pos.satlatitude, pos.satlongitude];
-        });
-        
-        var polyline = L.polyline(pathCoordinates, {
+        // Create polyline for path, one line per segment between antimeridian crossings
+        var pathSegments = `)
+	builder.WriteString(string(pathJSON))
+	builder.WriteString(`;
+
+        var polyline = L.polyline(pathSegments, {
             color: '#00ffff',
             weight: 3,
             opacity: 0.7
@@ -784,7 +1067,7 @@ func generateHTMLMapContent(data Response) string {
         positions.forEach(function(pos, index) {
             var marker = L.circleMarker([pos.satlatitude, pos.satlongitude], {
                 radius: 8,
-                fillColor: index === 0 ? '#ff0000' : (index === positions.length - 1 ? '#00ff00' : '#00ffff'),
+                fillColor: index === 0 ? '#ff0000' : (index === positions.length - 1 ? '#00ff00' : markerColors[index]),
                 color: '#fff',
                 weight: 2,
                 opacity: 1,
@@ -811,7 +1094,7 @@ func generateHTMLMapContent(data Response) string {
             div.style.borderRadius = '5px';
             div.innerHTML = '<h4>Legend</h4>' +
                 '<p><span style="color: #ff0000;">●</span> First Position</p>' +
-                '<p><span style="color: #00ffff;">●</span> Intermediate</p>' +
+                '<p><span style="color: #00ffff;">●</span> Intermediate (older ones dimmer)</p>' +
                 '<p><span style="color: #00ff00;">●</span> Last Position</p>';
             return div;
         };
@@ -823,17 +1106,24 @@ func generateHTMLMapContent(data Response) string {
 	return builder.String()
 }
 
+// kmlCoordinates formats a position as a KML "lon,lat,alt" tuple. KML altitudes are in meters.
+func kmlCoordinates(pos Position) string {
+	return FormatQuantity(QuantityCoordinate, pos.Satlongitude) + "," +
+		FormatQuantity(QuantityCoordinate, pos.Satlatitude) + "," +
+		FormatQuantity(QuantityAltitude, pos.Sataltitude*1000)
+}
+
 // PrintSatellitePosition displays satellite position data in a formatted table.
 func PrintSatellitePosition(pos Position, last bool) {
-	fmt.Println(color.Ize(color.Purple, GenRowString("Latitude", fmt.Sprintf("%f", pos.Satlatitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Longitude", fmt.Sprintf("%f", pos.Satlongitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Altitude", fmt.Sprintf("%f", pos.Sataltitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Right Ascension", fmt.Sprintf("%f", pos.Azimuth))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Declination", fmt.Sprintf("%f", pos.Dec))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Timestamp", fmt.Sprintf("%d", pos.Timestamp))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Latitude", FormatQuantity(QuantityCoordinate, pos.Satlatitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Longitude", FormatQuantity(QuantityCoordinate, pos.Satlongitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Altitude", FormatQuantity(QuantityAltitude, pos.Sataltitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Right Ascension", FormatQuantity(QuantityAngle, pos.Azimuth))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite Declination", FormatQuantity(QuantityAngle, pos.Dec))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Timestamp", fmt.Sprintf("%d", pos.Timestamp))))
 	if last {
-		fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+		fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 	} else {
-		fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
+		fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
 	}
 }
@@ -6,10 +6,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/TwiN/go-color"
 	satellite "github.com/joshuaferrara/go-satellite"
 )
 
+// minOrbitalAltitudeKm is the altitude below which a propagated object is treated as reentered.
+const minOrbitalAltitudeKm = 100.0
+
+// earthRotationRadS is Earth's sidereal rotation rate in radians per second.
+const earthRotationRadS = 7.2921150e-5
+
 // SGPPosition represents a satellite position calculated using SGP4.
 type SGPPosition struct {
 	Latitude  float64 // Satellite latitude in degrees
@@ -17,13 +22,39 @@ type SGPPosition struct {
 	Altitude  float64 // Satellite altitude in kilometers
 	Velocity  float64 // Satellite velocity in km/s
 	Timestamp int64   // Unix timestamp
+	Sunlit    *bool   // Whether the satellite is in sunlight, nil when not determined
 }
 
+// HeightReference identifies the surface an observer's altitude is measured from.
+type HeightReference int
+
+const (
+	// HeightEllipsoid means Altitude is height above the WGS ellipsoid, which is what the look-angle math expects.
+	HeightEllipsoid HeightReference = iota
+	// HeightMSL means Altitude is height above mean sea level (the geoid), as reported by maps and most GPS displays.
+	HeightMSL
+)
+
 // ObserverPosition represents the position of an observer on Earth.
 type ObserverPosition struct {
 	Latitude  float64 // Observer latitude in degrees
 	Longitude float64 // Observer longitude in degrees
-	Altitude  float64 // Observer altitude in meters
+	Altitude  float64 // Observer altitude in meters, relative to HeightRef
+
+	// HeightRef selects how Altitude is interpreted. The zero value treats it as ellipsoidal height.
+	HeightRef HeightReference
+	// GeoidUndulation is the geoid height above the ellipsoid at the observer in meters
+	// (EGM96/EGM2008 value, roughly -106 to +85). It is only applied when HeightRef is HeightMSL.
+	GeoidUndulation float64
+}
+
+// EllipsoidHeight returns the observer's height above the WGS ellipsoid in meters,
+// converting from mean sea level using GeoidUndulation when needed.
+func (o ObserverPosition) EllipsoidHeight() float64 {
+	if o.HeightRef == HeightMSL {
+		return o.Altitude + o.GeoidUndulation
+	}
+	return o.Altitude
 }
 
 // LookAngles represents the viewing angles from an observer to a satellite.
@@ -32,6 +63,9 @@ type LookAngles struct {
 	Elevation float64 // Elevation angle in degrees (-90 to 90)
 	Range     float64 // Range to satellite in kilometers
 	RangeRate float64 // Range rate in km/s
+
+	RightAscension float64 // Topocentric right ascension in degrees (0-360)
+	Declination    float64 // Topocentric declination in degrees
 }
 
 // SGP4PositionResult contains the calculated position and look angles.
@@ -40,12 +74,37 @@ type SGP4PositionResult struct {
 	LookAngles LookAngles
 }
 
+// TLELineTolerance is how many trailing columns a TLE line may be missing and still be accepted,
+// for sources that strip the checksum or trailing whitespace. Such lines are padded with spaces.
+var TLELineTolerance = 1
+
+// maxTLELineTolerance keeps padding to the element set number and checksum columns, which SGP4
+// does not read.
+const maxTLELineTolerance = 5
+
+// minTLELineFields is the fewest whitespace-separated fields a structurally valid TLE line has.
+const minTLELineFields = 8
+
+// padTLELine right-pads a line that is at most TLELineTolerance columns short to the standard
+// width, if it starts with prefix and has the expected number of fields. Other lines are
+// returned unchanged.
+func padTLELine(line, prefix string) string {
+	missing := tleLineLength - len(line)
+	if missing <= 0 || missing > TLELineTolerance {
+		return line
+	}
+	if !strings.HasPrefix(line, prefix) || len(strings.Fields(line)) < minTLELineFields {
+		return line
+	}
+	return fmt.Sprintf("%-*s", tleLineLength, line)
+}
+
 // CalculateSGP4Position calculates the satellite position using SGP4 algorithm from raw TLE line strings.
 // This is the recommended function to use as it works directly with TLE line strings.
 func CalculateSGP4Position(line1, line2 string, targetTime time.Time) (SGPPosition, error) {
 	// Validate TLE lines
-	line1 = strings.TrimSpace(line1)
-	line2 = strings.TrimSpace(line2)
+	line1 = padTLELine(strings.TrimSpace(line1), "1 ")
+	line2 = padTLELine(strings.TrimSpace(line2), "2 ")
 
 	if len(line1) < 69 || len(line2) < 69 {
 		return SGPPosition{}, fmt.Errorf("invalid TLE: lines must be at least 69 characters")
@@ -83,21 +142,109 @@ func CalculateSGP4Position(line1, line2 string, targetTime time.Time) (SGPPositi
 	// Calculate velocity magnitude
 	velocityMagnitude := math.Sqrt(velocity.X*velocity.X + velocity.Y*velocity.Y + velocity.Z*velocity.Z)
 
-	return SGPPosition{
+	pos := SGPPosition{
 		Latitude:  latLong.Latitude * satellite.RAD2DEG,
 		Longitude: latLong.Longitude * satellite.RAD2DEG,
-		Altitude:  altitude / 1000.0, // Convert meters to kilometers
+		Altitude:  altitude, // ECIToLLA already reports kilometers
 		Velocity:  velocityMagnitude,
 		Timestamp: targetTime.Unix(),
-	}, nil
+	}
+
+	if err := validateSGP4Output(pos); err != nil {
+		return SGPPosition{}, err
+	}
+
+	return pos, nil
+}
+
+// validateSGP4Output rejects propagation results that are numerically invalid or
+// indicate the object has decayed, rather than letting them reach the caller.
+func validateSGP4Output(pos SGPPosition) error {
+	for _, v := range []float64{pos.Latitude, pos.Longitude, pos.Altitude, pos.Velocity} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return NewAppErrorWithContext(
+				ErrCodeTLEParseFailed,
+				"SGP4 propagation produced invalid values",
+				fmt.Sprintf("Latitude: %f, Longitude: %f, Altitude: %f km, Velocity: %f km/s", pos.Latitude, pos.Longitude, pos.Altitude, pos.Velocity),
+			)
+		}
+	}
+
+	if pos.Altitude < minOrbitalAltitudeKm {
+		return NewAppErrorWithContext(
+			ErrCodeTLEDecayed,
+			"Satellite has decayed or reentered at the requested time",
+			fmt.Sprintf("Propagated altitude: %.2f km", pos.Altitude),
+		)
+	}
+
+	return nil
+}
+
+// IsSunlit reports whether a satellite at satPosECI (km, equatorial inertial) is in sunlight at t.
+// Earth's shadow is treated as a cylinder with Earth's radius pointing away from the Sun, which
+// ignores the penumbra.
+func IsSunlit(satPosECI satellite.Vector3, t time.Time) bool {
+	r := [3]float64{satPosECI.X, satPosECI.Y, satPosECI.Z}
+	sun := sunDirectionECI(t.UTC())
+
+	// On the Sun's side of the terminator plane nothing can shade the satellite
+	along := r[0]*sun[0] + r[1]*sun[1] + r[2]*sun[2]
+	if along >= 0 {
+		return true
+	}
+	var perpendicular float64
+	for i := range r {
+		d := r[i] - along*sun[i]
+		perpendicular += d * d
+	}
+	return math.Sqrt(perpendicular) > earthRadiusKm
+}
+
+// CalculateSGP4PositionWithIllumination calculates the satellite position like
+// CalculateSGP4Position and sets Sunlit to whether the satellite is in sunlight.
+func CalculateSGP4PositionWithIllumination(line1, line2 string, targetTime time.Time) (SGPPosition, error) {
+	pos, err := CalculateSGP4Position(line1, line2, targetTime)
+	if err != nil {
+		return SGPPosition{}, err
+	}
+	sunlit := satelliteSunlit(padTLELine(strings.TrimSpace(line1), "1 "), padTLELine(strings.TrimSpace(line2), "2 "), targetTime)
+	pos.Sunlit = &sunlit
+	return pos, nil
 }
 
 // CalculateSGP4PositionFromTLE calculates position from a TLE struct.
-// Note: This requires the original TLE lines. If you have raw TLE lines, use CalculateSGP4Position instead.
+// Empty lines fall back to the raw lines stored in the TLE; the line parameters are kept for
+// compatibility, and CalculateSGP4PositionFromTLERecord does not need them.
 func CalculateSGP4PositionFromTLE(tle TLE, line1, line2 string, targetTime time.Time) (SGPPosition, error) {
+	line1, line2 = tleLines(tle, line1, line2)
 	return CalculateSGP4Position(line1, line2, targetTime)
 }
 
+// CalculateSGP4PositionFromTLERecord calculates position from a TLE parsed by ConstructTLE, using
+// the raw lines it stores.
+func CalculateSGP4PositionFromTLERecord(tle TLE, targetTime time.Time) (SGPPosition, error) {
+	if tle.RawLine1 == "" || tle.RawLine2 == "" {
+		return SGPPosition{}, NewAppErrorWithContext(
+			ErrCodeTLEInsufficientData,
+			"TLE record has no raw element lines",
+			fmt.Sprintf("Satellite: %s (%d)", tle.CommonName, tle.SatelliteCatalogNumber),
+		)
+	}
+	return CalculateSGP4Position(tle.RawLine1, tle.RawLine2, targetTime)
+}
+
+// tleLines returns line1 and line2, substituting the TLE's raw lines for empty ones.
+func tleLines(tle TLE, line1, line2 string) (string, string) {
+	if strings.TrimSpace(line1) == "" {
+		line1 = tle.RawLine1
+	}
+	if strings.TrimSpace(line2) == "" {
+		line2 = tle.RawLine2
+	}
+	return line1, line2
+}
+
 // CalculateSGP4PositionWithObserver calculates satellite position and look angles from an observer's perspective.
 // This is the recommended function to use as it works directly with TLE line strings.
 func CalculateSGP4PositionWithObserver(line1, line2 string, targetTime time.Time, observer ObserverPosition) (SGP4PositionResult, error) {
@@ -122,7 +269,7 @@ func CalculateSGP4PositionWithObserver(line1, line2 string, targetTime time.Time
 	minute := targetTime.Minute()
 	second := targetTime.Second()
 
-	position, _ := satellite.Propagate(sat, year, month, day, hour, minute, second)
+	position, velocity := satellite.Propagate(sat, year, month, day, hour, minute, second)
 
 	// Calculate Julian Day
 	jday := satellite.JDay(year, month, day, hour, minute, second)
@@ -132,7 +279,7 @@ func CalculateSGP4PositionWithObserver(line1, line2 string, targetTime time.Time
 		Latitude:  observer.Latitude * satellite.DEG2RAD,
 		Longitude: observer.Longitude * satellite.DEG2RAD,
 	}
-	obsAlt := observer.Altitude / 1000.0 // Convert meters to kilometers
+	obsAlt := observer.EllipsoidHeight() / 1000.0 // Convert meters to kilometers
 	obsECI := satellite.LLAToECI(obsLatLong, obsAlt, jday)
 
 	// Calculate look angles
@@ -142,7 +289,17 @@ func CalculateSGP4PositionWithObserver(line1, line2 string, targetTime time.Time
 	dx := position.X - obsECI.X
 	dy := position.Y - obsECI.Y
 	dz := position.Z - obsECI.Z
-	rangeKm := math.Sqrt(dx*dx+dy*dy+dz*dz) / 1000.0 // Convert meters to kilometers
+	rangeKm := math.Sqrt(dx*dx + dy*dy + dz*dz) // go-satellite works in kilometers
+
+	// Range rate is the relative velocity along the line of sight. The observer moves with
+	// Earth's rotation, so its inertial velocity is omega x r.
+	dvx := velocity.X + earthRotationRadS*obsECI.Y
+	dvy := velocity.Y - earthRotationRadS*obsECI.X
+	dvz := velocity.Z
+	rangeRate := (dx*dvx + dy*dvy + dz*dvz) / math.Sqrt(dx*dx+dy*dy+dz*dz)
+
+	rightAscension := math.Mod(math.Atan2(dy, dx)*satellite.RAD2DEG+360, 360)
+	declination := math.Asin(dz/math.Sqrt(dx*dx+dy*dy+dz*dz)) * satellite.RAD2DEG
 
 	return SGP4PositionResult{
 		Position: satPosition,
@@ -150,11 +307,23 @@ func CalculateSGP4PositionWithObserver(line1, line2 string, targetTime time.Time
 			Azimuth:   lookAngles.Az * satellite.RAD2DEG,
 			Elevation: lookAngles.El * satellite.RAD2DEG,
 			Range:     rangeKm,
-			RangeRate: 0.0, // Range rate calculation would require velocity comparison
+			RangeRate: rangeRate,
+
+			RightAscension: rightAscension,
+			Declination:    declination,
 		},
 	}, nil
 }
 
+// CalculateSGP4PositionWithDynamicObserver calculates satellite position and look angles for an observer
+// whose location changes over time, such as an aircraft or ship. observerAt is evaluated at targetTime.
+func CalculateSGP4PositionWithDynamicObserver(line1, line2 string, targetTime time.Time, observerAt func(t time.Time) ObserverPosition) (SGP4PositionResult, error) {
+	if observerAt == nil {
+		return SGP4PositionResult{}, fmt.Errorf("observer position function must not be nil")
+	}
+	return CalculateSGP4PositionWithObserver(line1, line2, targetTime, observerAt(targetTime))
+}
+
 // CalculateSGP4Positions calculates multiple positions over a time range.
 func CalculateSGP4Positions(line1, line2 string, startTime time.Time, endTime time.Time, interval time.Duration) ([]SGPPosition, error) {
 	if startTime.After(endTime) {
@@ -179,43 +348,135 @@ func CalculateSGP4Positions(line1, line2 string, startTime time.Time, endTime ti
 	return positions, nil
 }
 
+// Propagation purposes understood by SuggestStep.
+const (
+	StepPurposeGroundTrack   = "ground-track"
+	StepPurposePassDetection = "pass-detection"
+)
+
+// passDetectionStep is fine enough to catch the rise and set of short LEO passes.
+const passDetectionStep = 10 * time.Second
+
+// orbitalPeriod returns the time for one revolution from the TLE mean motion (revolutions per day).
+func orbitalPeriod(tle TLE) time.Duration {
+	return time.Duration(secondsPerDay / tle.MeanMotion * float64(time.Second))
+}
+
+// SuggestStep returns a propagation step suited to purpose. Ground tracks sample each orbit
+// 360 times (one point per degree of mean anomaly), so a GEO gets a much coarser step than a LEO.
+// Pass detection uses a fixed 10 second step. Unknown purposes are treated as ground tracks.
+func SuggestStep(tle TLE, purpose string) time.Duration {
+	if purpose == StepPurposePassDetection {
+		return passDetectionStep
+	}
+	if tle.MeanMotion <= 0 {
+		return time.Minute
+	}
+
+	step := (orbitalPeriod(tle) / 360).Round(time.Second)
+	if step < time.Second {
+		step = time.Second
+	}
+	return step
+}
+
 // CalculateSGP4PositionFromTLEStruct calculates position from a TLE struct with original lines.
-// This is a convenience wrapper that uses the provided TLE lines.
+// This is a convenience wrapper that uses the provided TLE lines, or the TLE's raw lines when they are empty.
 func CalculateSGP4PositionFromTLEStruct(tle TLE, originalLine1, originalLine2 string, targetTime time.Time) (SGPPosition, error) {
+	originalLine1, originalLine2 = tleLines(tle, originalLine1, originalLine2)
 	return CalculateSGP4Position(originalLine1, originalLine2, targetTime)
 }
 
 // CalculateSGP4PositionFromTLEStructWithObserver calculates position and look angles from a TLE struct with original lines.
 func CalculateSGP4PositionFromTLEStructWithObserver(tle TLE, originalLine1, originalLine2 string, targetTime time.Time, observer ObserverPosition) (SGP4PositionResult, error) {
+	originalLine1, originalLine2 = tleLines(tle, originalLine1, originalLine2)
 	return CalculateSGP4PositionWithObserver(originalLine1, originalLine2, targetTime, observer)
 }
 
 // PrintSGP4Position displays SGP4-calculated position in a formatted table.
 func PrintSGP4Position(pos SGPPosition) {
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║              SGP4 Calculated Position                       ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Latitude (degrees)", fmt.Sprintf("%.6f", pos.Latitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Longitude (degrees)", fmt.Sprintf("%.6f", pos.Longitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Altitude (km)", fmt.Sprintf("%.2f", pos.Altitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Velocity (km/s)", fmt.Sprintf("%.4f", pos.Velocity))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Timestamp", fmt.Sprintf("%d", pos.Timestamp))))
-	fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, "║              SGP4 Calculated Position                       ║"))
+	fmt.Println(Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Latitude (degrees)", FormatQuantity(QuantityCoordinate, pos.Latitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Longitude (degrees)", FormatQuantity(QuantityCoordinate, pos.Longitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Altitude (km)", FormatQuantity(QuantityAltitude, pos.Altitude))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Velocity (km/s)", FormatQuantity(QuantityVelocity, pos.Velocity))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Timestamp", fmt.Sprintf("%d", pos.Timestamp))))
+	if pos.Sunlit != nil {
+		fmt.Println(Colorize(RoleHeader, GenRowString("Illumination", illuminationName(*pos.Sunlit))))
+	}
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+}
+
+// illuminationName describes whether a satellite is in sunlight.
+func illuminationName(sunlit bool) string {
+	if sunlit {
+		return "Sunlit"
+	}
+	return "Eclipse"
 }
 
 // PrintSGP4PositionWithLookAngles displays position and look angles in a formatted table.
 func PrintSGP4PositionWithLookAngles(result SGP4PositionResult) {
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, "║         SGP4 Calculated Position & Look Angles             ║"))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Latitude (degrees)", fmt.Sprintf("%.6f", result.Position.Latitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Longitude (degrees)", fmt.Sprintf("%.6f", result.Position.Longitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Altitude (km)", fmt.Sprintf("%.2f", result.Position.Altitude))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Velocity (km/s)", fmt.Sprintf("%.4f", result.Position.Velocity))))
-	fmt.Println(color.Ize(color.Purple, "╠═════════════════════════════════════════════════════════════╣"))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Azimuth (degrees)", fmt.Sprintf("%.2f", result.LookAngles.Azimuth))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Elevation (degrees)", fmt.Sprintf("%.2f", result.LookAngles.Elevation))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Range (km)", fmt.Sprintf("%.2f", result.LookAngles.Range))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Range Rate (km/s)", fmt.Sprintf("%.4f", result.LookAngles.RangeRate))))
-	fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+	for _, line := range lookAngleTableLines(result) {
+		fmt.Println(Colorize(RoleHeader, line))
+	}
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
+}
+
+// lookAngleTableLines renders the rows of the position and look angle table, without its bottom
+// border so variants can add rows.
+func lookAngleTableLines(result SGP4PositionResult) []string {
+	return []string{
+		"\n╔═════════════════════════════════════════════════════════════╗",
+		"║         SGP4 Calculated Position & Look Angles             ║",
+		"╠═════════════════════════════════════════════════════════════╣",
+		GenRowString("Satellite Latitude (degrees)", FormatQuantity(QuantityCoordinate, result.Position.Latitude)),
+		GenRowString("Satellite Longitude (degrees)", FormatQuantity(QuantityCoordinate, result.Position.Longitude)),
+		GenRowString("Satellite Altitude (km)", FormatQuantity(QuantityAltitude, result.Position.Altitude)),
+		GenRowString("Satellite Velocity (km/s)", FormatQuantity(QuantityVelocity, result.Position.Velocity)),
+		"╠═════════════════════════════════════════════════════════════╣",
+		GenRowString("Azimuth (degrees)", FormatQuantity(QuantityAngle, result.LookAngles.Azimuth)),
+		GenRowString("Elevation (degrees)", FormatQuantity(QuantityAngle, result.LookAngles.Elevation)),
+		GenRowString("Range (km)", FormatQuantity(QuantityAltitude, result.LookAngles.Range)),
+		GenRowString("Range Rate (km/s)", FormatQuantity(QuantityVelocity, result.LookAngles.RangeRate)),
+	}
+}
+
+// dopplerTableLines renders the Doppler rows for a downlink and an uplink frequency in MHz. A
+// frequency of zero is left out.
+func dopplerTableLines(rangeRate, downlinkMHz, uplinkMHz float64) []string {
+	var lines []string
+	if downlinkMHz > 0 {
+		lines = append(lines,
+			GenRowString("Downlink Nominal (MHz)", fmt.Sprintf("%.6f", downlinkMHz)),
+			GenRowString("Downlink Received (MHz)", fmt.Sprintf("%.6f", downlinkMHz+DopplerShift(rangeRate, downlinkMHz))),
+			GenRowString("Downlink Shift (kHz)", fmt.Sprintf("%+.3f", DopplerShift(rangeRate, downlinkMHz)*1000)),
+		)
+	}
+	if uplinkMHz > 0 {
+		transmit := UplinkFrequency(rangeRate, uplinkMHz)
+		lines = append(lines,
+			GenRowString("Uplink Nominal (MHz)", fmt.Sprintf("%.6f", uplinkMHz)),
+			GenRowString("Uplink Transmit (MHz)", fmt.Sprintf("%.6f", transmit)),
+			GenRowString("Uplink Shift (kHz)", fmt.Sprintf("%+.3f", (transmit-uplinkMHz)*1000)),
+		)
+	}
+	return lines
+}
+
+// PrintSGP4PositionWithDoppler displays position and look angles like
+// PrintSGP4PositionWithLookAngles, followed by the Doppler-shifted downlink frequency to tune to
+// and the uplink frequency to transmit. Frequencies are in MHz; pass 0 for one that is not used.
+func PrintSGP4PositionWithDoppler(result SGP4PositionResult, downlinkMHz, uplinkMHz float64) {
+	lines := lookAngleTableLines(result)
+	if doppler := dopplerTableLines(result.LookAngles.RangeRate, downlinkMHz, uplinkMHz); len(doppler) > 0 {
+		lines = append(lines, "╠═════════════════════════════════════════════════════════════╣")
+		lines = append(lines, doppler...)
+	}
+	for _, line := range lines {
+		fmt.Println(Colorize(RoleHeader, line))
+	}
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝\n\n"))
 }
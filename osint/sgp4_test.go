@@ -1,9 +1,13 @@
 package osint
 
 import (
+	"errors"
 	"math"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/joshuaferrara/go-satellite"
 )
 
 // Test TLE data for ISS (International Space Station)
@@ -82,7 +86,7 @@ func TestCalculateSGP4Position_InvalidTLE(t *testing.T) {
 }
 
 func TestCalculateSGP4PositionWithObserver(t *testing.T) {
-	targetTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	targetTime := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC) // a day after the TLE epoch
 	
 	// Observer at New York City (approximately)
 	observer := ObserverPosition{
@@ -467,6 +471,9 @@ func TestCalculateSGP4Position_ExactLength(t *testing.T) {
 
 func TestCalculateSGP4Position_JustBelowMinimumLength(t *testing.T) {
 	targetTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	original := TLELineTolerance
+	TLELineTolerance = 0
+	t.Cleanup(func() { TLELineTolerance = original })
 	
 	// Create lines that are just below 69 characters
 	shortLine1 := testTLELine1[:68]
@@ -474,7 +481,33 @@ func TestCalculateSGP4Position_JustBelowMinimumLength(t *testing.T) {
 	
 	_, err := CalculateSGP4Position(shortLine1, shortLine2, targetTime)
 	if err == nil {
-		t.Error("Expected error for lines shorter than 69 characters")
+		t.Error("Expected error for lines shorter than 69 characters when no tolerance is configured")
+	}
+}
+
+func TestCalculateSGP4Position_PadsTrimmedLines(t *testing.T) {
+	targetTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	// Checksum column stripped, as some sources do
+	got, err := CalculateSGP4Position(testTLELine1[:68], testTLELine2[:68], targetTime)
+	if err != nil {
+		t.Fatalf("Expected 68-character lines to be padded and accepted, got %v", err)
+	}
+	want, err := CalculateSGP4Position(testTLELine1, testTLELine2, targetTime)
+	if err != nil {
+		t.Fatalf("CalculateSGP4Position failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("Padded position = %+v, want %+v", got, want)
+	}
+
+	if _, err := CalculateSGP4Position(testTLELine1[:67], testTLELine2[:67], targetTime); err == nil {
+		t.Error("Expected error for lines beyond the length tolerance")
+	}
+	// Right length but missing fields, so it is not padded
+	collapsed := "1 25544U 98067A 04236.56031392 .00020137 00000-0 16538-3                       "[:68]
+	if _, err := CalculateSGP4Position(collapsed, testTLELine2, targetTime); err == nil {
+		t.Error("Expected error for a short line without the expected fields")
 	}
 }
 
@@ -791,7 +824,7 @@ func TestCalculateSGP4Position_VelocityCalculation(t *testing.T) {
 }
 
 func TestCalculateSGP4PositionWithObserver_RangeCalculation(t *testing.T) {
-	targetTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	targetTime := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC) // a day after the TLE epoch
 	
 	observer := ObserverPosition{
 		Latitude:  40.7128,
@@ -816,6 +849,141 @@ func TestCalculateSGP4PositionWithObserver_RangeCalculation(t *testing.T) {
 	}
 }
 
+func TestCalculateSGP4Position_DecayedObject(t *testing.T) {
+	// Very low, high-drag orbit that decays within weeks of its epoch
+	line1 := "1 99999U 24001A   24015.50000000  .01000000  00000-0  10000-2 0  9993"
+	line2 := "2 99999  51.6000 100.0000 0005000  90.0000 270.0000 16.30000000 10004"
+
+	epoch := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	pos, err := CalculateSGP4Position(line1, line2, epoch)
+	if err != nil {
+		t.Fatalf("Expected valid position at epoch, got error: %v", err)
+	}
+	if pos.Altitude < 150 || pos.Altitude > 250 {
+		t.Errorf("Expected altitude near 200 km at epoch, got %f", pos.Altitude)
+	}
+
+	_, err = CalculateSGP4Position(line1, line2, epoch.AddDate(0, 0, 30))
+	if err == nil {
+		t.Fatal("Expected error for decayed object")
+	}
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("Expected *AppError, got %T", err)
+	}
+	if appErr.Code != ErrCodeTLEDecayed {
+		t.Errorf("Expected error code %s, got %s", ErrCodeTLEDecayed, appErr.Code)
+	}
+
+	_, err = CalculateSGP4PositionWithObserver(line1, line2, epoch.AddDate(0, 0, 30), ObserverPosition{})
+	if err == nil {
+		t.Error("Expected observer calculation to propagate decay error")
+	}
+}
+
+func TestCalculateSGP4PositionWithDynamicObserver(t *testing.T) {
+	startTime := time.Date(2004, 8, 23, 13, 0, 0, 0, time.UTC)
+	targetTime := startTime.Add(time.Hour)
+
+	static := ObserverPosition{Latitude: 40.0, Longitude: -74.0, Altitude: 10000.0}
+
+	// Observer flying east at 10 degrees of longitude per hour
+	eastbound := func(t time.Time) ObserverPosition {
+		obs := static
+		obs.Longitude += 10.0 * t.Sub(startTime).Hours()
+		return obs
+	}
+
+	staticResult, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, targetTime, static)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionWithObserver failed: %v", err)
+	}
+
+	dynamicResult, err := CalculateSGP4PositionWithDynamicObserver(testTLELine1, testTLELine2, targetTime, eastbound)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionWithDynamicObserver failed: %v", err)
+	}
+
+	if dynamicResult.Position != staticResult.Position {
+		t.Error("Satellite position should not depend on the observer")
+	}
+	if dynamicResult.LookAngles.Azimuth == staticResult.LookAngles.Azimuth &&
+		dynamicResult.LookAngles.Elevation == staticResult.LookAngles.Elevation {
+		t.Error("Expected look angles for moving observer to differ from static observer")
+	}
+
+	// At the start time the moving observer has not moved yet
+	atStart, err := CalculateSGP4PositionWithDynamicObserver(testTLELine1, testTLELine2, startTime, eastbound)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionWithDynamicObserver failed: %v", err)
+	}
+	staticAtStart, _ := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, startTime, static)
+	if atStart.LookAngles != staticAtStart.LookAngles {
+		t.Errorf("Expected identical look angles at start time, got %+v and %+v", atStart.LookAngles, staticAtStart.LookAngles)
+	}
+}
+
+func TestObserverPosition_EllipsoidHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		observer ObserverPosition
+		expected float64
+	}{
+		{"ellipsoid is default", ObserverPosition{Altitude: 100, GeoidUndulation: -30}, 100},
+		{"msl adds undulation", ObserverPosition{Altitude: 100, HeightRef: HeightMSL, GeoidUndulation: -30}, 70},
+		{"msl without undulation", ObserverPosition{Altitude: 100, HeightRef: HeightMSL}, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.observer.EllipsoidHeight(); got != tt.expected {
+				t.Errorf("EllipsoidHeight() = %f, want %f", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateSGP4PositionWithObserver_HeightReference(t *testing.T) {
+	targetTime := time.Date(2004, 8, 23, 13, 30, 0, 0, time.UTC)
+
+	// Geoid lies 35 m below the ellipsoid here, so an MSL height of 500 m is 465 m above the ellipsoid
+	msl := ObserverPosition{Latitude: 40.0, Longitude: -74.0, Altitude: 500, HeightRef: HeightMSL, GeoidUndulation: -35}
+	ellipsoid := ObserverPosition{Latitude: 40.0, Longitude: -74.0, Altitude: 500}
+	equivalent := ObserverPosition{Latitude: 40.0, Longitude: -74.0, Altitude: 465}
+
+	mslResult, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, targetTime, msl)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionWithObserver failed: %v", err)
+	}
+	ellipsoidResult, _ := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, targetTime, ellipsoid)
+	equivalentResult, _ := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, targetTime, equivalent)
+
+	if mslResult.LookAngles.Range != equivalentResult.LookAngles.Range {
+		t.Errorf("MSL observer should match ellipsoid observer at corrected height: %f vs %f",
+			mslResult.LookAngles.Range, equivalentResult.LookAngles.Range)
+	}
+	if mslResult.LookAngles.Range == ellipsoidResult.LookAngles.Range {
+		t.Error("Expected MSL and ellipsoid heights to produce different ranges")
+	}
+
+	// The ISS is above the horizon at this time, so lowering the observer moves it further away
+	if mslResult.LookAngles.Elevation <= 0 {
+		t.Fatalf("Expected satellite above the horizon, got elevation %f", mslResult.LookAngles.Elevation)
+	}
+	if mslResult.LookAngles.Range <= ellipsoidResult.LookAngles.Range {
+		t.Errorf("Expected lower observer to have larger range: %f <= %f",
+			mslResult.LookAngles.Range, ellipsoidResult.LookAngles.Range)
+	}
+}
+
+func TestCalculateSGP4PositionWithDynamicObserver_NilFunc(t *testing.T) {
+	targetTime := time.Date(2004, 8, 23, 13, 0, 0, 0, time.UTC)
+
+	if _, err := CalculateSGP4PositionWithDynamicObserver(testTLELine1, testTLELine2, targetTime, nil); err == nil {
+		t.Error("Expected error for nil observer function")
+	}
+}
+
 // Test print functions to ensure they don't panic
 func TestPrintSGP4Position(t *testing.T) {
 	pos := SGPPosition{
@@ -909,3 +1077,163 @@ func TestPrintSGP4PositionWithLookAngles_EdgeCases(t *testing.T) {
 	}
 }
 
+func TestSuggestStep(t *testing.T) {
+	leo := TLE{MeanMotion: 15.5}
+	geo := TLE{MeanMotion: 1.0027}
+
+	leoStep := SuggestStep(leo, StepPurposeGroundTrack)
+	geoStep := SuggestStep(geo, StepPurposeGroundTrack)
+	if leoStep >= geoStep {
+		t.Errorf("LEO step %v should be smaller than GEO step %v", leoStep, geoStep)
+	}
+	// A ~93 minute LEO orbit sampled 360 times gives roughly 15 seconds
+	if leoStep < 14*time.Second || leoStep > 17*time.Second {
+		t.Errorf("LEO ground-track step = %v, want about 15s", leoStep)
+	}
+
+	if got := SuggestStep(geo, StepPurposePassDetection); got != 10*time.Second {
+		t.Errorf("Pass detection step = %v, want 10s", got)
+	}
+	if got := SuggestStep(TLE{}, StepPurposeGroundTrack); got <= 0 {
+		t.Errorf("Missing mean motion should still give a positive step, got %v", got)
+	}
+}
+
+func TestCalculateSGP4PositionFromTLERecord(t *testing.T) {
+	targetTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	tle := ConstructTLE("ISS (ZARYA)", testTLELine1, testTLELine2)
+
+	if tle.RawLine1 != testTLELine1 || tle.RawLine2 != testTLELine2 {
+		t.Fatalf("ConstructTLE should store the raw lines, got %q / %q", tle.RawLine1, tle.RawLine2)
+	}
+
+	got, err := CalculateSGP4PositionFromTLERecord(tle, targetTime)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionFromTLERecord failed: %v", err)
+	}
+	want, err := CalculateSGP4Position(testTLELine1, testTLELine2, targetTime)
+	if err != nil {
+		t.Fatalf("CalculateSGP4Position failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("Position from record = %+v, want %+v", got, want)
+	}
+
+	fallback, err := CalculateSGP4PositionFromTLE(tle, "", "", targetTime)
+	if err != nil || fallback != want {
+		t.Errorf("CalculateSGP4PositionFromTLE with empty lines = %+v, %v; want %+v", fallback, err, want)
+	}
+
+	_, err = CalculateSGP4PositionFromTLERecord(TLE{CommonName: "No Lines"}, targetTime)
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeTLEInsufficientData {
+		t.Errorf("Expected %s error without raw lines, got %v", ErrCodeTLEInsufficientData, err)
+	}
+}
+
+func TestValidateTLEChecksum_ISS(t *testing.T) {
+	for _, line := range []string{testTLELine1, testTLELine2} {
+		if ok, err := ValidateTLEChecksum(line); err != nil || !ok {
+			t.Errorf("ValidateTLEChecksum(%q) = %v, %v; want true", line, ok, err)
+		}
+	}
+	if appErr := tleChecksumWarning(testTLELine1, testTLELine2); appErr != nil {
+		t.Errorf("tleChecksumWarning() = %v, want nil for the ISS TLE", appErr)
+	}
+
+	// Change one digit of the inclination: 51.6335 becomes 51.6336
+	mutated := strings.Replace(testTLELine2, "51.6335", "51.6336", 1)
+	if ok, err := ValidateTLEChecksum(mutated); err != nil || ok {
+		t.Errorf("ValidateTLEChecksum(%q) = %v, %v; want false", mutated, ok, err)
+	}
+	appErr := tleChecksumWarning(testTLELine1, mutated)
+	if appErr == nil || appErr.Code != ErrCodeTLEChecksumFailed {
+		t.Fatalf("tleChecksumWarning() = %v, want %s", appErr, ErrCodeTLEChecksumFailed)
+	}
+	if !strings.Contains(appErr.Message, "line 2") {
+		t.Errorf("tleChecksumWarning() message = %q, want it to name line 2", appErr.Message)
+	}
+
+	// The element set still parses, so a bad checksum only warns
+	if tle := ConstructTLE("ISS", testTLELine1, mutated); tle.SatelliteCatalogNumber != 25544 {
+		t.Errorf("ConstructTLE() catalog number = %d, want 25544", tle.SatelliteCatalogNumber)
+	}
+}
+
+func TestCalculateSGP4PositionWithObserver_RangeRateMatchesRangeDifference(t *testing.T) {
+	observer := ObserverPosition{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+
+	for _, offset := range []time.Duration{0, 10 * time.Minute, 40 * time.Minute} {
+		at := start.Add(offset)
+		now, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, at, observer)
+		if err != nil {
+			t.Fatalf("CalculateSGP4PositionWithObserver() error: %v", err)
+		}
+		later, err := CalculateSGP4PositionWithObserver(testTLELine1, testTLELine2, at.Add(time.Second), observer)
+		if err != nil {
+			t.Fatalf("CalculateSGP4PositionWithObserver() error: %v", err)
+		}
+
+		// Range rate in km/s is the change in range over one second, up to the curvature of the track
+		difference := later.LookAngles.Range - now.LookAngles.Range
+		if math.Abs(now.LookAngles.RangeRate-difference) > 0.01 {
+			t.Errorf("at %v: RangeRate = %.4f km/s, range changed by %.4f km in 1s", at, now.LookAngles.RangeRate, difference)
+		}
+	}
+}
+
+// eciAbove returns the inertial position of a point alt km above latitude and longitude at t.
+func eciAbove(latitude, longitude, alt float64, t time.Time) satellite.Vector3 {
+	jday := satellite.JDay(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second())
+	return satellite.LLAToECI(satellite.LatLong{Latitude: latitude * degreesToRadians, Longitude: longitude * degreesToRadians}, alt, jday)
+}
+
+func TestIsSunlit(t *testing.T) {
+	equinoxNoon := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	solsticeNoon := time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		latitude, longitude float64
+		alt                 float64
+		at                  time.Time
+		want                bool
+	}{
+		{"daytime sub-point near Greenwich", 0, 0, 400, equinoxNoon, true},
+		{"nighttime sub-point over the Pacific", 0, 180, 400, equinoxNoon, false},
+		{"high orbit beside the shadow", 20, 180, 40000, equinoxNoon, true},
+		{"arctic in polar day", 80, 180, 400, solsticeNoon, true},
+		{"antarctic in polar night", -80, 180, 400, solsticeNoon, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSunlit(eciAbove(tt.latitude, tt.longitude, tt.alt, tt.at), tt.at); got != tt.want {
+				t.Errorf("IsSunlit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateSGP4PositionWithIllumination(t *testing.T) {
+	at := time.Date(2004, 8, 24, 12, 0, 0, 0, time.UTC)
+	pos, err := CalculateSGP4PositionWithIllumination(testTLELine1, testTLELine2, at)
+	if err != nil {
+		t.Fatalf("CalculateSGP4PositionWithIllumination() failed: %v", err)
+	}
+	if pos.Sunlit == nil {
+		t.Fatal("Sunlit should be set")
+	}
+	if want := satelliteSunlit(testTLELine1, testTLELine2, at); *pos.Sunlit != want {
+		t.Errorf("Sunlit = %v, want %v", *pos.Sunlit, want)
+	}
+
+	plain, _ := CalculateSGP4Position(testTLELine1, testTLELine2, at)
+	if plain.Sunlit != nil || plain.Latitude != pos.Latitude || plain.Longitude != pos.Longitude {
+		t.Errorf("Illumination should not change the position: %+v vs %+v", plain, pos)
+	}
+
+	if _, err := CalculateSGP4PositionWithIllumination("invalid", testTLELine2, at); err == nil {
+		t.Error("Expected error for an invalid TLE")
+	}
+}
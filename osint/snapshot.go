@@ -0,0 +1,278 @@
+package osint
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FavoritePosition is the SGP4 position of one favorite satellite at a snapshot time.
+type FavoritePosition struct {
+	SatelliteName string  `json:"satellite_name"`
+	NORADID       string  `json:"norad_id"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	Altitude      float64 `json:"altitude_km"`
+	Timestamp     int64   `json:"timestamp"`
+}
+
+// SnapshotOptions bounds how favorites are looked up when taking a snapshot.
+type SnapshotOptions struct {
+	// MaxConcurrency is how many favorites are looked up at the same time.
+	MaxConcurrency int
+	// ItemTimeout is how long one favorite may take before it is reported as failed and skipped.
+	ItemTimeout time.Duration
+}
+
+const (
+	defaultSnapshotConcurrency = 4
+	defaultSnapshotItemTimeout = 30 * time.Second
+)
+
+// SnapshotSettings holds the options used by the favorites snapshot menu entry. main applies
+// config overrides to it.
+var SnapshotSettings = SnapshotOptions{
+	MaxConcurrency: defaultSnapshotConcurrency,
+	ItemTimeout:    defaultSnapshotItemTimeout,
+}
+
+// TLESource returns the two element lines of the latest TLE for a NORAD ID. Cancelling ctx
+// abandons the lookup.
+type TLESource func(ctx context.Context, norad string) (string, string, error)
+
+// newSnapshotTLESource creates the TLE source SnapshotFavorites looks favorites up with: a
+// logged-in Space-Track session whose answers are cached.
+var newSnapshotTLESource = func() TLESource {
+	return loggedInTLESource(Login)
+}
+
+// loggedInTLESource logs in with login straight away, on the caller's goroutine, so a rejected
+// login can ask for new credentials before any lookup starts, and returns a cached Space-Track
+// TLESource using the session.
+func loggedInTLESource(login func() (*http.Client, error)) TLESource {
+	client, err := login()
+	return cachingTLESource(spaceTrackTLESource(client, err))
+}
+
+// spaceTrackTLESource returns a TLESource that fetches from Space-Track with an already
+// logged-in client. Lookups may run concurrently. If loginErr is set every lookup fails with it,
+// so each favorite is reported without asking for credentials again.
+func spaceTrackTLESource(client *http.Client, loginErr error) TLESource {
+	return func(ctx context.Context, norad string) (string, string, error) {
+		if loginErr != nil {
+			return "", "", loginErr
+		}
+		if err := ctx.Err(); err != nil {
+			return "", "", err
+		}
+
+		endpoint := fmt.Sprintf("/class/gp_history/format/tle/NORAD_CAT_ID/%s/orderby/EPOCH%%20desc/limit/1", norad)
+		data, err := QuerySpaceTrackContext(ctx, client, endpoint)
+		if err != nil {
+			return "", "", err
+		}
+
+		lines := strings.Split(strings.TrimSpace(data), "\n")
+		if len(lines) < 2 {
+			return "", "", NewAppErrorWithContext(ErrCodeTLEInsufficientData, "No TLE returned", "NORAD ID: "+norad)
+		}
+		return strings.TrimSpace(lines[0]), strings.TrimSpace(lines[1]), nil
+	}
+}
+
+// SnapshotFavorites propagates every favorite satellite to t with SGP4. Element sets are fetched
+// from Space-Track, so no N2YO quota is used. Up to opts.MaxConcurrency favorites are looked up at
+// once, and one that takes longer than opts.ItemTimeout is given up on. Satellites that fail are
+// left out and reported in the returned error alongside the positions that succeeded, which keep
+// the order of the favorites list.
+func SnapshotFavorites(t time.Time, opts SnapshotOptions) ([]FavoritePosition, error) {
+	favorites, err := LoadFavorites()
+	if err != nil {
+		return nil, err
+	}
+	if len(favorites) == 0 {
+		return nil, nil
+	}
+	if opts.MaxConcurrency < 1 {
+		opts.MaxConcurrency = 1
+	}
+
+	source := newSnapshotTLESource()
+	results := make([]FavoritePosition, len(favorites))
+	itemErrs := make([]error, len(favorites))
+	slots := make(chan struct{}, opts.MaxConcurrency)
+	var wg sync.WaitGroup
+	for i, fav := range favorites {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(idx int, fav FavoriteSatellite) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			pos, err := snapshotFavorite(source, fav.NORADID, t, opts.ItemTimeout)
+			if err != nil {
+				itemErrs[idx] = fmt.Errorf("%s (%s): %w", fav.SatelliteName, fav.NORADID, err)
+				return
+			}
+			results[idx] = FavoritePosition{
+				SatelliteName: fav.SatelliteName,
+				NORADID:       fav.NORADID,
+				Latitude:      pos.Latitude,
+				Longitude:     pos.Longitude,
+				Altitude:      pos.Altitude,
+				Timestamp:     t.Unix(),
+			}
+		}(i, fav)
+	}
+	wg.Wait()
+
+	positions := make([]FavoritePosition, 0, len(favorites))
+	for i := range favorites {
+		if itemErrs[i] == nil {
+			positions = append(positions, results[i])
+		}
+	}
+	return positions, errors.Join(itemErrs...)
+}
+
+// snapshotFavorite fetches one favorite's element set and propagates it to t, giving up after
+// timeout. A lookup that times out is cancelled, so it stops using the API.
+func snapshotFavorite(source TLESource, norad string, t time.Time, timeout time.Duration) (SGPPosition, error) {
+	type outcome struct {
+		pos SGPPosition
+		err error
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan outcome, 1)
+	go func() {
+		line1, line2, err := source(ctx, norad)
+		if err != nil {
+			done <- outcome{err: err}
+			return
+		}
+		pos, err := CalculateSGP4Position(line1, line2, t)
+		done <- outcome{pos: pos, err: err}
+	}()
+
+	if timeout <= 0 {
+		result := <-done
+		return result.pos, result.err
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case result := <-done:
+		return result.pos, result.err
+	case <-timer.C:
+		return SGPPosition{}, NewAppErrorWithContext(ErrCodeNetworkTimeout, "Lookup timed out", fmt.Sprintf("Gave up after %s", timeout))
+	}
+}
+
+// exportFavoritesSnapshotCSV writes one row per satellite.
+func exportFavoritesSnapshotCSV(positions []FavoritePosition, filePath string) error {
+	file, err := createExportFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+
+	headers := []string{"Satellite Name", "NORAD ID", "Latitude", "Longitude", "Altitude (km)", "Timestamp"}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, pos := range positions {
+		row := []string{
+			pos.SatelliteName,
+			pos.NORADID,
+			FormatQuantity(QuantityCoordinate, pos.Latitude),
+			FormatQuantity(QuantityCoordinate, pos.Longitude),
+			FormatQuantity(QuantityAltitude, pos.Altitude),
+			fmt.Sprintf("%d", pos.Timestamp),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+	return closeCSVExport(file, writer)
+}
+
+// exportFavoritesSnapshotJSON writes the snapshot as a JSON document.
+func exportFavoritesSnapshotJSON(positions []FavoritePosition, filePath string) error {
+	exportData := map[string]interface{}{
+		"positions":        positions,
+		"export_timestamp": time.Now().Format(time.RFC3339),
+	}
+
+	jsonData, err := json.MarshalIndent(exportData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+	return nil
+}
+
+// exportFavoritesSnapshotGeoJSON writes one Point feature per satellite.
+func exportFavoritesSnapshotGeoJSON(positions []FavoritePosition, filePath string) error {
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	for _, pos := range positions {
+		collection.Features = append(collection.Features, newGeoJSONPoint(pos.Latitude, pos.Longitude, map[string]interface{}{
+			"name":        pos.SatelliteName,
+			"norad_id":    pos.NORADID,
+			"altitude_km": pos.Altitude,
+			"timestamp":   pos.Timestamp,
+		}))
+	}
+
+	jsonData, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal GeoJSON: %w", err)
+	}
+	if err := writeExportFile(filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write GeoJSON file: %w", err)
+	}
+	return nil
+}
+
+// SnapshotFavoritesExport computes the current position of every favorite and exports them to one file.
+func SnapshotFavoritesExport() {
+	positions, err := SnapshotFavorites(time.Now().UTC(), SnapshotSettings)
+	if err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Some favorites were skipped: "+err.Error()))
+	}
+	if len(positions) == 0 {
+		fmt.Println(Colorize(RoleWarning, "  [!] No favorite positions to export"))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Computed positions for %d favorite(s)", len(positions))))
+
+	defaultFilename := fmt.Sprintf("favorites_snapshot_%s", time.Now().Format("20060102_150405"))
+	format, filePath, err := showExportMenuWithFormats(defaultFilename, FormatCSV, FormatJSON, FormatGeoJSON)
+	if err != nil {
+		return
+	}
+
+	switch format {
+	case FormatCSV:
+		err = exportFavoritesSnapshotCSV(positions, filePath)
+	case FormatJSON:
+		err = exportFavoritesSnapshotJSON(positions, filePath)
+	case FormatGeoJSON:
+		err = exportFavoritesSnapshotGeoJSON(positions, filePath)
+	}
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Snapshot exported to "+filePath))
+}
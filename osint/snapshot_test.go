@@ -0,0 +1,218 @@
+package osint
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const vanguardTLELine1 = "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753"
+const vanguardTLELine2 = "2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667"
+
+// withSnapshotTLEs replaces the snapshot TLE source with a fixed set of element sets.
+func withSnapshotTLEs(t *testing.T, tles map[string][2]string) {
+	t.Helper()
+	original := newSnapshotTLESource
+	newSnapshotTLESource = func() TLESource {
+		return func(_ context.Context, norad string) (string, string, error) {
+			lines, ok := tles[norad]
+			if !ok {
+				return "", "", NewAppError(ErrCodeAPINoData, "No TLE for "+norad)
+			}
+			return lines[0], lines[1], nil
+		}
+	}
+	t.Cleanup(func() { newSnapshotTLESource = original })
+}
+
+func TestSnapshotFavorites_Export(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := SaveFavorites([]FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544"},
+		{SatelliteName: "VANGUARD 1", NORADID: "5"},
+	}); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+	withSnapshotTLEs(t, map[string][2]string{
+		"25544": {testTLELine1, testTLELine2},
+		"5":     {vanguardTLELine1, vanguardTLELine2},
+	})
+
+	at := time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC)
+	positions, err := SnapshotFavorites(at, SnapshotSettings)
+	if err != nil {
+		t.Fatalf("SnapshotFavorites() failed: %v", err)
+	}
+	if len(positions) != 2 {
+		t.Fatalf("Expected 2 positions, got %d", len(positions))
+	}
+	if positions[1].NORADID != "5" || positions[1].Timestamp != at.Unix() {
+		t.Errorf("Unexpected second position: %+v", positions[1])
+	}
+
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "snapshot.csv")
+	if err := exportFavoritesSnapshotCSV(positions, csvPath); err != nil {
+		t.Fatalf("exportFavoritesSnapshotCSV() failed: %v", err)
+	}
+	file, err := os.Open(csvPath)
+	if err != nil {
+		t.Fatalf("Failed to open CSV file: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected header plus 2 rows, got %d records", len(records))
+	}
+	if records[1][0] != "ISS (ZARYA)" || records[2][1] != "5" {
+		t.Errorf("Unexpected CSV rows: %v", records[1:])
+	}
+
+	geoPath := filepath.Join(dir, "snapshot.geojson")
+	if err := exportFavoritesSnapshotGeoJSON(positions, geoPath); err != nil {
+		t.Fatalf("exportFavoritesSnapshotGeoJSON() failed: %v", err)
+	}
+	content, err := os.ReadFile(geoPath)
+	if err != nil {
+		t.Fatalf("Failed to read GeoJSON file: %v", err)
+	}
+	var collection geoJSONFeatureCollection
+	if err := json.Unmarshal(content, &collection); err != nil {
+		t.Fatalf("GeoJSON did not parse: %v", err)
+	}
+	if collection.Type != "FeatureCollection" || len(collection.Features) != 2 {
+		t.Errorf("Expected FeatureCollection with 2 features, got %s with %d", collection.Type, len(collection.Features))
+	}
+}
+
+func TestSnapshotFavorites_PartialFailure(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := SaveFavorites([]FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544"},
+		{SatelliteName: "MISSING", NORADID: "99999"},
+	}); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+	withSnapshotTLEs(t, map[string][2]string{"25544": {testTLELine1, testTLELine2}})
+
+	positions, err := SnapshotFavorites(time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC), SnapshotSettings)
+	if err == nil {
+		t.Error("Expected an error naming the failed favorite")
+	}
+	if len(positions) != 1 || positions[0].NORADID != "25544" {
+		t.Errorf("Expected only the ISS position, got %+v", positions)
+	}
+}
+
+func TestSnapshotFavorites_SlowAndFailingItems(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := SaveFavorites([]FavoriteSatellite{
+		{SatelliteName: "HUNG", NORADID: "11111"},
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544"},
+		{SatelliteName: "BROKEN", NORADID: "22222"},
+		{SatelliteName: "VANGUARD 1", NORADID: "5"},
+	}); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	original := newSnapshotTLESource
+	newSnapshotTLESource = func() TLESource {
+		return func(_ context.Context, norad string) (string, string, error) {
+			switch norad {
+			case "11111":
+				<-release
+				return "", "", NewAppError(ErrCodeAPINoData, "Released")
+			case "22222":
+				return "", "", NewAppError(ErrCodeAPIResponseFailed, "Bad response")
+			case "25544":
+				return testTLELine1, testTLELine2, nil
+			}
+			return vanguardTLELine1, vanguardTLELine2, nil
+		}
+	}
+	t.Cleanup(func() { newSnapshotTLESource = original })
+
+	opts := SnapshotOptions{MaxConcurrency: 2, ItemTimeout: 50 * time.Millisecond}
+	positions, err := SnapshotFavorites(time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC), opts)
+	if err == nil {
+		t.Fatal("Expected errors for the hung and broken favorites")
+	}
+	if !strings.Contains(err.Error(), "HUNG (11111)") || !strings.Contains(err.Error(), "BROKEN (22222)") {
+		t.Errorf("Error should name both failed favorites, got %v", err)
+	}
+	if len(positions) != 2 || positions[0].NORADID != "25544" || positions[1].NORADID != "5" {
+		t.Errorf("Expected the ISS and Vanguard positions in favorites order, got %+v", positions)
+	}
+}
+
+func TestSnapshotFavorites_RejectedLogin(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := SaveFavorites([]FavoriteSatellite{
+		{SatelliteName: "ISS (ZARYA)", NORADID: "25544"},
+		{SatelliteName: "VANGUARD 1", NORADID: "5"},
+	}); err != nil {
+		t.Fatalf("SaveFavorites() failed: %v", err)
+	}
+
+	// The rejected login takes longer than the item timeout; it must not count against it.
+	attempts := 0
+	attempt := func() (*http.Client, error) {
+		attempts++
+		time.Sleep(50 * time.Millisecond)
+		return nil, NewAppError(ErrCodeAuthFailed, "Authentication failed with Space-Track API")
+	}
+	original := newSnapshotTLESource
+	newSnapshotTLESource = func() TLESource {
+		return loggedInTLESource(func() (*http.Client, error) { return loginWithRetry(attempt) })
+	}
+	t.Cleanup(func() { newSnapshotTLESource = original })
+	prompts := withReenterCredentials(t)
+	withConfirmInput(t, io.NopCloser(strings.NewReader("n\n")))
+
+	opts := SnapshotOptions{MaxConcurrency: 2, ItemTimeout: 10 * time.Millisecond}
+	positions, err := SnapshotFavorites(time.Date(2004, 8, 24, 0, 0, 0, 0, time.UTC), opts)
+	if len(positions) != 0 {
+		t.Errorf("Expected no positions, got %+v", positions)
+	}
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAuthFailed {
+		t.Fatalf("Expected the login error for every favorite, got %v", err)
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("The login should not be bounded by the item timeout, got %v", err)
+	}
+	if attempts != 1 || *prompts != 0 {
+		t.Errorf("attempts = %d, prompts = %d; want one login and no re-entered credentials", attempts, *prompts)
+	}
+}
+
+func TestSnapshotFavorite_TimeoutCancelsLookup(t *testing.T) {
+	cancelled := make(chan struct{})
+	source := func(ctx context.Context, norad string) (string, string, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return "", "", ctx.Err()
+	}
+
+	if _, err := snapshotFavorite(source, "25544", time.Now(), 10*time.Millisecond); err == nil {
+		t.Fatal("Expected a timeout error")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("The timed out lookup was not cancelled")
+	}
+}
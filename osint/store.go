@@ -0,0 +1,78 @@
+package osint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// getAppDataPath returns the path of a file in the SatIntel data directory (see DataDir),
+// creating the directory if needed. It falls back to the current directory.
+func getAppDataPath(filename string) string {
+	return pathIn(DataDir, filename)
+}
+
+// getCachePath returns the path of a file in the SatIntel cache directory (see CacheDir),
+// creating the directory if needed. It falls back to the current directory.
+func getCachePath(filename string) string {
+	return pathIn(CacheDir, filename)
+}
+
+// loadJSONState decodes a JSON file from the data directory into v.
+// It returns false without error if the file does not exist yet.
+func loadJSONState(filename string, v interface{}) (bool, error) {
+	return loadJSONFile(getAppDataPath(filename), filename, v)
+}
+
+// saveJSONState writes v as indented JSON to a file in the data directory.
+func saveJSONState(filename string, v interface{}) error {
+	return saveJSONFile(getAppDataPath(filename), filename, v)
+}
+
+// loadJSONCache decodes a JSON file from the cache directory into v.
+// It returns false without error if the file does not exist yet.
+func loadJSONCache(filename string, v interface{}) (bool, error) {
+	return loadJSONFile(getCachePath(filename), filename, v)
+}
+
+// saveJSONCache writes v as indented JSON to a file in the cache directory.
+func saveJSONCache(filename string, v interface{}) error {
+	return saveJSONFile(getCachePath(filename), filename, v)
+}
+
+// loadJSONFile decodes the JSON file at path into v, naming it filename in errors.
+func loadJSONFile(path, filename string, v interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	return true, nil
+}
+
+// saveJSONFile writes v as indented JSON to path, naming it filename in errors.
+func saveJSONFile(path, filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", filename, err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	return nil
+}
+
+// removeJSONState deletes a state file from the data directory if it exists.
+func removeJSONState(filename string) error {
+	if err := os.Remove(getAppDataPath(filename)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s: %w", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	earthMuKm3s2     = 398600.4418 // Earth's gravitational parameter, km^3/s^2
+	earthRadiusKm    = 6378.137    // WGS84 equatorial radius, km
+	earthJ2          = 1.08262668e-3
+	julianDateJ2000  = 2451545.0
+	unixEpochJulian  = 2440587.5
+	secondsPerDay    = 86400.0
+	degreesToRadians = math.Pi / 180
+)
+
+// TLEEpochTime converts a TLE epoch (two-digit year and fractional day of year, YYDDD.DDDDDDDD) to UTC.
+// Years 57-99 are 1957-1999 and 00-56 are 2000-2056, as in the TLE specification.
+func TLEEpochTime(epoch float64) time.Time {
+	year := int(epoch / 1000)
+	if year < 57 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	dayOfYear := epoch - float64(int(epoch/1000))*1000
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start.Add(time.Duration((dayOfYear - 1) * secondsPerDay * float64(time.Second)))
+}
+
+// julianDate returns the Julian date of t.
+func julianDate(t time.Time) float64 {
+	return float64(t.UnixNano())/1e9/secondsPerDay + unixEpochJulian
+}
+
+// sunDirectionECI returns the unit vector from Earth to the Sun in equatorial inertial coordinates,
+// using the low-precision solar position from the Astronomical Almanac (about 0.01 degree accuracy).
+func sunDirectionECI(t time.Time) [3]float64 {
+	n := julianDate(t) - julianDateJ2000
+	meanLongitude := 280.460 + 0.9856474*n
+	meanAnomaly := (357.528 + 0.9856003*n) * degreesToRadians
+	eclipticLongitude := (meanLongitude + 1.915*math.Sin(meanAnomaly) + 0.020*math.Sin(2*meanAnomaly)) * degreesToRadians
+	obliquity := (23.439 - 0.0000004*n) * degreesToRadians
+
+	return [3]float64{
+		math.Cos(eclipticLongitude),
+		math.Cos(obliquity) * math.Sin(eclipticLongitude),
+		math.Sin(obliquity) * math.Sin(eclipticLongitude),
+	}
+}
+
+// nodalPrecessionRate returns the J2 drift of the ascending node in rad/s. It is zero when the
+// mean motion is unknown.
+func nodalPrecessionRate(tle TLE) float64 {
+	if tle.MeanMotion <= 0 {
+		return 0
+	}
+	meanMotion := tle.MeanMotion * 2 * math.Pi / secondsPerDay // rad/s
+	semiMajorAxis := math.Cbrt(earthMuKm3s2 / (meanMotion * meanMotion))
+	semiLatusRectum := semiMajorAxis * (1 - tle.Eccentrcity*tle.Eccentrcity)
+	inclination := tle.OrbitInclination * degreesToRadians
+	return -1.5 * meanMotion * earthJ2 * math.Pow(earthRadiusKm/semiLatusRectum, 2) * math.Cos(inclination)
+}
+
+// raanAt returns the right ascension of the ascending node in degrees at t, advancing the
+// epoch value by the J2 nodal precession rate.
+func raanAt(tle TLE, t time.Time) float64 {
+	raan := tle.RightAscension
+	if tle.MeanMotion <= 0 || tle.ElementSetEpoch == 0 {
+		return raan
+	}
+
+	elapsed := t.Sub(tle.EpochTime()).Seconds()
+	return math.Mod(raan+nodalPrecessionRate(tle)*elapsed/degreesToRadians, 360)
+}
+
+const (
+	// sunSynchronousRate is the nodal precession that keeps pace with the mean Sun: one turn per
+	// tropical year, in rad/s.
+	sunSynchronousRate = 2 * math.Pi / (365.2422 * secondsPerDay)
+	// sunSynchronousTolerance is the fraction the precession may differ from sunSynchronousRate.
+	sunSynchronousTolerance = 0.05
+)
+
+// IsSunSynchronous reports whether the orbit's node precesses eastward at the rate of the mean
+// Sun, so it keeps the same local solar time.
+func IsSunSynchronous(tle TLE) bool {
+	rate := nodalPrecessionRate(tle)
+	return math.Abs(rate-sunSynchronousRate) <= sunSynchronousTolerance*sunSynchronousRate
+}
+
+// meanSunRightAscension returns the right ascension of the mean Sun in degrees at t.
+func meanSunRightAscension(t time.Time) float64 {
+	n := julianDate(t) - julianDateJ2000
+	return math.Mod(280.460+0.9856474*n, 360)
+}
+
+// MeanLocalTimeAscendingNode returns the mean local solar time of the ascending node in hours
+// (0-24) at t. For a sun-synchronous orbit it stays nearly constant.
+func MeanLocalTimeAscendingNode(tle TLE, t time.Time) float64 {
+	hourAngle := raanAt(tle, t) - meanSunRightAscension(t)
+	return math.Mod(math.Mod(12+hourAngle/15, 24)+24, 24)
+}
+
+// formatLocalTime renders fractional hours as HH:MM.
+func formatLocalTime(hours float64) string {
+	minutes := int(math.Round(hours*60)) % (24 * 60)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// BetaAngle returns the angle in degrees between the Sun vector and the orbit plane at t.
+// Positive values mean the Sun is on the side of the orbit normal. Larger magnitudes mean
+// shorter (or no) eclipses.
+func BetaAngle(tle TLE, t time.Time) float64 {
+	inclination := tle.OrbitInclination * degreesToRadians
+	raan := raanAt(tle, t) * degreesToRadians
+
+	normal := [3]float64{
+		math.Sin(inclination) * math.Sin(raan),
+		-math.Sin(inclination) * math.Cos(raan),
+		math.Cos(inclination),
+	}
+	sun := sunDirectionECI(t)
+
+	dot := normal[0]*sun[0] + normal[1]*sun[1] + normal[2]*sun[2]
+	return math.Asin(math.Max(-1, math.Min(1, dot))) / degreesToRadians
+}
@@ -0,0 +1,142 @@
+package osint
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTLEEpochTime(t *testing.T) {
+	tests := []struct {
+		epoch float64
+		want  time.Time
+	}{
+		{24172.86875, time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)},
+		{4236.56031392, time.Date(2004, 8, 23, 13, 26, 51, 122688000, time.UTC)},
+		{98001.0, time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := TLEEpochTime(tt.epoch)
+		if diff := got.Sub(tt.want); diff > time.Millisecond || diff < -time.Millisecond {
+			t.Errorf("TLEEpochTime(%v) = %v, want %v", tt.epoch, got, tt.want)
+		}
+	}
+}
+
+func TestBetaAngle_KnownGeometry(t *testing.T) {
+	juneSolstice := time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)
+	marchEquinox := time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		tle       TLE
+		at        time.Time
+		want      float64
+		tolerance float64
+	}{
+		{
+			// The orbit normal is the pole, so beta equals the solar declination
+			name: "equatorial orbit at June solstice",
+			tle:  TLE{OrbitInclination: 0, RightAscension: 0},
+			at:   juneSolstice,
+			want: 23.44, tolerance: 0.05,
+		},
+		{
+			// The Sun lies in the orbit plane at the equinox when the node points at it
+			name: "ISS-like orbit with node toward the Sun at March equinox",
+			tle:  TLE{OrbitInclination: 51.6, RightAscension: 0},
+			at:   marchEquinox,
+			want: 0, tolerance: 0.05,
+		},
+		{
+			// A polar dawn-dusk orbit faces the Sun edge-on
+			name: "polar orbit with normal toward the Sun at March equinox",
+			tle:  TLE{OrbitInclination: 90, RightAscension: 90},
+			at:   marchEquinox,
+			want: 90, tolerance: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BetaAngle(tt.tle, tt.at)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("BetaAngle() = %.4f, want %.2f ± %.2f", got, tt.want, tt.tolerance)
+			}
+		})
+	}
+}
+
+func TestRaanAt_NodalPrecession(t *testing.T) {
+	// A sun-synchronous orbit's node advances about 0.9856 degrees per day
+	tle := TLE{
+		OrbitInclination: 98.2,
+		RightAscension:   90,
+		Eccentrcity:      0.0001,
+		MeanMotion:       14.57,
+		ElementSetEpoch:  24080.12916667,
+	}
+	start := TLEEpochTime(tle.ElementSetEpoch)
+	rate := raanAt(tle, start.Add(10*24*time.Hour)) - raanAt(tle, start)
+	if math.Abs(rate/10-0.9856) > 0.05 {
+		t.Errorf("Sun-synchronous node moved %.4f deg/day, want ~0.9856", rate/10)
+	}
+
+	// A prograde ISS-like orbit regresses by roughly 5 degrees per day
+	tle.OrbitInclination = 51.64
+	tle.MeanMotion = 15.5
+	rate = raanAt(tle, start.Add(24*time.Hour)) - raanAt(tle, start)
+	if rate > -4.5 || rate < -5.5 {
+		t.Errorf("ISS-like node moved %.4f deg/day, want about -5", rate)
+	}
+}
+
+func TestIsSunSynchronous(t *testing.T) {
+	tests := []struct {
+		name string
+		tle  TLE
+		want bool
+	}{
+		// NOAA 19: 99.2° inclination at about 870 km
+		{"NOAA 19", TLE{OrbitInclination: 99.19, Eccentrcity: 0.0014, MeanMotion: 14.1247}, true},
+		// Sentinel-2A: 98.6° inclination at about 786 km
+		{"Sentinel-2A", TLE{OrbitInclination: 98.57, Eccentrcity: 0.0001, MeanMotion: 14.3082}, true},
+		{"ISS", TLE{OrbitInclination: 51.64, Eccentrcity: 0.0005, MeanMotion: 15.50}, false},
+		{"polar but not SSO", TLE{OrbitInclination: 90, Eccentrcity: 0.001, MeanMotion: 14.2}, false},
+		{"no mean motion", TLE{OrbitInclination: 98.6}, false},
+	}
+	for _, tt := range tests {
+		if got := IsSunSynchronous(tt.tle); got != tt.want {
+			t.Errorf("IsSunSynchronous(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMeanLocalTimeAscendingNode(t *testing.T) {
+	at := time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)
+	sun := meanSunRightAscension(at)
+
+	// A node 90 degrees east of the mean Sun crosses the equator at 18:00 local time
+	dusk := TLE{OrbitInclination: 98.6, RightAscension: math.Mod(sun+90, 360)}
+	if got := MeanLocalTimeAscendingNode(dusk, at); math.Abs(got-18) > 0.01 {
+		t.Errorf("MeanLocalTimeAscendingNode() = %.3f, want 18", got)
+	}
+	// A node opposite the Sun crosses at midnight
+	night := TLE{OrbitInclination: 98.6, RightAscension: math.Mod(sun+180, 360)}
+	if got := MeanLocalTimeAscendingNode(night, at); math.Min(got, 24-got) > 0.01 {
+		t.Errorf("MeanLocalTimeAscendingNode() = %.3f, want 0", got)
+	}
+
+	// A sun-synchronous orbit keeps its local time over months
+	sso := TLE{OrbitInclination: 98.57, Eccentrcity: 0.0001, MeanMotion: 14.3082,
+		RightAscension: math.Mod(sun-30, 360), ElementSetEpoch: 24080.12916667}
+	start := TLEEpochTime(sso.ElementSetEpoch)
+	drift := MeanLocalTimeAscendingNode(sso, start.Add(90*24*time.Hour)) - MeanLocalTimeAscendingNode(sso, start)
+	if math.Abs(drift) > 0.25 {
+		t.Errorf("SSO local time drifted %.2f hours over 90 days", drift)
+	}
+
+	if got := formatLocalTime(10.5); got != "10:30" {
+		t.Errorf("formatLocalTime(10.5) = %q, want 10:30", got)
+	}
+}
@@ -0,0 +1,129 @@
+package osint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TwiN/go-color"
+)
+
+// ColorRole identifies what a piece of UI text is for, so its color can come from the active theme.
+type ColorRole int
+
+const (
+	RoleHeader  ColorRole = iota // Table borders and rows
+	RoleSuccess                  // "[+]" confirmations
+	RoleError                    // "[!] ERROR" messages
+	RoleWarning                  // Non-fatal warnings
+	RoleInfo                     // "[*]" progress and hints
+	RoleText                     // Plain values inside panels
+	RoleMuted                    // Secondary details
+	RoleAccent                   // Banners and farewell messages
+)
+
+// Theme maps UI roles to terminal color codes. An empty color leaves text uncolored.
+type Theme struct {
+	Name         string
+	HeaderColor  string
+	SuccessColor string
+	ErrorColor   string
+	WarningColor string
+	InfoColor    string
+	TextColor    string
+	MutedColor   string
+	AccentColor  string
+}
+
+// themes holds the built-in themes selectable by name.
+var themes = map[string]Theme{
+	"default": {
+		Name:         "default",
+		HeaderColor:  color.Purple,
+		SuccessColor: color.Green,
+		ErrorColor:   color.Red,
+		WarningColor: color.Yellow,
+		InfoColor:    color.Cyan,
+		TextColor:    color.White,
+		MutedColor:   color.Gray,
+		AccentColor:  color.Blue,
+	},
+	"mono": {
+		Name: "mono",
+	},
+	"highcontrast": {
+		Name:         "highcontrast",
+		HeaderColor:  color.Bold + color.White,
+		SuccessColor: color.Bold + color.Blue,
+		ErrorColor:   color.Bold + color.White + color.RedBackground,
+		WarningColor: color.Bold + color.Yellow,
+		InfoColor:    color.Bold + color.Cyan,
+		TextColor:    color.Bold + color.White,
+		MutedColor:   color.White,
+		AccentColor:  color.Bold + color.Cyan,
+	},
+}
+
+// currentTheme is the theme used by Colorize.
+var currentTheme = themes["default"]
+
+// SetTheme selects a built-in theme by name (case-insensitive).
+func SetTheme(name string) error {
+	theme, ok := themes[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return NewAppErrorWithContext(
+			ErrCodeInputInvalid,
+			fmt.Sprintf("Unknown theme: %s", name),
+			fmt.Sprintf("Available themes: %s", strings.Join(ThemeNames(), ", ")),
+		)
+	}
+	currentTheme = theme
+	return nil
+}
+
+// CurrentTheme returns the active theme.
+func CurrentTheme() Theme {
+	return currentTheme
+}
+
+// ThemeNames returns the names of the built-in themes in sorted order.
+func ThemeNames() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// colorFor returns the color code the theme assigns to role.
+func (t Theme) colorFor(role ColorRole) string {
+	switch role {
+	case RoleHeader:
+		return t.HeaderColor
+	case RoleSuccess:
+		return t.SuccessColor
+	case RoleError:
+		return t.ErrorColor
+	case RoleWarning:
+		return t.WarningColor
+	case RoleInfo:
+		return t.InfoColor
+	case RoleText:
+		return t.TextColor
+	case RoleMuted:
+		return t.MutedColor
+	case RoleAccent:
+		return t.AccentColor
+	}
+	return ""
+}
+
+// Colorize wraps text in the active theme's color for role.
+func Colorize(role ColorRole, text string) string {
+	code := currentTheme.colorFor(role)
+	if code == "" {
+		return text
+	}
+	return color.Ize(code, text)
+}
@@ -0,0 +1,61 @@
+package osint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TwiN/go-color"
+)
+
+func TestSetTheme(t *testing.T) {
+	t.Cleanup(func() { SetTheme("default") })
+
+	for _, name := range ThemeNames() {
+		if err := SetTheme(name); err != nil {
+			t.Errorf("SetTheme(%q) failed: %v", name, err)
+		}
+		if CurrentTheme().Name != name {
+			t.Errorf("Expected current theme %q, got %q", name, CurrentTheme().Name)
+		}
+	}
+
+	if err := SetTheme("HighContrast"); err != nil {
+		t.Errorf("Expected theme names to be case-insensitive: %v", err)
+	}
+
+	if err := SetTheme("neon"); err == nil {
+		t.Error("Expected error for unknown theme")
+	}
+	if CurrentTheme().Name != "highcontrast" {
+		t.Errorf("Unknown theme should not change the current theme, got %q", CurrentTheme().Name)
+	}
+}
+
+func TestColorizeFollowsTheme(t *testing.T) {
+	t.Cleanup(func() { SetTheme("default") })
+
+	SetTheme("default")
+	defaultHeader := Colorize(RoleHeader, "text")
+	if defaultHeader != color.Ize(color.Purple, "text") {
+		t.Errorf("Expected default header to be purple, got %q", defaultHeader)
+	}
+	if Colorize(RoleError, "text") != color.Ize(color.Red, "text") {
+		t.Error("Expected default error to be red")
+	}
+
+	SetTheme("highcontrast")
+	contrastHeader := Colorize(RoleHeader, "text")
+	if contrastHeader == defaultHeader {
+		t.Error("Expected header color to change when switching themes")
+	}
+	if !strings.HasPrefix(contrastHeader, color.Bold) {
+		t.Errorf("Expected bold header in highcontrast theme, got %q", contrastHeader)
+	}
+
+	SetTheme("mono")
+	for _, role := range []ColorRole{RoleHeader, RoleSuccess, RoleError, RoleWarning, RoleInfo, RoleText, RoleMuted, RoleAccent} {
+		if got := Colorize(role, "text"); got != "text" {
+			t.Errorf("Expected mono theme to leave text uncolored for role %d, got %q", role, got)
+		}
+	}
+}
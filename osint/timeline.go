@@ -0,0 +1,269 @@
+package osint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimelineEntry is one window in a combined pass schedule. Overlapping visual and radio passes
+// are merged into a single entry marked with both types.
+type TimelineEntry struct {
+	Start        time.Time
+	End          time.Time
+	MaxTime      time.Time
+	MaxElevation float64
+	Visual       bool
+	Radio        bool
+	// VisibleStart and VisibleEnd bound the visible part of the window when Visual is set.
+	VisibleStart time.Time
+	VisibleEnd   time.Time
+	// Magnitude is the brightest visual magnitude, or unknownMagnitude when N2YO has no estimate.
+	Magnitude float64
+}
+
+// PassType describes which kinds of pass the entry covers.
+func (e TimelineEntry) PassType() string {
+	switch {
+	case e.Visual && e.Radio:
+		return "Visual + Radio"
+	case e.Visual:
+		return "Visual"
+	default:
+		return "Radio"
+	}
+}
+
+// MergePassTimelines combines visual and radio passes into one timeline sorted by start time.
+// Windows that overlap, including a visual pass inside the radio pass it belongs to, become a
+// single entry annotated with both pass types.
+func MergePassTimelines(visual []Pass, radio []RadioPass) []TimelineEntry {
+	var entries []TimelineEntry
+	for _, pass := range visual {
+		start := time.Unix(int64(pass.StartUTC), 0).UTC()
+		end := time.Unix(int64(pass.EndUTC), 0).UTC()
+		entries = append(entries, TimelineEntry{
+			Start:        start,
+			End:          end,
+			MaxTime:      time.Unix(int64(pass.MaxUTC), 0).UTC(),
+			MaxElevation: pass.MaxEl,
+			Visual:       true,
+			VisibleStart: start,
+			VisibleEnd:   end,
+			Magnitude:    pass.Mag,
+		})
+	}
+	for _, pass := range radio {
+		entries = append(entries, TimelineEntry{
+			Start:        time.Unix(pass.StartUTC, 0).UTC(),
+			End:          time.Unix(pass.EndUTC, 0).UTC(),
+			MaxTime:      time.Unix(pass.MaxUTC, 0).UTC(),
+			MaxElevation: pass.MaxEl,
+			Radio:        true,
+			Magnitude:    unknownMagnitude,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Start.Before(entries[j].Start) })
+
+	var merged []TimelineEntry
+	for _, entry := range entries {
+		if len(merged) == 0 || entry.Start.After(merged[len(merged)-1].End) {
+			merged = append(merged, entry)
+			continue
+		}
+		merged[len(merged)-1] = mergeTimelineEntries(merged[len(merged)-1], entry)
+	}
+	return merged
+}
+
+// mergeTimelineEntries combines two overlapping windows.
+func mergeTimelineEntries(a, b TimelineEntry) TimelineEntry {
+	merged := a
+	if b.End.After(merged.End) {
+		merged.End = b.End
+	}
+	if b.MaxElevation > merged.MaxElevation {
+		merged.MaxElevation = b.MaxElevation
+		merged.MaxTime = b.MaxTime
+	}
+	if b.Visual {
+		if !merged.Visual || b.VisibleStart.Before(merged.VisibleStart) {
+			merged.VisibleStart = b.VisibleStart
+		}
+		if !merged.Visual || b.VisibleEnd.After(merged.VisibleEnd) {
+			merged.VisibleEnd = b.VisibleEnd
+		}
+		merged.Visual = true
+	}
+	merged.Radio = merged.Radio || b.Radio
+	merged.Magnitude = min(merged.Magnitude, b.Magnitude)
+	return merged
+}
+
+// timelineLines renders a combined pass timeline, one line per window. Times are UTC.
+func timelineLines(satName string, entries []TimelineEntry) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Satellite Name", satName)),
+		Colorize(RoleHeader, GenRowString("Passes", strconv.Itoa(len(entries)))),
+		Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"),
+	}
+	for i, entry := range entries {
+		line := fmt.Sprintf("#%d  %-14s  %s - %s  maxEl %s° at %s",
+			i+1,
+			entry.PassType(),
+			entry.Start.Format("2006-01-02 15:04"),
+			entry.End.Format("15:04"),
+			FormatQuantity(QuantityAngle, entry.MaxElevation),
+			entry.MaxTime.Format("15:04"),
+		)
+		if entry.Visual {
+			line += fmt.Sprintf("  visible %s - %s", entry.VisibleStart.Format("15:04"), entry.VisibleEnd.Format("15:04"))
+			if entry.Magnitude < unknownMagnitude {
+				line += fmt.Sprintf("  mag %.1f", entry.Magnitude)
+			}
+		}
+		role := RoleText
+		if entry.Visual {
+			role = RoleSuccess
+		}
+		lines = append(lines, Colorize(role, "  "+line))
+	}
+	return lines
+}
+
+// GetCombinedPrediction fetches visual and radio passes for one satellite and location and shows
+// them as a single timeline.
+func GetCombinedPrediction() ([]TimelineEntry, error) {
+	selection := SatelliteSelection()
+	if selection.norad == "" {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT"))
+		return nil, NewAppError(ErrCodeSatInvalidNORAD, "No satellite selected")
+	}
+
+	latitude, longitude, autoDetected := GetLocationWithPrompt()
+	if latitude == "" || longitude == "" {
+		return nil, NewAppError(ErrCodeInputEmpty, "Observer location is required")
+	}
+	if autoDetected {
+		fmt.Println(Colorize(RoleSuccess, "  [+] Using auto-detected location"))
+	}
+
+	altitude := scanWithDefault("ENTER ALTITUDE (meters, default: 0)", "0")
+	days := scanWithDefault("ENTER DAYS OF PREDICTION (default: 3)", "3")
+	elevation := scanWithDefault("ENTER MIN ELEVATION FOR RADIO PASSES (default: 10)", "10")
+	vis := scanWithDefault("ENTER MIN VISIBILITY FOR VISUAL PASSES (seconds, default: 60)", "60")
+
+	latitude = cleanNumericInput(latitude)
+	longitude = cleanNumericInput(longitude)
+	altitude = cleanNumericInput(altitude)
+
+	_, err := strconv.ParseFloat(latitude, 64)
+	_, err2 := strconv.ParseFloat(longitude, 64)
+	_, err3 := strconv.ParseFloat(altitude, 64)
+	_, err4 := strconv.Atoi(days)
+	_, err5 := strconv.Atoi(elevation)
+	_, err6 := strconv.Atoi(vis)
+	if err != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil || err6 != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: INVALID INPUT - Please enter valid numbers"))
+		return nil, NewAppError(ErrCodeInputInvalid, "Invalid prediction parameters")
+	}
+
+	spinner := ShowProgressWithSpinner("Fetching visual and radio pass predictions")
+	visual, err := FetchVisualPasses(selection.norad, latitude, longitude, altitude, days, vis)
+	if err != nil {
+		spinner.Stop()
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch visual pass predictions from N2YO API")
+		return nil, err
+	}
+	radio, err := FetchRadioPasses(selection.norad, latitude, longitude, altitude, days, elevation)
+	spinner.Stop()
+	if err != nil {
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch radio pass predictions from N2YO API")
+		return nil, err
+	}
+
+	entries := MergePassTimelines(visual.Passes, radio.Passes)
+	if len(entries) == 0 {
+		fmt.Println(Colorize(RoleInfo, "  [*] No visual or radio passes in the prediction window"))
+		return entries, nil
+	}
+	satName := radio.Info.SatName
+	if satName == "" {
+		satName = visual.Info.SatName
+	}
+	printPaged(timelineLines(satName, entries))
+	return entries, nil
+}
+
+// scanInput is where answers to typed prompts are read from.
+var scanInput io.Reader = os.Stdin
+
+// scanWithDefault prints a prompt and reads one word, returning def when the answer is empty.
+func scanWithDefault(label, def string) string {
+	fmt.Print("\n " + label + " > ")
+	var input string
+	fmt.Fscanln(scanInput, &input)
+	if input = strings.TrimSpace(input); input == "" {
+		return def
+	}
+	return input
+}
+
+// scanLine reads the rest of the current input line, spaces included. It reads a byte at a time
+// so nothing past the line is consumed from scanInput.
+func scanLine() string {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := scanInput.Read(b)
+		if n == 1 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			break
+		}
+	}
+	return strings.TrimSuffix(string(line), "\r")
+}
+
+// promptNumber asks for a number, re-prompting only this field until the answer is valid.
+// An empty answer takes def when it is set. Bounds of 0 and 0 mean any value is accepted.
+// It returns false if input ends before a valid answer.
+func promptNumber(label, fieldName, def string, min, max float64) (string, bool) {
+	for {
+		fmt.Print("\n " + label + " > ")
+		var input string
+		if _, err := fmt.Fscanln(scanInput, &input); err == io.EOF {
+			return "", false
+		}
+		input = strings.TrimSpace(input)
+		if input == "" && def != "" {
+			return def, true
+		}
+
+		normalized, err := normalizeNumberInput(input)
+		if err == nil {
+			input = normalized
+			err = ValidateNumericInput(input, fieldName, min, max)
+		}
+		if _, parseErr := strconv.ParseFloat(input, 64); err == nil && parseErr != nil {
+			err = NewAppErrorWithContext(ErrCodeInputInvalid, fieldName+" must be a number", fmt.Sprintf("Field: %s, Value: %s", fieldName, input))
+		}
+		if err == nil {
+			return input, true
+		}
+		if appErr, ok := err.(*AppError); ok {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+appErr.Message))
+		} else {
+			fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		}
+	}
+}
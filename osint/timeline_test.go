@@ -0,0 +1,120 @@
+package osint
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMergePassTimelines(t *testing.T) {
+	base := int64(1700000000)
+	visual := []Pass{
+		// Visible part of the first radio pass
+		{StartUTC: int(base + 120), MaxUTC: int(base + 300), EndUTC: int(base + 420), MaxEl: 40, Mag: -1.5},
+		// Visual pass with no matching radio pass
+		{StartUTC: int(base + 20000), MaxUTC: int(base + 20200), EndUTC: int(base + 20400), MaxEl: 25, Mag: unknownMagnitude},
+	}
+	radio := []RadioPass{
+		{StartUTC: base, MaxUTC: base + 310, EndUTC: base + 600, MaxEl: 42},
+		{StartUTC: base + 6000, MaxUTC: base + 6300, EndUTC: base + 6600, MaxEl: 15},
+	}
+
+	entries := MergePassTimelines(visual, radio)
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 timeline entries, got %d: %+v", len(entries), entries)
+	}
+
+	first := entries[0]
+	if first.PassType() != "Visual + Radio" {
+		t.Errorf("First entry type = %q, want Visual + Radio", first.PassType())
+	}
+	if !first.Start.Equal(time.Unix(base, 0)) || !first.End.Equal(time.Unix(base+600, 0)) {
+		t.Errorf("First entry window = %v - %v, want the radio pass window", first.Start, first.End)
+	}
+	if !first.VisibleStart.Equal(time.Unix(base+120, 0)) || !first.VisibleEnd.Equal(time.Unix(base+420, 0)) {
+		t.Errorf("First entry visible window = %v - %v, want the visual pass window", first.VisibleStart, first.VisibleEnd)
+	}
+	if first.MaxElevation != 42 || !first.MaxTime.Equal(time.Unix(base+310, 0)) {
+		t.Errorf("First entry peak = %.0f° at %v, want the higher radio peak", first.MaxElevation, first.MaxTime)
+	}
+	if first.Magnitude != -1.5 {
+		t.Errorf("First entry magnitude = %v, want -1.5", first.Magnitude)
+	}
+
+	if entries[1].PassType() != "Radio" || entries[2].PassType() != "Visual" {
+		t.Errorf("Entry types = %q, %q; want Radio, Visual", entries[1].PassType(), entries[2].PassType())
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Start.Before(entries[i-1].End) {
+			t.Errorf("Entries %d and %d overlap", i-1, i)
+		}
+	}
+}
+
+func TestMergePassTimelines_Empty(t *testing.T) {
+	if entries := MergePassTimelines(nil, nil); len(entries) != 0 {
+		t.Errorf("Expected no entries, got %+v", entries)
+	}
+}
+
+func withScanInput(t *testing.T, input string) {
+	t.Helper()
+	original := scanInput
+	scanInput = strings.NewReader(input)
+	t.Cleanup(func() { scanInput = original })
+}
+
+func TestPromptNumber_RepromptsInvalidField(t *testing.T) {
+	withScanInput(t, "abc\n12abc\n150\n")
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	altitude, ok := promptNumber("ENTER ALTITUDE (meters, default: 0)", "ALTITUDE", "0", 0, 0)
+	w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	if !ok || altitude != "150" {
+		t.Errorf("promptNumber() = %q, %v; want 150 after two invalid answers", altitude, ok)
+	}
+	if got := strings.Count(string(out), "ENTER ALTITUDE"); got != 3 {
+		t.Errorf("altitude was prompted %d times, want 3", got)
+	}
+	if !strings.Contains(string(out), "ALTITUDE must be a") {
+		t.Errorf("expected a field-specific error, got:\n%s", out)
+	}
+}
+
+func TestPromptNumber_DefaultAndEOF(t *testing.T) {
+	withScanInput(t, "\n")
+	if altitude, ok := promptNumber("ALT", "ALTITUDE", "0", 0, 0); !ok || altitude != "0" {
+		t.Errorf("promptNumber() with empty answer = %q, %v; want the default", altitude, ok)
+	}
+
+	withScanInput(t, "abc\n")
+	if _, ok := promptNumber("ALT", "ALTITUDE", "0", 0, 0); ok {
+		t.Error("promptNumber() should give up when input ends")
+	}
+}
+
+func TestPromptNumber_CommaDecimal(t *testing.T) {
+	withScanInput(t, "1,200\n1,5\n")
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	altitude, ok := promptNumber("ENTER ALTITUDE (meters, default: 0)", "ALTITUDE", "0", 0, 0)
+	w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	if !ok || altitude != "1.5" {
+		t.Errorf("promptNumber() = %q, %v; want 1.5 after rejecting 1,200", altitude, ok)
+	}
+	if !strings.Contains(string(out), "Ambiguous number") {
+		t.Errorf("Expected 1,200 to be rejected as ambiguous, got %q", out)
+	}
+}
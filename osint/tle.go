@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-
-	"github.com/TwiN/go-color"
-	"github.com/manifoldco/promptui"
+	"time"
 )
 
 type TLE struct {
@@ -29,6 +27,76 @@ type TLE struct {
 	MeanMotion                 float64
 	RevolutionNumber           int
 	ChecksumTwo                int
+	// RawLine1 and RawLine2 keep the element lines the record was parsed from, so SGP4 can
+	// propagate it without the caller holding on to them.
+	RawLine1 string
+	RawLine2 string
+}
+
+// EpochTime returns the element set epoch, stored as YYDDD.DDDDDDDD, as a UTC time.
+func (tle TLE) EpochTime() time.Time {
+	return TLEEpochTime(tle.ElementSetEpoch)
+}
+
+// formatEpoch renders a TLE epoch as a UTC timestamp.
+func formatEpoch(tle TLE) string {
+	return tle.EpochTime().Format("2006-01-02 15:04:05") + " UTC"
+}
+
+// ParseInternationalDesignator splits a COSPAR international designator into its launch year,
+// launch number of that year and piece letters. It accepts the TLE form "98067A" and the catalog
+// form "1998-067A". Two-digit years of 57 and later are in the 1900s, since no satellite launched
+// before 1957.
+func ParseInternationalDesignator(id string) (year int, launchNum int, piece string, err error) {
+	invalid := func(reason string) error {
+		return NewAppErrorWithContext(ErrCodeTLEInvalidFormat, "Invalid international designator: "+reason, "Designator: "+id)
+	}
+
+	designator := strings.ToUpper(strings.TrimSpace(id))
+	var yearDigits string
+	if full, rest, found := strings.Cut(designator, "-"); found {
+		if len(full) != 4 {
+			return 0, 0, "", invalid("the year must have four digits")
+		}
+		yearDigits, designator = full, rest
+	} else {
+		if len(designator) < 2 {
+			return 0, 0, "", invalid("too short")
+		}
+		yearDigits, designator = designator[:2], designator[2:]
+	}
+	if len(designator) < 4 || len(designator) > 6 {
+		return 0, 0, "", invalid("expected a three-digit launch number and one to three piece letters")
+	}
+
+	year, yearErr := strconv.Atoi(yearDigits)
+	launchNum, launchErr := strconv.Atoi(designator[:3])
+	if yearErr != nil || launchErr != nil || launchNum < 1 || strings.Trim(yearDigits+designator[:3], "0123456789") != "" {
+		return 0, 0, "", invalid("year and launch number must be digits")
+	}
+	piece = designator[3:]
+	if strings.Trim(piece, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return 0, 0, "", invalid("piece must be letters")
+	}
+
+	if len(yearDigits) == 2 {
+		if year >= 57 {
+			year += 1900
+		} else {
+			year += 2000
+		}
+	}
+	return year, launchNum, piece, nil
+}
+
+// formatLaunchDetails describes a designator as "Launch Year: 1998, Launch #67, Piece A", or
+// returns false when it cannot be decoded.
+func formatLaunchDetails(id string) (string, bool) {
+	year, launchNum, piece, err := ParseInternationalDesignator(id)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("Launch Year: %d, Launch #%d, Piece %s", year, launchNum, piece), true
 }
 
 // ConstructTLE parses two-line element data into a TLE struct.
@@ -36,6 +104,8 @@ type TLE struct {
 func ConstructTLE(one string, two string, three string) TLE {
 	tle := TLE{}
 	tle.CommonName = one
+	tle.RawLine1 = strings.TrimSpace(two)
+	tle.RawLine2 = strings.TrimSpace(three)
 	firstArr := strings.Fields(two)
 	secondArr := strings.Fields(three)
 
@@ -121,45 +191,242 @@ func ConstructTLE(one string, two string, three string) TLE {
 
 // PrintTLE displays the TLE data in a formatted table.
 func PrintTLE(tle TLE) {
-	fmt.Println(color.Ize(color.Purple, "\n╔═════════════════════════════════════════════════════════════╗"))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Name", tle.CommonName)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Satellite Catalog Number", fmt.Sprintf("%d", tle.SatelliteCatalogNumber))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Elset Classification", tle.ElsetClassificiation)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("International Designator", tle.InternationalDesignator)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Element Set Epoch (UTC)", fmt.Sprintf("%f", tle.ElementSetEpoch))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("1st Derivative of the Mean Motion", fmt.Sprintf("%f", tle.FirstDerivativeMeanMotion))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("2nd Derivative of the Mean Motion", tle.SecondDerivativeMeanMotion)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("B* Drag Term", tle.BDragTerm)))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Element Set Type", fmt.Sprintf("%d", tle.ElementSetType))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Element Number", fmt.Sprintf("%d", tle.ElementNumber))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Checksum Line One", fmt.Sprintf("%d", tle.ChecksumOne))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Orbit Inclination (degrees)", fmt.Sprintf("%f", tle.OrbitInclination))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Right Ascension of Ascending Node (degrees)", fmt.Sprintf("%f", tle.RightAscension))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Eccentricity", fmt.Sprintf("%f", tle.Eccentrcity))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Argument of Perigee (degrees)", fmt.Sprintf("%f", tle.Perigee))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Mean Anomaly (degrees)", fmt.Sprintf("%f", tle.MeanAnamoly))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Mean Motion (revolutions/day)", fmt.Sprintf("%f", tle.MeanMotion))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Revolution Number at Epoch", fmt.Sprintf("%d", tle.RevolutionNumber))))
-	fmt.Println(color.Ize(color.Purple, GenRowString("Checksum Line Two", fmt.Sprintf("%d", tle.ChecksumTwo))))
-
-	fmt.Println(color.Ize(color.Purple, "╚═════════════════════════════════════════════════════════════╝ \n\n"))
+	PrintTLEWithRawLines(tle, "", "")
+}
 
-	// Offer export option
-	exportPrompt := promptui.Prompt{
-		Label:     "Export TLE data? (y/n)",
-		Default:   "n",
-		AllowEdit: true,
+// PrintTLEWithRawLines displays the TLE data in a formatted table and, when the raw
+// element lines are available, offers to copy them to the clipboard.
+func PrintTLEWithRawLines(tle TLE, line1, line2 string) {
+	fmt.Println(Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Name", tle.CommonName)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Satellite Catalog Number", fmt.Sprintf("%d", tle.SatelliteCatalogNumber))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Elset Classification", tle.ElsetClassificiation)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("International Designator", tle.InternationalDesignator)))
+	if details, ok := formatLaunchDetails(tle.InternationalDesignator); ok {
+		fmt.Println(Colorize(RoleHeader, GenRowString("Launch", details)))
+	}
+	fmt.Println(Colorize(RoleHeader, GenRowString("Element Set Epoch (UTC)", formatEpoch(tle))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("1st Derivative of the Mean Motion", fmt.Sprintf("%f", tle.FirstDerivativeMeanMotion))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("2nd Derivative of the Mean Motion", tle.SecondDerivativeMeanMotion)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("B* Drag Term", tle.BDragTerm)))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Element Set Type", fmt.Sprintf("%d", tle.ElementSetType))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Element Number", fmt.Sprintf("%d", tle.ElementNumber))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Checksum Line One", fmt.Sprintf("%d", tle.ChecksumOne))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Orbit Inclination (degrees)", fmt.Sprintf("%f", tle.OrbitInclination))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Right Ascension of Ascending Node (degrees)", fmt.Sprintf("%f", tle.RightAscension))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Eccentricity", fmt.Sprintf("%f", tle.Eccentrcity))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Argument of Perigee (degrees)", fmt.Sprintf("%f", tle.Perigee))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Mean Anomaly (degrees)", fmt.Sprintf("%f", tle.MeanAnamoly))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Mean Motion (revolutions/day)", fmt.Sprintf("%f", tle.MeanMotion))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Revolution Number at Epoch", fmt.Sprintf("%d", tle.RevolutionNumber))))
+	fmt.Println(Colorize(RoleHeader, GenRowString("Checksum Line Two", fmt.Sprintf("%d", tle.ChecksumTwo))))
+	if tle.MeanMotion > 0 {
+		fmt.Println(Colorize(RoleHeader, GenRowString("Beta Angle Now (degrees)", FormatQuantity(QuantityAngle, BetaAngle(tle, time.Now().UTC())))))
+		if IsSunSynchronous(tle) {
+			fmt.Println(Colorize(RoleHeader, GenRowString("Sun-Synchronous", "Yes")))
+			fmt.Println(Colorize(RoleHeader, GenRowString("Local Time of Ascending Node", formatLocalTime(MeanLocalTimeAscendingNode(tle, time.Now().UTC())))))
+		}
 	}
-	exportAnswer, _ := exportPrompt.Run()
-	if strings.ToLower(strings.TrimSpace(exportAnswer)) == "y" {
+
+	fmt.Println(Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝ \n\n"))
+
+	if line1 != "" && line2 != "" {
+		offerClipboardCopy(tle.CommonName, line1, line2)
+	}
+
+	// Offer export option
+	if confirm("Export TLE data?", false) {
 		defaultFilename := fmt.Sprintf("tle_%s_%d", strings.ReplaceAll(tle.CommonName, " ", "_"), tle.SatelliteCatalogNumber)
-		format, filePath, err := showExportMenu(defaultFilename)
+		formats := []ExportFormat{FormatCSV, FormatJSON, FormatText}
+		if line1 != "" && line2 != "" {
+			formats = append(formats, FormatTLE)
+		}
+		format, filePath, err := showExportMenuWithFormats(defaultFilename, formats...)
 		if err == nil {
-			if err := ExportTLE(tle, format, filePath); err != nil {
-				fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to export: "+err.Error()))
+			if format == FormatTLE {
+				err = ExportRawTLE(tle.CommonName, line1, line2, filePath)
 			} else {
-				fmt.Println(color.Ize(color.Green, fmt.Sprintf("  [+] Exported to: %s", filePath)))
+				err = ExportTLE(tle, format, filePath)
+			}
+			if err != nil {
+				fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to export: "+err.Error()))
+			} else {
+				fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Exported to: %s", filePath)))
 			}
 		}
 	}
 }
+
+// tleLineLength is the fixed width of a standard TLE line.
+const tleLineLength = 69
+
+// ValidateTLEChecksum verifies the modulo-10 checksum in column 69 of a TLE line.
+// Digits count as their value, minus signs count as 1, everything else counts as 0.
+// It returns an error if the line is too short or the checksum column is not a digit.
+func ValidateTLEChecksum(line string) (bool, error) {
+	line = strings.TrimRight(line, " \r\n")
+	if len(line) < tleLineLength {
+		return false, NewAppErrorWithContext(
+			ErrCodeTLEInvalidFormat,
+			"TLE line is too short to contain a checksum",
+			fmt.Sprintf("Line length: %d (expected %d)", len(line), tleLineLength),
+		)
+	}
+
+	expected := line[tleLineLength-1]
+	if expected < '0' || expected > '9' {
+		return false, NewAppErrorWithContext(
+			ErrCodeTLEInvalidFormat,
+			"TLE checksum column is not a digit",
+			fmt.Sprintf("Column 69: %q", expected),
+		)
+	}
+
+	return tleChecksum(line[:tleLineLength-1]) == int(expected-'0'), nil
+}
+
+// tleChecksum computes the modulo-10 checksum of the first 68 columns of a TLE line.
+func tleChecksum(body string) int {
+	sum := 0
+	for _, c := range body {
+		if c >= '0' && c <= '9' {
+			sum += int(c - '0')
+		} else if c == '-' {
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+// tleChecksumWarning checks the checksums of both element lines and returns an AppError with
+// ErrCodeTLEChecksumFailed for the first one that does not match, or nil. Lines too short to
+// hold a checksum are left to the line length tolerance and not reported here.
+func tleChecksumWarning(line1, line2 string) *AppError {
+	for i, line := range []string{line1, line2} {
+		if ok, err := ValidateTLEChecksum(line); err == nil && !ok {
+			line = strings.TrimRight(line, " \r\n")
+			return NewAppErrorWithContext(
+				ErrCodeTLEChecksumFailed,
+				fmt.Sprintf("Checksum mismatch on TLE line %d, the element set may be corrupted", i+1),
+				fmt.Sprintf("Column 69: %c, computed: %d", line[tleLineLength-1], tleChecksum(line[:tleLineLength-1])),
+			)
+		}
+	}
+	return nil
+}
+
+// warnTLEChecksum prints a warning when either element line fails its checksum. The element set
+// is still shown, since a single wrong digit rarely matters for display.
+func warnTLEChecksum(line1, line2 string) {
+	if appErr := tleChecksumWarning(line1, line2); appErr != nil {
+		fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] WARNING [%s]: %s (%s)", appErr.Code, appErr.Message, appErr.Context)))
+	}
+}
+
+// RecomputeTLEChecksum returns line with column 69 replaced by the correct checksum.
+// Anything after column 69 is dropped, and short lines are padded with spaces so the
+// checksum lands in the right column.
+func RecomputeTLEChecksum(line string) string {
+	body := strings.TrimRight(line, " \r\n")
+	if len(body) >= tleLineLength {
+		body = body[:tleLineLength-1]
+	} else {
+		body = fmt.Sprintf("%-*s", tleLineLength-1, body)
+	}
+	return body + strconv.Itoa(tleChecksum(body))
+}
+
+// ParseTLEStrict parses a TLE using the fixed column layout of the specification.
+// Unlike ConstructTLE it rejects malformed lines, unparseable fields, mismatched
+// catalog numbers and bad checksums instead of returning partially filled data.
+func ParseTLEStrict(name, line1, line2 string) (TLE, error) {
+	line1 = strings.TrimRight(line1, " \r\n")
+	line2 = strings.TrimRight(line2, " \r\n")
+
+	for i, line := range []string{line1, line2} {
+		if len(line) != tleLineLength {
+			return TLE{}, NewAppErrorWithContext(
+				ErrCodeTLEInvalidFormat,
+				fmt.Sprintf("TLE line %d must be exactly %d characters", i+1, tleLineLength),
+				fmt.Sprintf("Line %d length: %d", i+1, len(line)),
+			)
+		}
+	}
+	if !strings.HasPrefix(line1, "1 ") {
+		return TLE{}, NewAppError(ErrCodeTLEInvalidFormat, "TLE line 1 must start with '1 '")
+	}
+	if !strings.HasPrefix(line2, "2 ") {
+		return TLE{}, NewAppError(ErrCodeTLEInvalidFormat, "TLE line 2 must start with '2 '")
+	}
+
+	for i, line := range []string{line1, line2} {
+		ok, err := ValidateTLEChecksum(line)
+		if err != nil {
+			return TLE{}, err
+		}
+		if !ok {
+			return TLE{}, NewAppErrorWithContext(
+				ErrCodeTLEChecksumFailed,
+				fmt.Sprintf("Checksum mismatch on TLE line %d", i+1),
+				fmt.Sprintf("Line %d: %s", i+1, line),
+			)
+		}
+	}
+
+	// field extracts columns [start, end] (1-based, inclusive) as in the TLE specification.
+	field := func(line string, start, end int) string {
+		return strings.TrimSpace(line[start-1 : end])
+	}
+
+	var parseErr error
+	parseInt := func(value, label string) int {
+		v, err := strconv.Atoi(value)
+		if err != nil && parseErr == nil {
+			parseErr = fmt.Errorf("invalid %s: %q", label, value)
+		}
+		return v
+	}
+	parseFloat := func(value, label string) float64 {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil && parseErr == nil {
+			parseErr = fmt.Errorf("invalid %s: %q", label, value)
+		}
+		return v
+	}
+
+	tle := TLE{CommonName: strings.TrimSpace(name)}
+	tle.SatelliteCatalogNumber = parseInt(field(line1, 3, 7), "satellite catalog number")
+	tle.ElsetClassificiation = field(line1, 8, 8)
+	tle.InternationalDesignator = field(line1, 10, 17)
+	tle.ElementSetEpoch = parseFloat(field(line1, 19, 32), "element set epoch")
+	tle.FirstDerivativeMeanMotion = parseFloat(field(line1, 34, 43), "first derivative of mean motion")
+	tle.SecondDerivativeMeanMotion = field(line1, 45, 52)
+	tle.BDragTerm = field(line1, 54, 61)
+	tle.ElementSetType = parseInt(field(line1, 63, 63), "element set type")
+	tle.ElementNumber = parseInt(field(line1, 65, 68), "element number")
+	tle.ChecksumOne = parseInt(field(line1, 69, 69), "line 1 checksum")
+
+	catalogTwo := parseInt(field(line2, 3, 7), "line 2 satellite catalog number")
+	tle.OrbitInclination = parseFloat(field(line2, 9, 16), "inclination")
+	tle.RightAscension = parseFloat(field(line2, 18, 25), "right ascension")
+	tle.Eccentrcity = parseFloat("0."+field(line2, 27, 33), "eccentricity")
+	tle.Perigee = parseFloat(field(line2, 35, 42), "argument of perigee")
+	tle.MeanAnamoly = parseFloat(field(line2, 44, 51), "mean anomaly")
+	tle.MeanMotion = parseFloat(field(line2, 53, 63), "mean motion")
+	tle.RevolutionNumber = parseInt(field(line2, 64, 68), "revolution number")
+	tle.ChecksumTwo = parseInt(field(line2, 69, 69), "line 2 checksum")
+
+	if parseErr != nil {
+		return TLE{}, NewAppErrorWithErr(ErrCodeTLEParseFailed, "Failed to parse TLE field", parseErr)
+	}
+
+	if tle.SatelliteCatalogNumber != catalogTwo {
+		return TLE{}, NewAppErrorWithContext(
+			ErrCodeTLEParseFailed,
+			"TLE lines refer to different satellites",
+			fmt.Sprintf("Line 1 catalog: %d, Line 2 catalog: %d", tle.SatelliteCatalogNumber, catalogTwo),
+		)
+	}
+
+	return tle, nil
+}
@@ -0,0 +1,106 @@
+package osint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecomputeTLEChecksum(t *testing.T) {
+	// Swap the valid checksum digits for wrong ones
+	bad1 := testTLELine1[:68] + "0"
+	bad2 := testTLELine2[:68] + "0"
+
+	for _, line := range []string{bad1, bad2} {
+		if ok, _ := ValidateTLEChecksum(line); ok {
+			t.Fatalf("Test line unexpectedly validates: %s", line)
+		}
+		fixed := RecomputeTLEChecksum(line)
+		ok, err := ValidateTLEChecksum(fixed)
+		if err != nil || !ok {
+			t.Errorf("RecomputeTLEChecksum(%q) = %q does not validate (err %v)", line, fixed, err)
+		}
+		if fixed[:68] != line[:68] {
+			t.Errorf("RecomputeTLEChecksum changed the element data: %q", fixed)
+		}
+	}
+
+	if got := RecomputeTLEChecksum(testTLELine1); got != testTLELine1 {
+		t.Errorf("A valid line should be unchanged, got %q", got)
+	}
+	if got := RecomputeTLEChecksum(testTLELine1[:68]); got != testTLELine1 {
+		t.Errorf("A line missing its checksum should gain it, got %q", got)
+	}
+	if got := RecomputeTLEChecksum(testTLELine1 + "  \r\n"); got != testTLELine1 {
+		t.Errorf("Trailing whitespace should be dropped, got %q", got)
+	}
+	if got := RecomputeTLEChecksum("1 25544U"); len(got) != tleLineLength {
+		t.Errorf("Short lines should be padded to %d columns, got %d", tleLineLength, len(got))
+	}
+}
+
+func TestTLE_EpochTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		epoch float64
+		want  time.Time
+	}{
+		{"Last year of the 2000s window", 56001.0, time.Date(2056, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"First year of the 1900s window", 57001.0, time.Date(1957, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"End of the 1900s window", 99365.5, time.Date(1999, 12, 31, 12, 0, 0, 0, time.UTC)},
+		{"Leap day with a fraction", 24060.25, time.Date(2024, 2, 29, 6, 0, 0, 0, time.UTC)},
+		{"Last day of a leap year", 24366.75, time.Date(2024, 12, 31, 18, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TLE{ElementSetEpoch: tt.epoch}.EpochTime()
+			if diff := got.Sub(tt.want); diff > time.Millisecond || diff < -time.Millisecond {
+				t.Errorf("EpochTime() for %v = %v, want %v", tt.epoch, got, tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("EpochTime() should be in UTC, got %v", got.Location())
+			}
+		})
+	}
+}
+
+func TestParseInternationalDesignator(t *testing.T) {
+	tests := []struct {
+		id        string
+		year      int
+		launchNum int
+		piece     string
+	}{
+		{"98067A", 1998, 67, "A"},
+		{"1998-067A", 1998, 67, "A"},
+		{"57001B", 1957, 1, "B"},
+		{"56001A", 2056, 1, "A"},
+		{"23001ABC", 2023, 1, "ABC"},
+		{" 24123bc  ", 2024, 123, "BC"},
+	}
+	for _, tt := range tests {
+		year, launchNum, piece, err := ParseInternationalDesignator(tt.id)
+		if err != nil {
+			t.Errorf("ParseInternationalDesignator(%q) failed: %v", tt.id, err)
+			continue
+		}
+		if year != tt.year || launchNum != tt.launchNum || piece != tt.piece {
+			t.Errorf("ParseInternationalDesignator(%q) = %d, %d, %q, want %d, %d, %q",
+				tt.id, year, launchNum, piece, tt.year, tt.launchNum, tt.piece)
+		}
+	}
+
+	for _, id := range []string{"", "98", "98067", "98O67A", "98067A1", "98067ABCD", "98-067A", "19X8-067A", "98000A"} {
+		if _, _, _, err := ParseInternationalDesignator(id); err == nil {
+			t.Errorf("ParseInternationalDesignator(%q) should fail", id)
+		}
+	}
+}
+
+func TestFormatLaunchDetails(t *testing.T) {
+	if got, ok := formatLaunchDetails("98067A"); !ok || got != "Launch Year: 1998, Launch #67, Piece A" {
+		t.Errorf("formatLaunchDetails(98067A) = %q, %v", got, ok)
+	}
+	if _, ok := formatLaunchDetails("UNKNOWN"); ok {
+		t.Error("formatLaunchDetails() should not describe an invalid designator")
+	}
+}
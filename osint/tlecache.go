@@ -0,0 +1,98 @@
+package osint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	tleCacheFile   = "tle_cache.json"
+	tleCacheMaxAge = 24 * time.Hour
+)
+
+// tleCacheMu serializes updates to the cache file, which concurrent batch downloads share.
+var tleCacheMu sync.Mutex
+
+// CachedTLE is the last element set fetched for a satellite, kept for offline use and outages.
+type CachedTLE struct {
+	Line1     string    `json:"line1"`
+	Line2     string    `json:"line2"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// StaleNotice labels output built from this cached copy instead of live data.
+func (c CachedTLE) StaleNotice() string {
+	return fmt.Sprintf("Network unavailable, using cached TLE from %s (may be stale)",
+		c.FetchedAt.UTC().Format("2006-01-02 15:04 UTC"))
+}
+
+// lookupCachedTLE returns the cached element set for a satellite.
+func lookupCachedTLE(norad string) (CachedTLE, bool) {
+	cache := map[string]CachedTLE{}
+	if _, err := loadJSONCache(tleCacheFile, &cache); err != nil {
+		return CachedTLE{}, false
+	}
+	cached, found := cache[norad]
+	return cached, found
+}
+
+// storeCachedTLE records a freshly fetched element set.
+func storeCachedTLE(norad, line1, line2 string) {
+	tleCacheMu.Lock()
+	defer tleCacheMu.Unlock()
+
+	cache := map[string]CachedTLE{}
+	if _, err := loadJSONCache(tleCacheFile, &cache); err != nil {
+		cache = map[string]CachedTLE{}
+	}
+	cache[norad] = CachedTLE{Line1: line1, Line2: line2, FetchedAt: time.Now().UTC()}
+	if err := saveJSONCache(tleCacheFile, cache); err != nil {
+		fmt.Println(Colorize(RoleWarning, "  [!] Could not cache TLE: "+err.Error()))
+	}
+}
+
+// isNetworkFailure reports whether err means a service could not be reached, as opposed to
+// answering with an error.
+func isNetworkFailure(err error) bool {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Code {
+		case ErrCodeAuthConnection, ErrCodeNetworkTimeout, ErrCodeNetworkUnreachable, ErrCodeNetworkDNS:
+			return true
+		}
+		err = appErr.OriginalErr
+	}
+	// syscall errors satisfy net.Error too, so rule out file system failures first.
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return false
+	}
+	var netErr net.Error
+	return err != nil && errors.As(err, &netErr)
+}
+
+// cachingTLESource wraps source so successful fetches are cached and, when the network is down,
+// the cached copy is returned with a staleness warning.
+func cachingTLESource(source TLESource) TLESource {
+	return func(ctx context.Context, norad string) (string, string, error) {
+		line1, line2, err := source(ctx, norad)
+		if err == nil {
+			storeCachedTLE(norad, line1, line2)
+			return line1, line2, nil
+		}
+		if !isNetworkFailure(err) {
+			return "", "", err
+		}
+		cached, found := lookupCachedTLE(norad)
+		if !found {
+			return "", "", err
+		}
+		fmt.Println(Colorize(RoleWarning, "  [!] "+cached.StaleNotice()))
+		return cached.Line1, cached.Line2, nil
+	}
+}
@@ -0,0 +1,86 @@
+package osint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCachingTLESource_NetworkFailure(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	online := true
+	source := cachingTLESource(func(_ context.Context, norad string) (string, string, error) {
+		if !online {
+			return "", "", NewAppErrorWithErr(ErrCodeAuthConnection, "Unable to connect to Space-Track API",
+				&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+		}
+		return testTLELine1, testTLELine2, nil
+	})
+
+	// Warm the cache while online
+	if _, _, err := source(context.Background(), "25544"); err != nil {
+		t.Fatalf("Online fetch failed: %v", err)
+	}
+	cached, found := lookupCachedTLE("25544")
+	if !found || cached.Line1 != testTLELine1 || time.Since(cached.FetchedAt) > time.Minute {
+		t.Fatalf("Fetched TLE was not cached: %+v", cached)
+	}
+
+	online = false
+	line1, line2, err := source(context.Background(), "25544")
+	if err != nil {
+		t.Fatalf("Expected cached fallback, got error: %v", err)
+	}
+	if line1 != testTLELine1 || line2 != testTLELine2 {
+		t.Errorf("Fallback returned %q, %q; want cached TLE", line1, line2)
+	}
+
+	notice := cached.StaleNotice()
+	if !strings.Contains(notice, "stale") || !strings.Contains(notice, cached.FetchedAt.UTC().Format("2006-01-02 15:04")) {
+		t.Errorf("StaleNotice() = %q, want staleness warning with cache timestamp", notice)
+	}
+
+	// Without a cached copy the network error is returned
+	if _, _, err := source(context.Background(), "5"); !isNetworkFailure(err) {
+		t.Errorf("Expected network error for uncached satellite, got %v", err)
+	}
+}
+
+func TestCachingTLESource_ServiceErrorNotMasked(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	storeCachedTLE("25544", testTLELine1, testTLELine2)
+
+	source := cachingTLESource(func(_ context.Context, norad string) (string, string, error) {
+		return "", "", NewAppErrorWithContext(ErrCodeTLEInsufficientData, "No TLE returned", "NORAD ID: "+norad)
+	})
+	if _, _, err := source(context.Background(), "25544"); err == nil {
+		t.Error("A service error should not fall back to the cache")
+	}
+}
+
+func TestIsNetworkFailure(t *testing.T) {
+	netErr := &net.DNSError{Err: "no such host", Name: "www.space-track.org"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"raw net error", netErr, true},
+		{"wrapped net error", fmt.Errorf("failed to fetch data from Space-Track: %w", netErr), true},
+		{"app error around net error", NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed", netErr), true},
+		{"timeout code", NewAppError(ErrCodeNetworkTimeout, "timed out"), true},
+		{"auth failure", NewAppError(ErrCodeAuthFailed, "bad password"), false},
+		{"status error", fmt.Errorf("query returned non-success status code: 500"), false},
+	}
+	for _, tt := range tests {
+		if got := isNetworkFailure(tt.err); got != tt.want {
+			t.Errorf("isNetworkFailure(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package osint
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// TLEFieldChange is one element that differs between two element sets of a satellite.
+type TLEFieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// tleDiffFields lists the elements compared by DiffTLE, each formatted to the precision the TLE
+// format carries.
+var tleDiffFields = []struct {
+	name   string
+	format func(TLE) string
+}{
+	{"Epoch (UTC)", func(t TLE) string { return t.EpochTime().Format("2006-01-02 15:04:05") }},
+	{"Element Number", func(t TLE) string { return strconv.Itoa(t.ElementNumber) }},
+	{"Inclination", func(t TLE) string { return formatElement(t.OrbitInclination, 4) }},
+	{"Right Ascension", func(t TLE) string { return formatElement(t.RightAscension, 4) }},
+	{"Eccentricity", func(t TLE) string { return formatElement(t.Eccentrcity, 7) }},
+	{"Arg. of Perigee", func(t TLE) string { return formatElement(t.Perigee, 4) }},
+	{"Mean Anomaly", func(t TLE) string { return formatElement(t.MeanAnamoly, 4) }},
+	{"Mean Motion", func(t TLE) string { return formatElement(t.MeanMotion, 8) }},
+	{"1st Deriv. Motion", func(t TLE) string { return formatElement(t.FirstDerivativeMeanMotion, 8) }},
+	{"B* Drag Term", func(t TLE) string { return t.BDragTerm }},
+	{"Revolution Number", func(t TLE) string { return strconv.Itoa(t.RevolutionNumber) }},
+}
+
+// DiffTLE lists the elements that changed from older to newer.
+func DiffTLE(older, newer TLE) []TLEFieldChange {
+	var changes []TLEFieldChange
+	for _, field := range tleDiffFields {
+		if before, after := field.format(older), field.format(newer); before != after {
+			changes = append(changes, TLEFieldChange{Field: field.name, Old: before, New: after})
+		}
+	}
+	return changes
+}
+
+// GetLastTwoTLEs returns the previous and the latest element sets Space-Track holds for a
+// satellite, oldest first.
+func GetLastTwoTLEs(client *http.Client, norad string) (TLE, TLE, error) {
+	history, err := FetchRecentTLEs(client, norad, 2)
+	if err != nil {
+		return TLE{}, TLE{}, err
+	}
+	if len(history) < 2 {
+		return TLE{}, TLE{}, NewAppErrorWithContext(ErrCodeTLEInsufficientData, "Only one element set is on record", "NORAD ID: "+norad)
+	}
+	// The history is newest first
+	return history[1], history[0], nil
+}
+
+// tleSideBySideRow formats one row of the side-by-side table.
+func tleSideBySideRow(field, previous, latest string) string {
+	return fmt.Sprintf("║ %-18s│ %-19s│ %-19s║", field, previous, latest)
+}
+
+// tleSideBySideLines renders two element sets next to each other, highlighting changed elements.
+func tleSideBySideLines(previous, latest TLE) []string {
+	changed := make(map[string]bool)
+	for _, change := range DiffTLE(previous, latest) {
+		changed[change.Field] = true
+	}
+
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, tleSideBySideRow("Element", "Previous", "Latest")),
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+	}
+	for _, field := range tleDiffFields {
+		row := tleSideBySideRow(field.name, field.format(previous), field.format(latest))
+		role := RoleHeader
+		if changed[field.name] {
+			role = RoleWarning
+		}
+		lines = append(lines, Colorize(role, row))
+	}
+	lines = append(lines,
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+		Colorize(RoleHeader, GenRowString("Time Between Epochs", latest.EpochTime().Sub(previous.EpochTime()).Round(time.Second).String())),
+		Colorize(RoleHeader, GenRowString("Changed Elements", strconv.Itoa(len(changed)))),
+		Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"),
+	)
+	return lines
+}
+
+// compareLastTwoTLEs fetches a satellite's last two element sets and shows them side by side.
+func compareLastTwoTLEs(client *http.Client, norad string) {
+	previous, latest, err := GetLastTwoTLEs(client, norad)
+	if err != nil {
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch the last two element sets")
+		return
+	}
+	printPaged(tleSideBySideLines(previous, latest))
+}
@@ -0,0 +1,47 @@
+package osint
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetLastTwoTLEs(t *testing.T) {
+	// The newer element set, one day and one element number later
+	newerLine1 := RecomputeTLEChecksum(strings.Replace(strings.Replace(testTLELine1, "04236.56031392", "04237.56031392", 1), " 0  999", " 0  100", 1))
+	newerLine2 := RecomputeTLEChecksum(strings.Replace(testTLELine2, "344.7760", "339.8612", 1))
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(newerLine1 + "\n" + newerLine2 + "\n" + testTLELine1 + "\n" + testTLELine2 + "\n"))
+	})
+
+	previous, latest, err := GetLastTwoTLEs(client, "25544")
+	if err != nil {
+		t.Fatalf("GetLastTwoTLEs() failed: %v", err)
+	}
+	if !previous.EpochTime().Before(latest.EpochTime()) {
+		t.Errorf("Expected the previous epoch %v before the latest %v", previous.EpochTime(), latest.EpochTime())
+	}
+	if previous.ElementNumber != 999 || latest.ElementNumber != 100 {
+		t.Errorf("Element numbers = %d, %d, want 999, 100", previous.ElementNumber, latest.ElementNumber)
+	}
+
+	changed := make(map[string]bool)
+	for _, change := range DiffTLE(previous, latest) {
+		changed[change.Field] = true
+	}
+	for _, field := range []string{"Epoch (UTC)", "Element Number", "Right Ascension"} {
+		if !changed[field] {
+			t.Errorf("Expected %s in the differences", field)
+		}
+	}
+	if changed["Inclination"] || changed["Mean Motion"] {
+		t.Errorf("Unchanged elements reported as differences: %v", changed)
+	}
+
+	single := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testTLELine1 + "\n" + testTLELine2 + "\n"))
+	})
+	if _, _, err := GetLastTwoTLEs(single, "25544"); err == nil {
+		t.Error("Expected error when only one element set is returned")
+	}
+}
@@ -0,0 +1,300 @@
+package osint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoryDownloadOptions tunes GetHistoricalTLEs: how many days of element sets each query
+// covers, how many times a failed query is retried and how long to wait before each retry.
+type HistoryDownloadOptions struct {
+	ChunkDays  int
+	Retries    int
+	RetryDelay time.Duration
+}
+
+// HistoryDownload holds the options GetHistoricalTLEs uses.
+var HistoryDownload = HistoryDownloadOptions{
+	ChunkDays:  30,
+	Retries:    3,
+	RetryDelay: 2 * time.Second,
+}
+
+// historyChunk is the part of a history download covering epochs in [start, end).
+type historyChunk struct {
+	start, end time.Time
+}
+
+// splitHistoryRange divides [start, end) into consecutive chunks of at most days days.
+func splitHistoryRange(start, end time.Time, days int) []historyChunk {
+	var chunks []historyChunk
+	for from := start; from.Before(end); from = from.AddDate(0, 0, days) {
+		chunks = append(chunks, historyChunk{start: from, end: minTime(from.AddDate(0, 0, days), end)})
+	}
+	return chunks
+}
+
+// minTime returns the earlier of two times.
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// parseTLEText reads the element sets in a TLE-format response, naming each after norad.
+func parseTLEText(norad, data string) []TLE {
+	var tles []TLE
+	var line1 string
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "1 "):
+			line1 = line
+		case strings.HasPrefix(line, "2 ") && line1 != "":
+			tles = append(tles, ConstructTLE(norad, line1, line))
+			line1 = ""
+		}
+	}
+	return tles
+}
+
+// historyRangeEndpoint is the gp_history query for a satellite's element sets with epochs between
+// the start and end dates, oldest first.
+func historyRangeEndpoint(norad string, start, end time.Time) string {
+	return fmt.Sprintf("/class/gp_history/NORAD_CAT_ID/%s/EPOCH/%s--%s/orderby/EPOCH%%20asc/format/tle",
+		norad, start.UTC().Format("2006-01-02"), end.UTC().Format("2006-01-02"))
+}
+
+// FetchTLEHistory returns the element sets Space-Track holds for a satellite with epochs between
+// start and end, oldest first, in a single query. Use GetHistoricalTLEs for long ranges.
+func FetchTLEHistory(client *http.Client, norad string, start, end time.Time) ([]TLE, error) {
+	rangeContext := fmt.Sprintf("NORAD ID: %s, Start: %s, End: %s", norad, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	if !start.Before(end) {
+		return nil, NewAppErrorWithContext(ErrCodeInputInvalid, "The history start date must be before the end date", rangeContext)
+	}
+	data, err := QuerySpaceTrack(client, historyRangeEndpoint(norad, start, end))
+	if err != nil {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed, "Failed to fetch TLE history", err)
+		appErr.Context = rangeContext
+		return nil, appErr
+	}
+
+	tles := parseTLEText(norad, data)
+	if len(tles) == 0 {
+		return nil, NewAppErrorWithContext(ErrCodeAPINoData, "No element sets on record for this date range", rangeContext)
+	}
+	sort.SliceStable(tles, func(i, j int) bool { return tles[i].EpochTime().Before(tles[j].EpochTime()) })
+	return tles, nil
+}
+
+// fetchHistoryChunk queries the element sets with epochs in one chunk, retrying failed queries.
+// A chunk without element sets is not an error.
+func fetchHistoryChunk(client *http.Client, norad string, chunk historyChunk, opts HistoryDownloadOptions) ([]TLE, error) {
+	var err error
+	for attempt := 0; attempt <= opts.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(opts.RetryDelay)
+		}
+		var tles []TLE
+		tles, err = FetchTLEHistory(client, norad, chunk.start, chunk.end.AddDate(0, 0, 1))
+		var appErr *AppError
+		if errors.As(err, &appErr) && appErr.Code == ErrCodeAPINoData {
+			return nil, nil
+		}
+		if err == nil {
+			return tles, nil
+		}
+	}
+	return nil, err
+}
+
+// GetHistoricalTLEs returns the element sets Space-Track holds for a satellite with epochs from
+// start up to end, oldest first. The range is fetched in chunks of HistoryDownload.ChunkDays
+// with a progress bar, and a chunk that fails is retried before giving up on it. Element sets
+// from the chunks that succeeded are returned even when others failed, along with an error
+// naming the missing date ranges, so they can be requested again.
+func GetHistoricalTLEs(client *http.Client, norad string, start, end time.Time) ([]TLE, error) {
+	if !start.Before(end) {
+		return nil, NewAppErrorWithContext(ErrCodeInputInvalid, "The history start date must be before the end date",
+			fmt.Sprintf("Start: %s, End: %s", start.Format("2006-01-02"), end.Format("2006-01-02")))
+	}
+	opts := HistoryDownload
+	chunks := splitHistoryRange(start, end, max(1, opts.ChunkDays))
+
+	var progress *ProgressBar
+	if !NonInteractive && stdoutIsTerminal() {
+		progress = ShowProgressWithBar(len(chunks), "Downloading element set history")
+	}
+
+	var tles []TLE
+	var failures []error
+	for _, chunk := range chunks {
+		chunkTLEs, err := fetchHistoryChunk(client, norad, chunk, opts)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s to %s: %w", chunk.start.Format("2006-01-02"), chunk.end.Format("2006-01-02"), err))
+		}
+		tles = append(tles, chunkTLEs...)
+		if progress != nil {
+			progress.Increment()
+		}
+	}
+	if progress != nil {
+		progress.Complete()
+	}
+
+	// Queries cover whole days and overlap at the chunk boundaries, so keep each element set in
+	// the range once
+	sort.SliceStable(tles, func(i, j int) bool { return tles[i].EpochTime().Before(tles[j].EpochTime()) })
+	series := make([]TLE, 0, len(tles))
+	for _, tle := range tles {
+		epoch := tle.EpochTime()
+		if epoch.Before(start) || !epoch.Before(end) {
+			continue
+		}
+		if n := len(series); n > 0 && series[n-1].RawLine1 == tle.RawLine1 && series[n-1].RawLine2 == tle.RawLine2 {
+			continue
+		}
+		series = append(series, tle)
+	}
+
+	if len(failures) > 0 {
+		appErr := NewAppErrorWithErr(ErrCodeAPIRequestFailed,
+			fmt.Sprintf("%d of %d parts of the history could not be downloaded", len(failures), len(chunks)), errors.Join(failures...))
+		appErr.Context = "NORAD ID: " + norad
+		return series, appErr
+	}
+	return series, nil
+}
+
+// downloadTLEHistory asks for a date range, downloads the satellite's element sets in it and
+// offers to save them as a TLE file.
+func downloadTLEHistory(client *http.Client, norad, name string) {
+	start, end, ok := promptHistoryRange()
+	if !ok {
+		return
+	}
+
+	// Include the whole end day
+	tles, err := GetHistoricalTLEs(client, norad, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to download the element set history")
+		if len(tles) == 0 {
+			return
+		}
+	}
+	fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Downloaded %d element sets between %s and %s",
+		len(tles), start.Format("2006-01-02"), end.Format("2006-01-02"))))
+	if len(tles) == 0 || !confirm("Save them to a TLE file?", true) {
+		return
+	}
+
+	filePath := compressedPath(withExportExt(scanWithDefault("ENTER FILE PATH (default: "+norad+"_history.tle)", norad+"_history.tle"), ".tle"))
+	var builder strings.Builder
+	for _, tle := range tles {
+		builder.WriteString(strings.TrimSpace(name) + "\n" + tle.RawLine1 + "\n" + tle.RawLine2 + "\n")
+	}
+	if err := writeExportFile(filePath, []byte(builder.String())); err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to write TLE file: "+err.Error()))
+		return
+	}
+	fmt.Println(Colorize(RoleSuccess, "  [+] Element set history saved to: "+filePath))
+}
+
+// promptHistoryRange asks for the first and last day of a history query. The end date defaults to
+// today.
+func promptHistoryRange() (time.Time, time.Time, bool) {
+	today := time.Now().UTC().Format("2006-01-02")
+	startInput := scanWithDefault("ENTER START DATE (YYYY-MM-DD)", "")
+	endInput := scanWithDefault("ENTER END DATE (YYYY-MM-DD, default: "+today+")", today)
+	start, err := time.Parse("2006-01-02", startInput)
+	end, err2 := time.Parse("2006-01-02", endInput)
+	if err != nil || err2 != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Dates must be in YYYY-MM-DD format"))
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+// elementTrendRow formats one row of the element trend table.
+func elementTrendRow(epoch, meanMotion, meanMotionChange, eccentricity, eccentricityChange string) string {
+	return fmt.Sprintf("║ %-10s│ %-11s│ %-11s│ %-9s│ %-11s║", epoch, meanMotion, meanMotionChange, eccentricity, eccentricityChange)
+}
+
+// elementTrendLines renders how mean motion and eccentricity changed from one element set to the
+// next. Element sets that follow a jump DetectManeuvers flags are highlighted.
+func elementTrendLines(name string, tles []TLE) []string {
+	maneuvers := DetectManeuvers(tles, defaultManeuverThresholdKm)
+	flagged := make(map[time.Time]bool)
+	for _, event := range maneuvers {
+		flagged[event.After] = true
+	}
+
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("Satellite", name)),
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+		Colorize(RoleHeader, elementTrendRow("Epoch", "Mean Motion", "Change", "Eccentr.", "Change")),
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+	}
+	for i, tle := range tles {
+		meanMotionChange, eccentricityChange := "", ""
+		if i > 0 {
+			meanMotionChange = fmt.Sprintf("%+.8f", tle.MeanMotion-tles[i-1].MeanMotion)
+			eccentricityChange = fmt.Sprintf("%+.7f", tle.Eccentrcity-tles[i-1].Eccentrcity)
+		}
+		row := elementTrendRow(tle.EpochTime().Format("2006-01-02"), fmt.Sprintf("%.8f", tle.MeanMotion),
+			meanMotionChange, fmt.Sprintf("%.7f", tle.Eccentrcity), eccentricityChange)
+		role := RoleHeader
+		if flagged[tle.EpochTime()] {
+			role = RoleWarning
+		}
+		lines = append(lines, Colorize(role, row))
+	}
+
+	first, last := tles[0], tles[len(tles)-1]
+	lines = append(lines,
+		Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+		Colorize(RoleHeader, GenRowString("Element Sets", strconv.Itoa(len(tles)))),
+		Colorize(RoleHeader, GenRowString("Mean Motion", fmt.Sprintf("%.8f -> %.8f (%+.8f)", first.MeanMotion, last.MeanMotion, last.MeanMotion-first.MeanMotion))),
+		Colorize(RoleHeader, GenRowString("Eccentricity", fmt.Sprintf("%.7f -> %.7f (%+.7f)", first.Eccentrcity, last.Eccentrcity, last.Eccentrcity-first.Eccentrcity))),
+		Colorize(RoleHeader, GenRowString("Possible Maneuvers", strconv.Itoa(len(maneuvers)))),
+		Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"),
+	)
+	return lines
+}
+
+// fetchElementTrend downloads the element sets for an element trend with GetHistoricalTLEs,
+// failing with ErrCodeAPINoData when none are on record for the range. Element sets from a
+// partial download are returned along with its error.
+func fetchElementTrend(client *http.Client, norad string, start, end time.Time) ([]TLE, error) {
+	tles, err := GetHistoricalTLEs(client, norad, start, end)
+	if err == nil && len(tles) == 0 {
+		return nil, NewAppErrorWithContext(ErrCodeAPINoData, "No element sets on record for this date range",
+			fmt.Sprintf("NORAD ID: %s, Start: %s, End: %s", norad, start.Format("2006-01-02"), end.Format("2006-01-02")))
+	}
+	return tles, err
+}
+
+// reportElementTrend asks for a date range and shows how the satellite's mean motion and
+// eccentricity evolved over it.
+func reportElementTrend(client *http.Client, norad, name string) {
+	start, end, ok := promptHistoryRange()
+	if !ok {
+		return
+	}
+	// Include the whole end day
+	tles, err := fetchElementTrend(client, norad, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		HandleError(err, ErrCodeAPIRequestFailed, "Failed to fetch TLE history")
+		if len(tles) == 0 {
+			return
+		}
+	}
+	printPaged(elementTrendLines(name, tles))
+}
@@ -0,0 +1,176 @@
+package osint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// historyServer answers gp_history range queries with an ISS element set every three days of
+// August and September 2004, newest first. failures counts down the queries to fail for the
+// range starting on failFrom.
+func historyServer(t *testing.T, failFrom string, failures int) (*http.Client, *int) {
+	t.Helper()
+	var mu sync.Mutex
+	queries := 0
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		queries++
+
+		_, rest, _ := strings.Cut(r.URL.Path, "/EPOCH/")
+		from, to, _ := strings.Cut(strings.Split(rest, "/")[0], "--")
+		if from == failFrom && failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		start, _ := time.Parse("2006-01-02", from)
+		end, _ := time.Parse("2006-01-02", to)
+		for day := 274; day >= 214; day -= 3 {
+			epoch := TLEEpochTime(float64(4000+day) + 0.5)
+			if epoch.Before(start) || epoch.After(end) {
+				continue
+			}
+			line1 := RecomputeTLEChecksum(strings.Replace(testTLELine1, "04236.56031392", fmt.Sprintf("04%03d.50000000", day), 1))
+			fmt.Fprintf(w, "%s\n%s\n", line1, testTLELine2)
+		}
+	})
+	return client, &queries
+}
+
+func withHistoryDownload(t *testing.T, opts HistoryDownloadOptions) {
+	original := HistoryDownload
+	HistoryDownload = opts
+	t.Cleanup(func() { HistoryDownload = original })
+}
+
+func TestGetHistoricalTLEs_RetriesFailedChunk(t *testing.T) {
+	withHistoryDownload(t, HistoryDownloadOptions{ChunkDays: 20, Retries: 2})
+	// The second chunk starts on August 21 and fails once
+	client, queries := historyServer(t, "2004-08-21", 1)
+
+	start := time.Date(2004, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2004, 10, 1, 0, 0, 0, 0, time.UTC)
+	tles, err := GetHistoricalTLEs(client, "25544", start, end)
+	if err != nil {
+		t.Fatalf("GetHistoricalTLEs() failed: %v", err)
+	}
+	if *queries != 5 {
+		t.Errorf("Made %d queries, want 4 chunks and 1 retry", *queries)
+	}
+
+	// Noon on days 214 to 274, every three days
+	if len(tles) != 21 {
+		t.Fatalf("Got %d element sets, want 21", len(tles))
+	}
+	for i, tle := range tles {
+		want := TLEEpochTime(float64(4214+3*i) + 0.5)
+		if !tle.EpochTime().Equal(want) {
+			t.Errorf("Element set %d has epoch %v, want %v", i, tle.EpochTime(), want)
+		}
+	}
+}
+
+func TestGetHistoricalTLEs_KeepsCompletedChunks(t *testing.T) {
+	withHistoryDownload(t, HistoryDownloadOptions{ChunkDays: 20, Retries: 1})
+	client, _ := historyServer(t, "2004-08-21", 2)
+
+	start := time.Date(2004, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2004, 10, 1, 0, 0, 0, 0, time.UTC)
+	tles, err := GetHistoricalTLEs(client, "25544", start, end)
+	if err == nil || !strings.Contains(err.Error(), "1 of 4 parts") {
+		t.Errorf("Expected an error naming the failed part, got %v", err)
+	}
+	// The 7 element sets from August 21 to September 10 are missing
+	if len(tles) != 14 {
+		t.Errorf("Got %d element sets, want the 14 from the other chunks", len(tles))
+	}
+
+	if _, err := GetHistoricalTLEs(client, "25544", end, start); err == nil {
+		t.Error("Expected error for a start date after the end date")
+	}
+}
+
+func TestFetchTLEHistory(t *testing.T) {
+	client, queries := historyServer(t, "", 0)
+
+	start := time.Date(2004, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2004, 8, 11, 0, 0, 0, 0, time.UTC)
+	tles, err := FetchTLEHistory(client, "25544", start, end)
+	if err != nil {
+		t.Fatalf("FetchTLEHistory() failed: %v", err)
+	}
+	if *queries != 1 {
+		t.Errorf("Made %d queries, want 1", *queries)
+	}
+	// Days 214, 217, 220 and 223, oldest first
+	if len(tles) != 4 || tles[0].EpochTime().After(tles[3].EpochTime()) {
+		t.Fatalf("Got %d element sets, want 4 oldest first", len(tles))
+	}
+	if tles[0].SatelliteCatalogNumber != 25544 || tles[0].MeanMotion == 0 {
+		t.Errorf("Element set not parsed: %+v", tles[0])
+	}
+
+	var appErr *AppError
+	_, err = FetchTLEHistory(client, "25544", start.AddDate(1, 0, 0), end.AddDate(1, 0, 0))
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAPINoData {
+		t.Errorf("Expected %s for a range without element sets, got %v", ErrCodeAPINoData, err)
+	}
+	if _, err := FetchTLEHistory(client, "25544", end, start); err == nil {
+		t.Error("Expected error for a start date after the end date")
+	}
+}
+
+func TestFetchElementTrend(t *testing.T) {
+	withHistoryDownload(t, HistoryDownloadOptions{ChunkDays: 5})
+	client, queries := historyServer(t, "", 0)
+
+	start := time.Date(2004, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2004, 8, 11, 0, 0, 0, 0, time.UTC)
+	tles, err := fetchElementTrend(client, "25544", start, end)
+	if err != nil {
+		t.Fatalf("fetchElementTrend() failed: %v", err)
+	}
+	if *queries != 2 {
+		t.Errorf("Made %d queries, want one per 5-day chunk", *queries)
+	}
+	// Days 214, 217, 220 and 223, oldest first
+	if len(tles) != 4 || tles[0].EpochTime().After(tles[3].EpochTime()) {
+		t.Fatalf("Got %d element sets, want 4 oldest first", len(tles))
+	}
+
+	var appErr *AppError
+	_, err = fetchElementTrend(client, "25544", start.AddDate(1, 0, 0), end.AddDate(1, 0, 0))
+	if !errors.As(err, &appErr) || appErr.Code != ErrCodeAPINoData {
+		t.Errorf("Expected %s for a range without element sets, got %v", ErrCodeAPINoData, err)
+	}
+}
+
+func TestElementTrendLines(t *testing.T) {
+	tles := []TLE{
+		{ElementSetEpoch: 24001, MeanMotion: 15.50, Eccentrcity: 0.0005},
+		{ElementSetEpoch: 24002, MeanMotion: 15.50001, Eccentrcity: 0.0006},
+		{ElementSetEpoch: 24003, MeanMotion: 15.40, Eccentrcity: 0.0006},
+	}
+	t.Cleanup(func() { SetTheme("default") })
+	SetTheme("mono")
+	lines := elementTrendLines("ISS (ZARYA)", tles)
+
+	output := strings.Join(lines, "\n")
+	for _, want := range []string{"+0.00001000", "+0.0001000", "-0.10000000", "Possible Maneuvers: 1"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Trend table missing %q", want)
+		}
+	}
+	for _, line := range lines {
+		if width := utf8.RuneCountInString(strings.TrimPrefix(line, "\n")); width != 63 {
+			t.Errorf("Row %q is %d wide, want 63", line, width)
+		}
+	}
+}
@@ -2,12 +2,13 @@ package osint
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/TwiN/go-color"
 	"github.com/iskaa02/qalam/gradient"
 )
 
@@ -16,12 +17,14 @@ func TLEParser() {
 	options, _ := os.ReadFile("txt/tle_parser.txt")
 	opt, _ := gradient.NewGradient("#1179ef", "cyan")
 	opt.Print("\n" + string(options))
-	var selection int = Option(0, 3)
+	var selection int = Option(0, 4)
 
 	if selection == 1 {
 		TLETextFile()
 	} else if selection == 2 {
 		TLEPlainString()
+	} else if selection == 3 {
+		LoadGroundTrackFile()
 	}
 }
 
@@ -164,16 +167,18 @@ func TLETextFile() {
 	}
 
 	if parsingFailed {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to parse TLE data"))
-		fmt.Println(color.Ize(color.Red, fmt.Sprintf("       Line 1 fields: %d (minimum required: 4)", len(line1Fields))))
-		fmt.Println(color.Ize(color.Red, fmt.Sprintf("       Line 2 fields: %d (minimum required: 3)", len(line2Fields))))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to parse TLE data"))
+		fmt.Println(Colorize(RoleError, fmt.Sprintf("       Line 1 fields: %d (minimum required: 4)", len(line1Fields))))
+		fmt.Println(Colorize(RoleError, fmt.Sprintf("       Line 2 fields: %d (minimum required: 3)", len(line2Fields))))
 		if len(line1Fields) >= 4 && len(line2Fields) >= 3 {
-			fmt.Println(color.Ize(color.Red, "       Note: Field count is sufficient, but parsing failed. Check TLE format."))
+			fmt.Println(Colorize(RoleError, "       Note: Field count is sufficient, but parsing failed. Check TLE format."))
 		}
 		return
 	}
 
-	PrintTLE(output)
+	PrintTLEWithRawLines(output, lineOne, lineTwo)
+	offerCatalogVerification(lineOne, lineTwo)
+	offerSGP4Propagation(output, lineOne, lineTwo)
 }
 
 // TLEPlainString prompts the user to enter TLE data line by line and parses it.
@@ -212,14 +217,144 @@ func TLEPlainString() {
 	}
 
 	if parsingFailed {
-		fmt.Println(color.Ize(color.Red, "  [!] ERROR: Failed to parse TLE data"))
-		fmt.Println(color.Ize(color.Red, fmt.Sprintf("       Line 1 fields: %d (minimum required: 4)", len(line1Fields))))
-		fmt.Println(color.Ize(color.Red, fmt.Sprintf("       Line 2 fields: %d (minimum required: 3)", len(line2Fields))))
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: Failed to parse TLE data"))
+		fmt.Println(Colorize(RoleError, fmt.Sprintf("       Line 1 fields: %d (minimum required: 4)", len(line1Fields))))
+		fmt.Println(Colorize(RoleError, fmt.Sprintf("       Line 2 fields: %d (minimum required: 3)", len(line2Fields))))
 		if len(line1Fields) >= 4 && len(line2Fields) >= 3 {
-			fmt.Println(color.Ize(color.Red, "       Note: Field count is sufficient, but parsing failed. Check TLE format."))
+			fmt.Println(Colorize(RoleError, "       Note: Field count is sufficient, but parsing failed. Check TLE format."))
 		}
 		return
 	}
 
-	PrintTLE(output)
+	PrintTLEWithRawLines(output, lineTwo, lineThree)
+	offerCatalogVerification(lineTwo, lineThree)
+	offerSGP4Propagation(output, lineTwo, lineThree)
+}
+
+// TLEValidationResult is the outcome of strictly validating one element set from a file.
+type TLEValidationResult struct {
+	Name   string   // Satellite name, empty for 2-line entries
+	Line   int      // Line number in the file where the entry starts
+	Errors []string // Problems found; empty when the entry is valid
+}
+
+// OK reports whether the entry passed validation.
+func (r TLEValidationResult) OK() bool {
+	return len(r.Errors) == 0
+}
+
+// tleFileLine is a non-blank line read from a TLE file with its 1-based line number.
+type tleFileLine struct {
+	number int
+	text   string
+}
+
+// ValidateTLEFile reads 2- or 3-line element sets from a file and validates each one
+// with ParseTLEStrict, which includes checksum validation.
+func ValidateTLEFile(path string) ([]TLEValidationResult, error) {
+	if err := validateFilePath(path); err != nil {
+		appErr := NewAppErrorWithContext(ErrCodeFilePathInvalid, "Invalid file path", fmt.Sprintf("Path: %s", path))
+		appErr.OriginalErr = err
+		return nil, appErr
+	}
+
+	file, err := os.Open(filepath.Clean(strings.TrimSpace(path)))
+	if err != nil {
+		return nil, NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed to open TLE file", err)
+	}
+	defer file.Close()
+
+	return validateTLEEntries(file)
+}
+
+// validateTLEEntries groups the lines read from r into element sets and validates each.
+func validateTLEEntries(r io.Reader) ([]TLEValidationResult, error) {
+	var lines []tleFileLine
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := strings.TrimRight(scanner.Text(), " \t\r")
+		if strings.TrimSpace(text) != "" {
+			lines = append(lines, tleFileLine{number: number, text: text})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, NewAppErrorWithErr(ErrCodeFileReadFailed, "Failed to read TLE file", err)
+	}
+
+	isLine := func(i int, prefix string) bool {
+		return i < len(lines) && strings.HasPrefix(lines[i].text, prefix)
+	}
+
+	var results []TLEValidationResult
+	for i := 0; i < len(lines); {
+		result := TLEValidationResult{Line: lines[i].number}
+
+		first := i
+		if !isLine(i, "1 ") {
+			if !isLine(i+1, "1 ") {
+				result.Errors = append(result.Errors, fmt.Sprintf("line %d: expected TLE line 1 or a satellite name followed by line 1", lines[i].number))
+				results = append(results, result)
+				i++
+				continue
+			}
+			result.Name = strings.TrimSpace(lines[i].text)
+			first = i + 1
+		}
+
+		if !isLine(first+1, "2 ") {
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: TLE line 1 is not followed by line 2", lines[first].number))
+			results = append(results, result)
+			i = first + 1
+			continue
+		}
+
+		if _, err := ParseTLEStrict(result.Name, lines[first].text, lines[first+1].text); err != nil {
+			var appErr *AppError
+			if errors.As(err, &appErr) {
+				msg := appErr.Message
+				if appErr.Context != "" {
+					msg += " (" + appErr.Context + ")"
+				} else if appErr.OriginalErr != nil {
+					msg += " (" + appErr.OriginalErr.Error() + ")"
+				}
+				result.Errors = append(result.Errors, msg)
+			} else {
+				result.Errors = append(result.Errors, err.Error())
+			}
+		}
+		results = append(results, result)
+		i = first + 2
+	}
+
+	if len(results) == 0 {
+		return nil, NewAppError(ErrCodeTLEInsufficientData, "No TLE entries found")
+	}
+
+	return results, nil
+}
+
+// PrintTLEValidationReport prints one OK/error line per entry and a summary.
+// It returns true if every entry is valid.
+func PrintTLEValidationReport(results []TLEValidationResult) bool {
+	valid := 0
+	for _, result := range results {
+		label := result.Name
+		if label == "" {
+			label = "UNSPECIFIED"
+		}
+		if result.OK() {
+			valid++
+			fmt.Println(Colorize(RoleSuccess, fmt.Sprintf("  [+] Line %d: %s - OK", result.Line, label)))
+			continue
+		}
+		for _, msg := range result.Errors {
+			fmt.Println(Colorize(RoleError, fmt.Sprintf("  [!] Line %d: %s - %s", result.Line, label, msg)))
+		}
+	}
+
+	summary := fmt.Sprintf("  [*] %d of %d element sets valid", valid, len(results))
+	fmt.Println(Colorize(RoleInfo, summary))
+	return valid == len(results)
 }
@@ -223,3 +223,79 @@ func BenchmarkValidateFilePath(b *testing.B) {
 
 
 
+
+func TestValidateTLEFile(t *testing.T) {
+	content := strings.Join([]string{
+		"ISS (ZARYA)",
+		"1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9993",
+		"2 25544  51.6335 344.7760 0007976 126.2523 325.9359 15.70406856328906",
+		"",
+		"BROKEN SAT",
+		"1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9994",
+		"2 25544  51.6335 344.7760 0007976 126.2523 325.9359 15.70406856328906",
+	}, "\n")
+
+	testFile := filepath.Join(t.TempDir(), "mixed.tle")
+	if err := os.WriteFile(testFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	results, err := ValidateTLEFile(testFile)
+	if err != nil {
+		t.Fatalf("ValidateTLEFile failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+
+	if !results[0].OK() {
+		t.Errorf("Expected first entry to be valid, got errors: %v", results[0].Errors)
+	}
+	if results[0].Name != "ISS (ZARYA)" || results[0].Line != 1 {
+		t.Errorf("Unexpected first entry: %+v", results[0])
+	}
+
+	if results[1].OK() {
+		t.Error("Expected second entry to fail checksum validation")
+	}
+	if results[1].Line != 5 {
+		t.Errorf("Expected second entry to start on line 5, got %d", results[1].Line)
+	}
+	if len(results[1].Errors) == 0 || !strings.Contains(results[1].Errors[0], "Checksum mismatch on TLE line 1") {
+		t.Errorf("Expected checksum error, got %v", results[1].Errors)
+	}
+
+	if PrintTLEValidationReport(results) {
+		t.Error("Expected report to indicate failure")
+	}
+}
+
+func TestValidateTLEFile_Malformed(t *testing.T) {
+	content := "JUNK LINE\n1 25544U 98067A   04236.56031392  .00020137  00000-0  16538-3 0  9993\n"
+	testFile := filepath.Join(t.TempDir(), "malformed.tle")
+	if err := os.WriteFile(testFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	results, err := ValidateTLEFile(testFile)
+	if err != nil {
+		t.Fatalf("ValidateTLEFile failed: %v", err)
+	}
+	if len(results) != 1 || results[0].OK() {
+		t.Fatalf("Expected one failing entry, got %+v", results)
+	}
+	if !strings.Contains(results[0].Errors[0], "not followed by line 2") {
+		t.Errorf("Unexpected error: %v", results[0].Errors)
+	}
+}
+
+func TestValidateTLEFile_Empty(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "empty.tle")
+	if err := os.WriteFile(testFile, []byte("\n\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	if _, err := ValidateTLEFile(testFile); err == nil {
+		t.Error("Expected error for file without TLE entries")
+	}
+}
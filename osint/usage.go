@@ -0,0 +1,172 @@
+package osint
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const n2yoUsageFile = "n2yo_usage.json"
+
+// n2yoHourlyLimits are the per-endpoint transaction limits N2YO enforces per rolling hour.
+var n2yoHourlyLimits = map[string]int{
+	"tle":          1000,
+	"positions":    1000,
+	"visualpasses": 100,
+	"radiopasses":  100,
+	"above":        100,
+}
+
+// n2yoWarnThreshold is the fraction of an hourly limit at which a warning is shown.
+const n2yoWarnThreshold = 0.8
+
+// N2YOCallBudget is how many N2YO requests one run may make before an operation needing more
+// asks for confirmation. Zero disables the check. main sets it from the config.
+var N2YOCallBudget = 200
+
+// n2yoRunCalls counts the N2YO requests made by this run, per endpoint.
+var n2yoRunCalls = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: map[string]int{}}
+
+// countN2YOCall records a request to an N2YO endpoint made by this run.
+func countN2YOCall(endpoint string) {
+	n2yoRunCalls.Lock()
+	defer n2yoRunCalls.Unlock()
+	n2yoRunCalls.counts[endpoint]++
+}
+
+// N2YORunCalls returns how many N2YO requests this run has made, per endpoint.
+func N2YORunCalls() map[string]int {
+	n2yoRunCalls.Lock()
+	defer n2yoRunCalls.Unlock()
+	counts := make(map[string]int, len(n2yoRunCalls.counts))
+	for endpoint, count := range n2yoRunCalls.counts {
+		counts[endpoint] = count
+	}
+	return counts
+}
+
+// EstimateCalls returns how many N2YO requests an operation makes for satCount satellites over
+// days days. Every endpoint takes one request per satellite, pass predictions included since one
+// request covers the whole window, except "above", which answers for all satellites at once.
+func EstimateCalls(operation string, satCount, days int) int {
+	if satCount <= 0 {
+		return 0
+	}
+	if operation == "above" {
+		return 1
+	}
+	return satCount
+}
+
+// allowN2YOCalls checks an operation's estimated requests against what is left of
+// N2YOCallBudget. Operations over budget need confirmation and are refused in
+// non-interactive mode.
+func allowN2YOCalls(operation string, satCount, days int) bool {
+	if N2YOCallBudget <= 0 {
+		return true
+	}
+	used := 0
+	for _, count := range N2YORunCalls() {
+		used += count
+	}
+	estimate := EstimateCalls(operation, satCount, days)
+	if used+estimate <= N2YOCallBudget {
+		return true
+	}
+
+	fmt.Println(Colorize(RoleWarning, fmt.Sprintf("  [!] This needs about %d N2YO requests, over the budget of %d for this run (%d used)",
+		estimate, N2YOCallBudget, used)))
+	return confirm("Proceed anyway?", false)
+}
+
+// N2YOEndpointUsage tracks calls made to one N2YO endpoint today.
+type N2YOEndpointUsage struct {
+	Requests          int `json:"requests"`           // Requests made today
+	TransactionsCount int `json:"transactions_count"` // Latest rolling-hour count reported by N2YO
+}
+
+// N2YOUsage holds today's N2YO usage per endpoint.
+type N2YOUsage struct {
+	Date      string                       `json:"date"`
+	Endpoints map[string]N2YOEndpointUsage `json:"endpoints"`
+}
+
+// usageDay returns the local date used to bucket usage.
+func usageDay() string {
+	return time.Now().Format("2006-01-02")
+}
+
+// LoadN2YOUsage returns today's recorded N2YO usage. Usage from previous days is discarded.
+func LoadN2YOUsage() (N2YOUsage, error) {
+	usage := N2YOUsage{Date: usageDay(), Endpoints: map[string]N2YOEndpointUsage{}}
+
+	var stored N2YOUsage
+	found, err := loadJSONState(n2yoUsageFile, &stored)
+	if err != nil {
+		return usage, err
+	}
+	if found && stored.Date == usage.Date && stored.Endpoints != nil {
+		usage.Endpoints = stored.Endpoints
+	}
+	return usage, nil
+}
+
+// RecordN2YOUsage records a request to an N2YO endpoint along with the transactionscount
+// value from its response, and persists the updated totals. The rate limiter is corrected with
+// the same count.
+func RecordN2YOUsage(endpoint string, count int) error {
+	observeN2YOUsage(endpoint, count)
+	usage, err := LoadN2YOUsage()
+	if err != nil {
+		// Start fresh rather than lose track because of a corrupted file
+		usage = N2YOUsage{Date: usageDay(), Endpoints: map[string]N2YOEndpointUsage{}}
+	}
+
+	entry := usage.Endpoints[endpoint]
+	entry.Requests++
+	entry.TransactionsCount = count
+	usage.Endpoints[endpoint] = entry
+
+	return saveJSONState(n2yoUsageFile, usage)
+}
+
+// nearLimit reports whether the latest transaction count is close to the endpoint's hourly limit.
+func (u N2YOEndpointUsage) nearLimit(endpoint string) bool {
+	limit, ok := n2yoHourlyLimits[endpoint]
+	return ok && float64(u.TransactionsCount) >= float64(limit)*n2yoWarnThreshold
+}
+
+// PrintN2YOUsageSummary prints today's N2YO usage per endpoint, warning about endpoints near their limit.
+// It prints nothing if no requests were made today.
+func PrintN2YOUsageSummary() {
+	usage, err := LoadN2YOUsage()
+	if err != nil || len(usage.Endpoints) == 0 {
+		return
+	}
+
+	endpoints := make([]string, 0, len(usage.Endpoints))
+	for endpoint := range usage.Endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	runCalls := N2YORunCalls()
+
+	fmt.Println(Colorize(RoleInfo, fmt.Sprintf("  [*] N2YO API usage for %s:", usage.Date)))
+	for _, endpoint := range endpoints {
+		entry := usage.Endpoints[endpoint]
+		line := fmt.Sprintf("      %-13s %d requests today (%d this run), %d transactions in the last hour",
+			endpoint, entry.Requests, runCalls[endpoint], entry.TransactionsCount)
+		if limit, ok := n2yoHourlyLimits[endpoint]; ok {
+			line += fmt.Sprintf(" (limit %d/hour)", limit)
+		}
+		if entry.nearLimit(endpoint) {
+			fmt.Println(Colorize(RoleWarning, line+" - approaching limit"))
+		} else {
+			fmt.Println(Colorize(RoleInfo, line))
+		}
+	}
+}
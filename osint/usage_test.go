@@ -0,0 +1,139 @@
+package osint
+
+import (
+	"testing"
+)
+
+func TestRecordN2YOUsage_Accumulates(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	records := []struct {
+		endpoint string
+		count    int
+	}{
+		{"positions", 3},
+		{"visualpasses", 1},
+		{"positions", 4},
+		{"positions", 5},
+		{"radiopasses", 2},
+	}
+	for _, r := range records {
+		if err := RecordN2YOUsage(r.endpoint, r.count); err != nil {
+			t.Fatalf("RecordN2YOUsage(%q, %d) failed: %v", r.endpoint, r.count, err)
+		}
+	}
+
+	usage, err := LoadN2YOUsage()
+	if err != nil {
+		t.Fatalf("LoadN2YOUsage() failed: %v", err)
+	}
+	if usage.Date != usageDay() {
+		t.Errorf("Expected usage for %s, got %s", usageDay(), usage.Date)
+	}
+
+	expected := map[string]N2YOEndpointUsage{
+		"positions":    {Requests: 3, TransactionsCount: 5},
+		"visualpasses": {Requests: 1, TransactionsCount: 1},
+		"radiopasses":  {Requests: 1, TransactionsCount: 2},
+	}
+	if len(usage.Endpoints) != len(expected) {
+		t.Errorf("Expected %d endpoints, got %d", len(expected), len(usage.Endpoints))
+	}
+	for endpoint, want := range expected {
+		if got := usage.Endpoints[endpoint]; got != want {
+			t.Errorf("%s usage = %+v, want %+v", endpoint, got, want)
+		}
+	}
+}
+
+func TestLoadN2YOUsage_DiscardsPreviousDays(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	old := N2YOUsage{
+		Date:      "2000-01-01",
+		Endpoints: map[string]N2YOEndpointUsage{"positions": {Requests: 50, TransactionsCount: 900}},
+	}
+	if err := saveJSONState(n2yoUsageFile, old); err != nil {
+		t.Fatalf("Failed to seed usage file: %v", err)
+	}
+
+	usage, err := LoadN2YOUsage()
+	if err != nil {
+		t.Fatalf("LoadN2YOUsage() failed: %v", err)
+	}
+	if len(usage.Endpoints) != 0 {
+		t.Errorf("Expected previous day's usage to be discarded, got %+v", usage.Endpoints)
+	}
+
+	RecordN2YOUsage("positions", 1)
+	usage, _ = LoadN2YOUsage()
+	if usage.Endpoints["positions"].Requests != 1 {
+		t.Errorf("Expected counting to restart today, got %+v", usage.Endpoints["positions"])
+	}
+}
+
+func TestN2YOEndpointUsage_NearLimit(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		count    int
+		expected bool
+	}{
+		{"visualpasses", 79, false},
+		{"visualpasses", 80, true},
+		{"positions", 500, false},
+		{"positions", 950, true},
+		{"unknown", 10000, false},
+	}
+
+	for _, tt := range tests {
+		usage := N2YOEndpointUsage{TransactionsCount: tt.count}
+		if got := usage.nearLimit(tt.endpoint); got != tt.expected {
+			t.Errorf("nearLimit(%q) with %d transactions = %v, want %v", tt.endpoint, tt.count, got, tt.expected)
+		}
+	}
+}
+
+func TestEstimateCalls(t *testing.T) {
+	tests := []struct {
+		operation string
+		satCount  int
+		days      int
+		expected  int
+	}{
+		{"visualpasses", 5, 3, 5},
+		{"radiopasses", 5, 10, 5},
+		{"radiopasses", 5, 11, 5},
+		{"positions", 12, 1, 12},
+		{"above", 40, 1, 1},
+		{"tle", 0, 1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := EstimateCalls(tt.operation, tt.satCount, tt.days); got != tt.expected {
+			t.Errorf("EstimateCalls(%q, %d, %d) = %d, want %d", tt.operation, tt.satCount, tt.days, got, tt.expected)
+		}
+	}
+}
+
+func TestAllowN2YOCalls_BlocksOverBudget(t *testing.T) {
+	original, calls := N2YOCallBudget, n2yoRunCalls.counts
+	N2YOCallBudget = 10
+	n2yoRunCalls.counts = map[string]int{"positions": 5}
+	NonInteractive = true
+	t.Cleanup(func() {
+		N2YOCallBudget, n2yoRunCalls.counts = original, calls
+		NonInteractive = false
+	})
+
+	if !allowN2YOCalls("visualpasses", 5, 1) {
+		t.Error("Expected a batch within the budget to be allowed")
+	}
+	if allowN2YOCalls("visualpasses", 6, 1) {
+		t.Error("Expected a batch over the budget to be blocked without confirmation")
+	}
+
+	N2YOCallBudget = 0
+	if !allowN2YOCalls("visualpasses", 20, 1) {
+		t.Error("Expected no limit with a zero budget")
+	}
+}
@@ -0,0 +1,43 @@
+package osint
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Version is the SatIntel release reported to remote services. Release builds set it with
+// -ldflags "-X github.com/ANG13T/SatIntel/osint.Version=1.2.3".
+var Version = "dev"
+
+// UserAgent returns the User-Agent sent with every outbound request. SATINTEL_USER_AGENT
+// replaces the default.
+func UserAgent() string {
+	if agent := strings.TrimSpace(os.Getenv("SATINTEL_USER_AGENT")); agent != "" {
+		return agent
+	}
+	return "SatIntel/" + Version + " (+https://github.com/ANG13T/SatIntel)"
+}
+
+// userAgentTransport adds the SatIntel User-Agent to requests that do not set one.
+type userAgentTransport struct {
+	base http.RoundTripper
+}
+
+func (t userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", UserAgent())
+	}
+	return t.base.RoundTrip(req)
+}
+
+// NewHTTPClient returns a client that identifies itself as SatIntel and logs its requests in
+// verbose mode. A zero timeout means none.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: userAgentTransport{base: loggingTransport{base: http.DefaultTransport}},
+	}
+}
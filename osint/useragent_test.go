@@ -0,0 +1,54 @@
+package osint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewHTTPClient_SetsUserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	t.Setenv("SATINTEL_USER_AGENT", "")
+	resp, err := NewHTTPClient(0).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	resp.Body.Close()
+	if !strings.HasPrefix(got, "SatIntel/"+Version) {
+		t.Errorf("User-Agent = %q, want SatIntel/%s prefix", got, Version)
+	}
+
+	t.Setenv("SATINTEL_USER_AGENT", "MyStation/2.0")
+	resp, err = NewHTTPClient(0).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	resp.Body.Close()
+	if got != "MyStation/2.0" {
+		t.Errorf("User-Agent = %q, want override from SATINTEL_USER_AGENT", got)
+	}
+}
+
+func TestFetchN2YO_SendsUserAgent(t *testing.T) {
+	t.Setenv("N2YO_API_KEY", "TESTKEY")
+	t.Setenv("SATINTEL_USER_AGENT", "")
+	var got string
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"info":{"satname":"SPACE STATION","satid":25544}}`))
+	})
+
+	var out map[string]interface{}
+	if err := fetchN2YO([]string{"tle", "25544"}, &out, "TLE", ""); err != nil {
+		t.Fatalf("fetchN2YO() failed: %v", err)
+	}
+	if !strings.HasPrefix(got, "SatIntel/") {
+		t.Errorf("N2YO request User-Agent = %q, want SatIntel/", got)
+	}
+}
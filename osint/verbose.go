@@ -0,0 +1,70 @@
+package osint
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// Logger receives verbose diagnostics. It discards everything until EnableVerboseLogging is
+// called.
+var Logger = slog.New(slog.DiscardHandler)
+
+// EnableVerboseLogging sends debug logs to w as key=value lines.
+func EnableVerboseLogging(w io.Writer) {
+	Logger = slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+// apiKeyPattern matches the N2YO apiKey parameter and its value.
+var apiKeyPattern = regexp.MustCompile(`(?i)(apiKey=)[^&\s]*`)
+
+// redactURL hides the API key in a request URL so it is safe to share in logs.
+func redactURL(rawURL string) string {
+	return apiKeyPattern.ReplaceAllString(rawURL, "${1}REDACTED")
+}
+
+// loggingTransport logs each request's URL, status and response size once the body is closed.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if !Logger.Enabled(req.Context(), slog.LevelDebug) {
+		return resp, err
+	}
+	target := redactURL(req.URL.String())
+	if err != nil {
+		Logger.Debug("http request failed", "method", req.Method, "url", target, "error", err, "duration", time.Since(started))
+		return resp, err
+	}
+	resp.Body = &loggedBody{ReadCloser: resp.Body, done: func(bytes int64) {
+		Logger.Debug("http request", "method", req.Method, "url", target, "status", resp.StatusCode,
+			"bytes", bytes, "duration", time.Since(started))
+	}}
+	return resp, nil
+}
+
+// loggedBody counts the bytes read from a response body and reports them when it is closed.
+type loggedBody struct {
+	io.ReadCloser
+	read int64
+	done func(int64)
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	if b.done != nil {
+		b.done(b.read)
+		b.done = nil
+	}
+	return b.ReadCloser.Close()
+}
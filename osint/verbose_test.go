@@ -0,0 +1,73 @@
+package osint
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// withVerboseLog captures verbose logging for the duration of the test.
+func withVerboseLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	original := Logger
+	EnableVerboseLogging(&buf)
+	t.Cleanup(func() { Logger = original })
+	return &buf
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"https://api.n2yo.com/rest/v1/satellite/tle/25544/&apiKey=ABC-123", "https://api.n2yo.com/rest/v1/satellite/tle/25544/&apiKey=REDACTED"},
+		{"https://example.com/?apikey=secret&format=json", "https://example.com/?apikey=REDACTED&format=json"},
+		{"https://www.space-track.org/basicspacedata/query/class/satcat", "https://www.space-track.org/basicspacedata/query/class/satcat"},
+	}
+	for _, tt := range tests {
+		if got := redactURL(tt.input); got != tt.want {
+			t.Errorf("redactURL(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestVerboseLogging_N2YORequest(t *testing.T) {
+	buf := withVerboseLog(t)
+	t.Setenv("N2YO_API_KEY", "SECRETKEY")
+	withN2YOServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(N2YOTLEResponse{})
+	})
+
+	FetchTLE("25544")
+
+	line := buf.String()
+	if strings.Contains(line, "SECRETKEY") {
+		t.Fatalf("log leaks the API key:\n%s", line)
+	}
+	for _, want := range []string{"level=DEBUG", "apiKey=REDACTED", "status=200", "bytes="} {
+		if !strings.Contains(line, want) {
+			t.Errorf("log missing %q, got:\n%s", want, line)
+		}
+	}
+}
+
+func TestVerboseLogging_SpaceTrackQuery(t *testing.T) {
+	buf := withVerboseLog(t)
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	})
+	client.Transport = loggingTransport{base: client.Transport}
+
+	if _, err := QuerySpaceTrack(client, "/class/satcat"); err != nil {
+		t.Fatalf("QuerySpaceTrack() failed: %v", err)
+	}
+	line := buf.String()
+	for _, want := range []string{"/class/satcat", "status=200", "bytes=2"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("log missing %q, got:\n%s", want, line)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package osint
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Discrepancy kinds reported by VerifyTLEAgainstCatalog.
+const (
+	DiscrepancyWrongSatellite = "wrong satellite"
+	DiscrepancyStaleEpoch     = "stale epoch"
+	DiscrepancyFutureEpoch    = "future epoch"
+	DiscrepancyTampered       = "tampered element"
+	DiscrepancyChecksum       = "bad checksum"
+)
+
+const (
+	// staleEpochThreshold is how much older than the catalog element a TLE may be before it is
+	// reported as stale.
+	staleEpochThreshold = 72 * time.Hour
+	// futureEpochTolerance allows for a catalog that has not caught up with a just-published element.
+	futureEpochTolerance = time.Hour
+	// sameEpochTolerance is how close two epochs must be to describe the same element set.
+	sameEpochTolerance = time.Second
+	// wrongSatelliteInclination is the inclination difference, in degrees, that no stale element of
+	// the same satellite would show.
+	wrongSatelliteInclination = 1.0
+	// wrongSatelliteMeanMotion is the relative mean motion difference treated as another satellite.
+	wrongSatelliteMeanMotion = 0.1
+)
+
+// TLEDiscrepancy is one way a supplied TLE disagrees with the catalog.
+type TLEDiscrepancy struct {
+	Kind      string
+	Field     string
+	Supplied  string
+	Reference string
+}
+
+// VerifyResult compares a supplied TLE with the latest Space-Track element for the same NORAD ID.
+type VerifyResult struct {
+	NORADID   int
+	Supplied  TLE
+	Reference TLE
+	// EpochDifference is the catalog epoch minus the supplied epoch; positive means the supplied
+	// element is older.
+	EpochDifference time.Duration
+	Discrepancies   []TLEDiscrepancy
+}
+
+// OK reports whether the TLE matched the catalog without discrepancies.
+func (r VerifyResult) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// VerifyTLEAgainstCatalog checks a TLE against the latest element Space-Track holds for its NORAD
+// ID. It reports a different satellite (designator or orbit plane mismatch), an outdated or
+// future epoch, bad checksums, and, when the epochs match, any element that differs from the
+// catalog copy.
+func VerifyTLEAgainstCatalog(client *http.Client, line1, line2 string) (VerifyResult, error) {
+	supplied := ConstructTLE("", line1, line2)
+	if supplied.SatelliteCatalogNumber == 0 || supplied.ElementSetEpoch == 0 {
+		return VerifyResult{}, NewAppErrorWithContext(ErrCodeTLEParseFailed, "Could not read the NORAD ID and epoch from the TLE", "Line 1: "+line1)
+	}
+
+	norad := strconv.Itoa(supplied.SatelliteCatalogNumber)
+	history, err := FetchRecentTLEs(client, norad, 1)
+	if err != nil {
+		return VerifyResult{}, err
+	}
+	return compareWithCatalog(supplied, history[0]), nil
+}
+
+// compareWithCatalog lists the discrepancies between a supplied TLE and the catalog element.
+func compareWithCatalog(supplied, reference TLE) VerifyResult {
+	result := VerifyResult{
+		NORADID:         supplied.SatelliteCatalogNumber,
+		Supplied:        supplied,
+		Reference:       reference,
+		EpochDifference: reference.EpochTime().Sub(supplied.EpochTime()),
+	}
+	add := func(kind, field, suppliedValue, referenceValue string) {
+		result.Discrepancies = append(result.Discrepancies, TLEDiscrepancy{kind, field, suppliedValue, referenceValue})
+	}
+
+	for i, line := range []string{supplied.RawLine1, supplied.RawLine2} {
+		if ok, err := ValidateTLEChecksum(line); err != nil || !ok {
+			add(DiscrepancyChecksum, fmt.Sprintf("Line %d", i+1), line, RecomputeTLEChecksum(line))
+		}
+	}
+
+	if supplied.InternationalDesignator != "" && reference.InternationalDesignator != "" &&
+		supplied.InternationalDesignator != reference.InternationalDesignator {
+		add(DiscrepancyWrongSatellite, "International Designator", supplied.InternationalDesignator, reference.InternationalDesignator)
+	}
+	if math.Abs(supplied.OrbitInclination-reference.OrbitInclination) > wrongSatelliteInclination {
+		add(DiscrepancyWrongSatellite, "Inclination", formatElement(supplied.OrbitInclination, 4), formatElement(reference.OrbitInclination, 4))
+	}
+	if reference.MeanMotion > 0 && math.Abs(supplied.MeanMotion-reference.MeanMotion)/reference.MeanMotion > wrongSatelliteMeanMotion {
+		add(DiscrepancyWrongSatellite, "Mean Motion", formatElement(supplied.MeanMotion, 8), formatElement(reference.MeanMotion, 8))
+	}
+
+	switch diff := result.EpochDifference; {
+	case diff > staleEpochThreshold:
+		add(DiscrepancyStaleEpoch, "Epoch", formatEpoch(supplied), formatEpoch(reference))
+	case diff < -futureEpochTolerance:
+		add(DiscrepancyFutureEpoch, "Epoch", formatEpoch(supplied), formatEpoch(reference))
+	case math.Abs(float64(diff)) <= float64(sameEpochTolerance):
+		// Same element set: every element should match to the precision of the format.
+		elements := []struct {
+			field               string
+			supplied, reference float64
+			decimals            int
+		}{
+			{"Inclination", supplied.OrbitInclination, reference.OrbitInclination, 4},
+			{"Right Ascension", supplied.RightAscension, reference.RightAscension, 4},
+			{"Eccentricity", supplied.Eccentrcity, reference.Eccentrcity, 7},
+			{"Argument of Perigee", supplied.Perigee, reference.Perigee, 4},
+			{"Mean Anomaly", supplied.MeanAnamoly, reference.MeanAnamoly, 4},
+			{"Mean Motion", supplied.MeanMotion, reference.MeanMotion, 8},
+			{"First Derivative of Mean Motion", supplied.FirstDerivativeMeanMotion, reference.FirstDerivativeMeanMotion, 8},
+		}
+		for _, e := range elements {
+			if formatElement(e.supplied, e.decimals) != formatElement(e.reference, e.decimals) {
+				add(DiscrepancyTampered, e.field, formatElement(e.supplied, e.decimals), formatElement(e.reference, e.decimals))
+			}
+		}
+		if supplied.BDragTerm != reference.BDragTerm {
+			add(DiscrepancyTampered, "B* Drag Term", supplied.BDragTerm, reference.BDragTerm)
+		}
+	}
+	return result
+}
+
+// formatElement renders an element to the number of decimals the TLE format carries.
+func formatElement(value float64, decimals int) string {
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
+// verifyLines renders a catalog verification as table rows.
+func verifyLines(result VerifyResult) []string {
+	lines := []string{
+		Colorize(RoleHeader, "\n╔═════════════════════════════════════════════════════════════╗"),
+		Colorize(RoleHeader, GenRowString("NORAD ID", strconv.Itoa(result.NORADID))),
+		Colorize(RoleHeader, GenRowString("Supplied Epoch", formatEpoch(result.Supplied))),
+		Colorize(RoleHeader, GenRowString("Catalog Epoch", formatEpoch(result.Reference))),
+		Colorize(RoleHeader, GenRowString("Discrepancies", strconv.Itoa(len(result.Discrepancies)))),
+	}
+	for _, d := range result.Discrepancies {
+		lines = append(lines,
+			Colorize(RoleHeader, "╠═════════════════════════════════════════════════════════════╣"),
+			Colorize(RoleWarning, GenRowString("Problem", d.Kind+": "+d.Field)),
+			Colorize(RoleHeader, GenRowString("Supplied", d.Supplied)),
+			Colorize(RoleHeader, GenRowString("Catalog", d.Reference)),
+		)
+	}
+	lines = append(lines, Colorize(RoleHeader, "╚═════════════════════════════════════════════════════════════╝"))
+	if result.OK() {
+		lines = append(lines, Colorize(RoleSuccess, "  [+] TLE matches the Space-Track catalog"))
+	}
+	return lines
+}
+
+// offerCatalogVerification asks whether to check a parsed TLE against Space-Track. It is skipped
+// when credentials are not available.
+func offerCatalogVerification(line1, line2 string) {
+	if !CredentialsConfigured() || !confirm("Verify this TLE against the Space-Track catalog?", false) {
+		return
+	}
+	client, err := Login()
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	result, err := VerifyTLEAgainstCatalog(client, line1, line2)
+	if err != nil {
+		fmt.Println(Colorize(RoleError, "  [!] ERROR: "+err.Error()))
+		return
+	}
+	printPaged(verifyLines(result))
+}
@@ -0,0 +1,91 @@
+package osint
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// catalogServer serves line1/line2 as the latest Space-Track element and records the request path.
+func catalogServer(t *testing.T, line1, line2 string) (*http.Client, *string) {
+	t.Helper()
+	var requested string
+	client := withSpaceTrackServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Write([]byte(line1 + "\n" + line2 + "\n"))
+	})
+	return client, &requested
+}
+
+// discrepancyKinds returns the kinds and fields reported, e.g. "tampered element: Inclination".
+func discrepancyKinds(result VerifyResult) []string {
+	var kinds []string
+	for _, d := range result.Discrepancies {
+		kinds = append(kinds, d.Kind+": "+d.Field)
+	}
+	return kinds
+}
+
+func TestVerifyTLEAgainstCatalog_Match(t *testing.T) {
+	client, requested := catalogServer(t, testTLELine1, testTLELine2)
+
+	result, err := VerifyTLEAgainstCatalog(client, testTLELine1, testTLELine2)
+	if err != nil {
+		t.Fatalf("VerifyTLEAgainstCatalog() failed: %v", err)
+	}
+	if !strings.Contains(*requested, "/NORAD_CAT_ID/25544/") {
+		t.Errorf("Requested path = %q, want a query for NORAD 25544", *requested)
+	}
+	if !result.OK() || result.EpochDifference != 0 {
+		t.Errorf("Expected a clean match, got %v (epoch difference %v)", discrepancyKinds(result), result.EpochDifference)
+	}
+}
+
+func TestVerifyTLEAgainstCatalog_Mismatch(t *testing.T) {
+	client, _ := catalogServer(t, testTLELine1, testTLELine2)
+
+	// Same epoch, inclination edited and checksum recomputed so only the element gives it away
+	tampered := RecomputeTLEChecksum(strings.Replace(testTLELine2, "51.6335", "51.6535", 1))
+	result, err := VerifyTLEAgainstCatalog(client, testTLELine1, tampered)
+	if err != nil {
+		t.Fatalf("VerifyTLEAgainstCatalog() failed: %v", err)
+	}
+	kinds := discrepancyKinds(result)
+	if len(kinds) != 1 || kinds[0] != "tampered element: Inclination" {
+		t.Errorf("Discrepancies = %v, want only the tampered inclination", kinds)
+	}
+
+	// Edited without fixing the checksum
+	result, _ = VerifyTLEAgainstCatalog(client, testTLELine1, strings.Replace(testTLELine2, "51.6335", "51.6535", 1))
+	if kinds := discrepancyKinds(result); len(kinds) != 2 || kinds[0] != "bad checksum: Line 2" {
+		t.Errorf("Discrepancies = %v, want a bad checksum and the tampered inclination", kinds)
+	}
+
+	// Ten days older than the catalog element
+	older := RecomputeTLEChecksum(strings.Replace(testTLELine1, "04236.56031392", "04226.56031392", 1))
+	result, _ = VerifyTLEAgainstCatalog(client, older, testTLELine2)
+	if kinds := discrepancyKinds(result); len(kinds) != 1 || kinds[0] != "stale epoch: Epoch" {
+		t.Errorf("Discrepancies = %v, want a stale epoch", kinds)
+	}
+
+	// A different satellite's elements under the same NORAD ID
+	other1 := RecomputeTLEChecksum(vanguardTLELine1[:2] + "25544" + vanguardTLELine1[7:])
+	other2 := RecomputeTLEChecksum(vanguardTLELine2[:2] + "25544" + vanguardTLELine2[7:])
+	result, _ = VerifyTLEAgainstCatalog(client, other1, other2)
+	wrong := 0
+	for _, d := range result.Discrepancies {
+		if d.Kind == DiscrepancyWrongSatellite {
+			wrong++
+		}
+	}
+	if wrong == 0 {
+		t.Errorf("Discrepancies = %v, want the satellite reported as wrong", discrepancyKinds(result))
+	}
+}
+
+func TestVerifyTLEAgainstCatalog_Unparseable(t *testing.T) {
+	client, _ := catalogServer(t, testTLELine1, testTLELine2)
+	if _, err := VerifyTLEAgainstCatalog(client, "not a tle", "either"); err == nil {
+		t.Error("Expected an error for an unparseable TLE")
+	}
+}